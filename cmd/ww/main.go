@@ -13,9 +13,12 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/wetware/ww/internal/cmd/client"
+	"github.com/wetware/ww/internal/cmd/keygen"
 	"github.com/wetware/ww/internal/cmd/start"
+	"github.com/wetware/ww/internal/runtime"
 	logutil "github.com/wetware/ww/internal/util/log"
 	ww "github.com/wetware/ww/pkg"
+	"github.com/wetware/ww/pkg/cap/proc"
 )
 
 var logger log.Logger
@@ -82,6 +85,7 @@ var flags = []cli.Flag{
 var commands = []*cli.Command{
 	start.Command(),
 	client.Command(),
+	keygen.Command(),
 }
 
 func before() cli.BeforeFunc {
@@ -92,6 +96,12 @@ func before() cli.BeforeFunc {
 }
 
 func main() {
+	// Processes with resource limits are started by re-executing ww,
+	// which holds them until they have been added to their cgroup.
+	if len(os.Args) > 1 && os.Args[1] == runtime.ShimArg {
+		proc.Shim(os.Args[2:])
+	}
+
 	run(&cli.App{
 		Name:                 "wetware",
 		Usage:                "the distributed programming language",
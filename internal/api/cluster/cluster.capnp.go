@@ -1725,12 +1725,12 @@ type View_Record struct{ capnp.Struct }
 const View_Record_TypeID = 0xcdcf42beb2537d20
 
 func NewView_Record(s *capnp.Segment) (View_Record, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return View_Record{st}, err
 }
 
 func NewRootView_Record(s *capnp.Segment) (View_Record, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
 	return View_Record{st}, err
 }
 
@@ -1778,12 +1778,54 @@ func (s View_Record) SetSeq(v uint64) {
 	s.Struct.SetUint64(8, v)
 }
 
+func (s View_Record) Meta() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s View_Record) HasMeta() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s View_Record) MetaBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s View_Record) SetMeta(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s View_Record) RelayAddrs() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(2)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s View_Record) HasRelayAddrs() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s View_Record) SetRelayAddrs(v capnp.TextList) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewRelayAddrs sets the relayAddrs field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s View_Record) NewRelayAddrs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
 // View_Record_List is a list of View_Record.
 type View_Record_List struct{ capnp.List }
 
 // NewView_Record creates a new list of View_Record.
 func NewView_Record_List(s *capnp.Segment, sz int32) (View_Record_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
 	return View_Record_List{l}, err
 }
 
@@ -2098,91 +2140,94 @@ func (p View_lookup_Results_Future) Record() View_Record_Future {
 	return View_Record_Future{Future: p.Future.Field(0, nil)}
 }
 
-const schema_fcf6ac08e448a6ac = "x\xda\x94V]l\x14e\x17>g~v\xa6_Z" +
-	"\xda\x97\x97Ol\xfd\xa9\xd4\x12K\xb5\x0dmA\x93\xc5" +
-	"\xb8K)\xa1\xad\x90t\xf8\x11\xf1\xc6Lv\x07\xba\xb2" +
-	"\xdd-\xb3S\x1b\x13\x0cF#\xa0\x17H\xd0&\x86\x0b" +
-	".\x8c\x8054\x0a\x01\x92\x9a\xd0\x04b\xa3\xe0_\x04" +
-	"\x09PC\x10\xf9I\xbc@\x05\x95\x00)\x8ey\xdf\xd9" +
-	"wf\xba]L\xbd\xeaf\xf7\xf49\xcfy\xces\x9e" +
-	"\x99\xb9\xab\xe5\xb8\xd4\xa4\xee-\x010z\xd5\x88;}" +
-	"\xc1\xae\x93\x8f\x0eo\x7f\x03\xc8\x03\x08\xa0h\x00-\xc3" +
-	"\xcar\x04\xc5\xddY;\xfeB\xcb\xef\x0f\xbd\x0dd\x9a" +
-	"\xec\xee\xdb\xd3~Y\xdfws\x1c\x00\xe9ne'\x1d" +
-	"R\x1e\x03\xa0G\x95-\x94\xa8\x1a\x80{d\xd7\xe0\xe1" +
-	"o{\x86\xb7y0*2\x9c\xdbJ'\x02RU\x8d" +
-	"\x01\xba\x7f\xfd\xb0\xf4\xcdm;\x9e\x7f\x07\x08\x15}\xe6" +
-	"\xab\x12\xeb\xa3\x9dz19>\xb2q`R\x9fJu" +
-	"'\x9d\xa5\xce\x04\xa0\x0d\xea\x12\xba\x8a}rGko" +
-	"\xb4\xd4U\x9c\x1f\x00\xf2\x7ftG\xcfu<\xd8\xb0\xe3" +
-	"\xad\x11P%\x0d\x80.V\xc7\xa8\xc1\xd8\xd0ej?" +
-	"\xa0{y\xb8o\xc5\xb3\xc7\x94\x0f\xbd\x96\x9c\x13\x1dT" +
-	"\xef\x00\xd2!N\xe9\xa9k\xda\xa5\xd7k^\xde\xcb\xb0" +
-	"\x04\xe7\xf3\xeat\xc6\xf9\x0a/\x18\xbb\xf8\xd9\xdc#\xbf" +
-	"\xcc\x1c\x02r\xbf_\xa0FjXAY\x84\x15\xfc\xf1" +
-	"\xcc\xf5/6\xdbw?\x0d#4D$V\xd0\xc4\x0b" +
-	"|\x86\x85\xb3Y\x91\x8fiO\x84\xcd\xd6\x17\xd9BG" +
-	"#L\xc3\xab\x17\xb6\x1b\xef\x8f\x9d\x18\x09\xa3\x0dE\xfe" +
-	"\xc7\xd0\x0eq\xb4\x1fg\xbd\xd7|kC\xe2s6P" +
-	" \x9b7\xfc\x95\xc8\xcf\xf4:\x83\xa1\xd7\"l\xf8G" +
-	"^]q`\xa4\xf5\xbbo\xc0\xa0(\x05\xbb\xf4TX" +
-	"\xa3\x9d\xa4\x96\xc6>\x99\xda'\x80\xee\xe9\x81\xa1\xcd\x07" +
-	"\x8f\xf7\x9f\x0au\xa6\xb75\xa6\xd4]\x8d5\xbes\xe4" +
-	"\xec\x8d\xad\x1b+\xce\x86\x85h\xd0\xab\x18\xb3\xf9:+" +
-	"H\xd7=~k\xe5Os\xce\x85\xa5^\xa53\x805" +
-	"\xfc\xf73O\xdew\xe2+g\xc1E\x0f\x80o\xff\x15" +
-	"\xf6\xff\x8a\xfb\xdb\x99\xea\xc3m_w^a<}l" +
-	"S\xe7[H\xe9l\x12:\xfe\xd1\x92\xe9\xe6\x85\xab!" +
-	"\xe7\x8c\xea\xdc9\xf2\xd3\x1f\x1cH\xecy\xf7W T" +
-	"\x0eF\x04\xa4\x83\xfa\x18=\xa43\x12\xfb\xf5%\xf4\x1c" +
-	"\xfb\xe4\x9em{\xed\xcb\x87\x17\xce\xbf\x1e\xa2pT\xaf" +
-	"AP\xfe\x8e\xcf;\xbej\xf7\xc0\x9f\x01\xf5\x96A\x9d" +
-	"\xab\xbe\x9fs\xbftP\x19\xd9\xba\x1aoN\xda\xe1\xf7" +
-	"\xfa1\x0f\x9b\x9e\xd6\xb7\xd0\xd9%3\xe1\x097\x91\xee" +
-	"\xcb9\x96\xdd\xa8$\xcc\xdeLo\xf4\xb9\x94\xd5\xdf\xd8" +
-	"nf\x92i\xcbn\xec\xe6\x7fk\x97[\xb9\xbe\xb4\x83" +
-	"9\xbf\x16E\xadl\xf5\x1b:bh\xae\x92\xd6`\x91" +
-	"D\x8dn\xcaC\xc5\x96[\x89\xac\x9d4tY\x05\xf0" +
-	"\xc5G\xa1\x14i\xaa\x07\x89\xcc\xd6\x10\xc5l\x81\xcc\xa4" +
-	"2\x0a\x12)\xd3\xcaS\x8ee\xc71\x96\xcef\xd7\xf7" +
-	"\xf5\xc6\xb1\x0bq*\xe4\xbbL\xdb\xec\xc9\x01\x18\x8a\xac" +
-	"\x00(\x08@\xcaZ\x01\x0c]F\xa3V\xc2M6'" +
-	"\x96\xc3i\x80]2bE@\x1f\x90}\xe9\xf7P\xbd" +
-	"\x1e\xed\xd9\x9c\xd3\xf8R6\x95\xc9\xcb\x92\x03Q ~" +
-	"\x97s\x8e\xa1`\xd8\xfc\xd8\xe9.L&\xed\x8e\xcc\xda" +
-	",p&L\x04q\xec(\x82\x86\x10&\x82\xaa\x953" +
-	"\xf48r\x0c\xff\x1c\x01|\"\x92\xd7ha&\xd1\x9d" +
-	"\xb5\x1b\x17u\xa7\xe4t\xb2\x0b\xd1\xd0\xfd\x01\xe7\xd4\x03" +
-	"\x18\xb52\x1as%$\x883\x98OIC\x14\xc0\xa8" +
-	"\x93\xd1\x98'ay\xc6\xec\xb1\xb0\x14$,\x05\x8c\x99" +
-	"\x1c\x09I\xa8\x1b\"\x09\x8d.\x15\x8e\x1e\xf3T\x0dk" +
-	"\xda\x1chZ\xddkYvHQ_\x88\x02E\xe5\x09" +
-	"\x83\xf4\x9b\xe9\xf5\xbe\xd5\xc2\xc8\xd1<\xf2\x0ci\x0aT" +
-	"\xf3\x98\x8b\xb2\x19\xc7Le,\xbbq\x9d\xe5pT-" +
-	"\xedL@\xad\x0fP\xcb\x93\xa6cb\x19HXvO" +
-	"v\xe9\x9c\xbf\xef0J'\x80Q*\xa3Q'\xa1\x9b" +
-	"\xe8N\xa5\x93\xb6\x95\x01\x80`v?\xfe\x0bfG\x81" +
-	"^\xce\xe0\xbd]\xfbO\x0al\xae^\xc4\xc0\xf2\xe7\"" +
-	"\xc2\x0eEz\x93\xa6\xaa\xfc\xb9\xf8\x11\x8c\xe2\xd9@*" +
-	"\xeb\xf9\xb9\xc8\xe9\\\x1c\xcb\x99\xa8\x13O\xa5\x88\xe8\xc5" +
-	"N\xa4>Xgy\xaf\xe9t\x8b\x89\x98g\xa6\xdd\xc3" +
-	"\x1a\xdc\xe3Zfm\xb6\xc0\x8dU\xc5\xdc\xd8\x9cwc" +
-	"\x9b\x84r*)\xbcXm&\x93\x81u\xca\x8a7\xe3" +
-	"g\xce\x03\x05\xb9\xf1K\xfdV\x8b\x19\xed\xb8\x8c\xc6R" +
-	"\x09E\xa7\x8e\x1a\x00\xa3MF\xa3KB\"\xe1\x0c\x94" +
-	"\x00\xc82\xf6e\xbb\x8c\xc6J6\x9fe\xd9\x82\x80\xe6" +
-	"8iTAB\x15P\xcbY\x1b\xb0\x04$,\x99\xcc" +
-	"!\xb0E\x91[\x98\xaax\x93\xcc\x9a\xb3\x1c\xbe\x0d\xb9" +
-	"\xe7\xbfy5,\x0c\x8b\xc9b\xa4Z\x03\x90M^6" +
-	"\xb2;\xf2\x93{*w\x94\xa7VX\xc4\xdbz\xb1\x1c" +
-	"\x1cph\xff\xd1`\xff\xfe\xfa\xab\x820\x8ay\x11\\" +
-	"\x10\xbd\x15\x80rv=\"H\x88!^\x91\xc2I\x8b" +
-	"\xa7p\xf0$\x90-\x9by\xc4\xcb\\\xf1\xd2\x87\xe2%" +
-	"\x92\x90(\xcf\xdc\x98\xa7H\xd1S\x99\xb8\x1e\x91%\xff" +
-	"&B\xb1\x83\x0a\xa7\x183\\G\x9b\xb0\\\xe1\x16\xfd" +
-	"~\xe0\xdd\x11#.^GP\xbc\xe1\x91\xa6\x1a\x11\x01" +
-	"\xe2]\x07\xc5\x0b\x03\xa9\xac\xe1\x11\xa0\xad\xb3\x9c8s" +
-	"\xb13\xf9i\xf2O\x00\x00\x00\xff\xff\xacC!h"
+const schema_fcf6ac08e448a6ac = "x\xda\x94Vkl\x14U\x14>g\xee\xcc\xce\x8e\xa1" +
+	"l/\xb7\x8a\xad\x8fJ\xad\xb1T\xdb\xd0\x96jR\xc4" +
+	">\xa8\x01\xaa$\x1d\x10\x1f$\xc6Lv\x07\xba\xb2\xdd" +
+	"-\xb3S\xab\x06\x02*O\x83H\xd0&\x86\x1f\xfc0" +
+	"\xf224Q\x82$5\xa1\x09D\xa2\xe0+\x01\x0dP" +
+	"C\x10\xa1$\xfe@\x05\x85 \xa9\x8e\xb9wv\x1e\xdd" +
+	".\xa6\xfe\xeaf\xf7\xf4;\xdf\xf9\xcew\xbe\x99\x19\xaf" +
+	"\x91\x16\xa9N\xd9\xad\x01\xe8=J\xc4\x992k\xc7\x89" +
+	"\xfb\x07\xb7\xbe\x09\xf4.\x04\x90U\x80\x86Ay!\x82" +
+	"\xecl\xaf\x1c]\xd2\xf0\xfb=o\x01\x9dL\x9c}\xbb" +
+	"\xe6]\x8c\xee\xbb>\x0a\x80l\xa7\xbc\x9d\x0d\xc8\x0f\x02" +
+	"\xb0\xc3\xf2\x06F\x15\x15\xc09\xb4c\xef\xc1o\xbb\x07" +
+	"\xb7\xb80\x0ar\x9c\xbf\xe4\x0e\x04d\x8a\xd2\x0c\xe8\\" +
+	"\xfb\xfe\xa9u[\xb6=\xf7\x0eP\xe6\xf5iT$\xde" +
+	"G=\xf9bbthe\xff\xb8>\xa5\xcav6M" +
+	"\x99\x0a\xc0j\x94\xb9l1\xff\xe4\x1c\xad\xbc\xdaPU" +
+	"|\xb6\x1f\xe8\xed\xe8\x1c=3\xff\xee\x9am\x9b\x86@" +
+	"\x91T\x00\xf6\x842\xcct\xce\x86-P\xfa\x00\x9d\x8b" +
+	"\x83\xbd\x8b\x9e<\"\x7f\xe8\xb6\x14\x9c\xd8^\xe5& " +
+	"\x1b\x10\x94\x1e\xbd\xac^x\xa3\xe2\xe5\xdd\x1c\xcb\xe3|" +
+	"V\x99\xc29\x8f\x88\x82\xe1\xf3\x9f\xcd8\xf4\xcb\xd4\x01" +
+	"\xa0w\xfa\x05J\xa4\x82\x17\x14Ex\xc1\x1f\x8f_\xf9" +
+	"b\xbd\xf5\xf7\xc7a\x84\x9a\x88\xc4\x0b\xeaD\x81\xcf0" +
+	"\x7f63\xf2\x11\xeb\x8e\xf0\xd9z#\x1b\xd8\xd1\x08\xd7" +
+	"\xf0\xd2\xb9\xad\xfa\xfb\xc3\xc7\x87\xc2h\x03\x91\xdb8\xda" +
+	"\xa7\x02\xed\xc7i\xef\xd5\xdfX\x11\xff\x9c\x0f\x14\xc8\xe6" +
+	"\x0e?\x12\xf9\x99]\xe10\xecr\x84\x0f\x7f\xdf\xaaE" +
+	"\xfb\x87\xda\xbe\xfb\x06t\x86R\xb0K\x85\xf0\x92\xe7\xd5" +
+	"\x13\xccT9\xbe\xa1\x96#\xa0\xf3C\xff\xc0\xfa\x03\xc7" +
+	"\xfaN\x86Z\xb3uQ.\xd5\xa6(\xef|\xf3\xd0\xe9" +
+	"\xab\x1bW\x16\x9f\x0e+1\x18-\xe3\xd4\x0e\x8b\x82T" +
+	"\xd5C7\x9e\xfei\xfa\x99\xb0\xd6\xe7\x05\xc0\x88\xf8\xfd" +
+	"\xd4#w\x1c\xff\xca\x9eu\xde\x05\x10\xebW\xb42\xbe" +
+	"\xfe\xdfN\x95\x1fl\xff\xbac\x84\x13\xf5\xb1/G\xc5" +
+	"\x1a\xaeE\xf9(lt\xcf\xdc)\xc6\xb9K!\xeb\xe8" +
+	"\x9a\xb0\x0ey\xec\x83\xfd\xf1]\xef\xfe\x0a\x94\x91`F" +
+	"@\xd6\xa8\x0d\xb3V\x8d\x93\x98\xad\xcde&\xff\xe4\x9c" +
+	"n_\xf3\xe5\xbd\xad\x8dWB\x14\x16h\x15\x08\xf2?" +
+	"-3\x8f-\xde\xd9\xffg@\xbd\xa1Q\x13\xb2\xcf\xd6" +
+	"8\xf7\x0b\x07\xe4\xa1\x8d\xcf\xe2\xf5qK|A;\xe2" +
+	"b3C\xdb\xc0>\xd1\xa6\xc2\xc3N<\xd5\x9b\xb5M" +
+	"\xabV\x8e\x1b=\xe9\x9e\xa6g\x92f_\xed<#\x9d" +
+	"H\x99Vm\x97\xf8[\xb9\xd0\xcc\xf6\xa6l\xcc\xfa\xb5" +
+	"\xe8\xd5\x12\xb3O\x8f\"\x86\xe6\xd2\xda\x82MR\xa5i" +
+	"u\x0e\xaay\xa1\x19\xcfX\x09=J\x14\x00_|\xf4" +
+	"\x94\xa2u\xd5 \xd1\x07TDo\xb6@fZ\xda\x04" +
+	"\x12-RcI\xdb\xb4Z\xb09\x95\xc9,\xef\xedi" +
+	"\xc1N\xc4\x89\x90\xef4,\xa3;\x0b\xa0\xcbD\x06\x90" +
+	"\x11\x80\x16\xb5\x01\xe8Q\x82z\xa5\x84\xab-A,\x8b" +
+	"\x93\x01;\x09bq@\x1f\x90\x7f\xe9\xf7P\xdc\x1e\xf3" +
+	"2Y\xbb\xf6\xa5L2\x9d\x93%\x0b^\x81\xf7;\xc9" +
+	"\xda\xba\x8ca\xf7c\x87\xd3\x9aHX\xf3\xd3K3 " +
+	"\x98p\x11\xbckG/i(\xe5\"(j\x8c\xa3\xb7" +
+	"\xa0\xc0\xf0\xef\x11\xc0'\"\xb9\x8dZ\xd3\xf1\xae\x8cU" +
+	";\xa7+IR\x89ND=\xea\x0f8\xbd\x1a@\xaf" +
+	"$\xa8\xcf\x90\x90\"\x96p\x9f\xd2\x9a&\x00\xbd\x8a\xa0" +
+	">S\xc2X\xda\xe86q\x12H8\x09\xb0\xd9\x10H" +
+	"HC\xdd\x10iht)\x7f\xf4fW\xd5\xb0\xa6\xf5" +
+	"\x81\xa6\xe5=\xa6i\x85\x14\xf5\x85\xc8S\x94\x8c\x19\xa4" +
+	"\xcfH-\xf7\xad\x16Fn\xca!\x97H\x13\xa0\x9a\xc3" +
+	"\x9c\x93I\xdbF2mZ\xb5\xcbL[\xa0\xaa){" +
+	"\x0cju\x80\x1aK\x18\xb6\x81E a\xd1-\xd9\xa5" +
+	"\xb2\xfe\xbe\xc3(\x1d\x00\xfa$\x82z\x95\x84N\xbc+" +
+	"\x99JXf\x1a\x00\x82\xd9\xfd\xfc\xcf\x9b\x1d=\xf4\x18" +
+	"\x87ww\xed?*\xb0\xbe|\x0e\x07\xcb\x9d\x8b\x17v" +
+	"\xe8\xc57\xad+\xcb\x9d\x8b\x9f\xc1\xe8=\x1chi\xb5" +
+	"8\x17\x92\xca\xb6`\x8c\x8b:\xf6T\x0a\x88^\xe8D" +
+	"\xaa\x83u\xc6z\x0c\xbb\xcb\x9b\x88{f\xf2-\xac!" +
+	"<\xae\xa6\x97f\xf2\xdcXV\xc8\x8d\xf597\xb6K" +
+	"H\x92\x09\xcf\x8b\xe5F\"\x11X\xa7\xa8p3q\xe6" +
+	"\"PP\x18\xbf\xc4o\xb5\x8a\xd3~\x85\xa0\xbeVB" +
+	"\xaf\xd3\xeb\x15\x00\xfaJ\x82\xfaF\x09\xa9\x84%(\x01" +
+	"\xd0u\xfc\xcb5\x04\xf5\xcd\x12R\x82%H\x00\xe8&" +
+	"\xfe\xdfk\x09\xea[%\xa4\xb2T\x822\x00}{\x09" +
+	"\x80\xbe\x99\xa0\xbe\x87+a\x9a\x96GU\xb5\xed\x14*" +
+	" \xa1\x02\xa8f\xcd\x15\xa8\x81\x84\x1a`\xac\xdb\xb4\x0d" +
+	"\xaf\xc8\xb1\xcc\x94\xf1jk\"\x01$\x18\xeb\x16\x1a\x06" +
+	"N+p^\x13\xdd\xc78\xffgM[,\x98t\xff" +
+	"?\xfb\x87\xb5\xe6\xc9[\x88T[\x00\xb2\xda\x8d[~" +
+	"\x9a\xfe\xc3`\"\xa7\x99\xa3\x96_$\xda\xbaI\x1fd" +
+	"B\xc8RM\x81\xa5|G\x95\x05\xf9\xd6\xec\xa6z^" +
+	"\x9a\x17\x03\x92\xccrD\x90\x10C\xbc\"\xf9\x93\x16\x0e" +
+	"\xf6\xe0\xe1BL\x8b\xdb\xce\x8dq\xefE\x12\xbd\x17S" +
+	"J\x9bD\x8c7\xbb\x8a\x14\xbc\xbe\xb1\xeb\xf1\xe2\xe9\xbf" +
+	"D(t\xa3\xe1`\xe4\xce\x9c\xdf\xee\xdb.o\x8b~" +
+	"?pO\x93\x13\xf7\xdep\xd0{k\xa4u\x15^\xaa" +
+	"x\xafO\xe8\xbd\x83\xd0\xd2\x0a\x91*\xea2\xd3n\xe1" +
+	"v\xb7\xc7?\xa0\xfe\x0d\x00\x00\xff\xff\x98a-\xbe"
 
 func init() {
 	schemas.Register(schema_fcf6ac08e448a6ac,
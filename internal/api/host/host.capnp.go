@@ -0,0 +1,1145 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package host
+
+import (
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+	server "capnproto.org/go/capnp/v3/server"
+	context "context"
+)
+
+type Host struct{ Client *capnp.Client }
+
+// Host_TypeID is the unique identifier for the type Host.
+const Host_TypeID = 0xc5e715360897ee3b
+
+func (c Host) View(ctx context.Context, params func(Host_view_Params) error) (Host_view_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      0,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "view",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_view_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Host_view_Results_Future{Future: ans.Future()}, release
+}
+func (c Host) Pubsub(ctx context.Context, params func(Host_pubsub_Params) error) (Host_pubsub_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      1,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "pubsub",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_pubsub_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Host_pubsub_Results_Future{Future: ans.Future()}, release
+}
+func (c Host) Anchor(ctx context.Context, params func(Host_anchor_Params) error) (Host_anchor_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      2,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "anchor",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_anchor_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Host_anchor_Results_Future{Future: ans.Future()}, release
+}
+func (c Host) Executor(ctx context.Context, params func(Host_executor_Params) error) (Host_executor_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      3,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "executor",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_executor_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Host_executor_Results_Future{Future: ans.Future()}, release
+}
+func (c Host) Scheduler(ctx context.Context, params func(Host_scheduler_Params) error) (Host_scheduler_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      4,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "scheduler",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_scheduler_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Host_scheduler_Results_Future{Future: ans.Future()}, release
+}
+func (c Host) Cron(ctx context.Context, params func(Host_cron_Params) error) (Host_cron_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      5,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "cron",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Host_cron_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Host_cron_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Host) AddRef() Host {
+	return Host{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Host) Release() {
+	c.Client.Release()
+}
+
+// A Host_Server is a Host with a local implementation.
+type Host_Server interface {
+	View(context.Context, Host_view) error
+
+	Pubsub(context.Context, Host_pubsub) error
+
+	Anchor(context.Context, Host_anchor) error
+
+	Executor(context.Context, Host_executor) error
+
+	Scheduler(context.Context, Host_scheduler) error
+
+	Cron(context.Context, Host_cron) error
+}
+
+// Host_NewServer creates a new Server from an implementation of Host_Server.
+func Host_NewServer(s Host_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Host_Methods(nil, s), s, c, policy)
+}
+
+// Host_ServerToClient creates a new Client from an implementation of Host_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Host_ServerToClient(s Host_Server, policy *server.Policy) Host {
+	return Host{Client: capnp.NewClient(Host_NewServer(s, policy))}
+}
+
+// Host_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Host_Methods(methods []server.Method, s Host_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 6)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      0,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "view",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.View(ctx, Host_view{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      1,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "pubsub",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Pubsub(ctx, Host_pubsub{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      2,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "anchor",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Anchor(ctx, Host_anchor{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      3,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "executor",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Executor(ctx, Host_executor{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      4,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "scheduler",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Scheduler(ctx, Host_scheduler{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc5e715360897ee3b,
+			MethodID:      5,
+			InterfaceName: "host.capnp:Host",
+			MethodName:    "cron",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Cron(ctx, Host_cron{call})
+		},
+	})
+
+	return methods
+}
+
+// Host_view holds the state for a server call to Host.view.
+// See server.Call for documentation.
+type Host_view struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Host_view) Args() Host_view_Params {
+	return Host_view_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Host_view) AllocResults() (Host_view_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_view_Results{Struct: r}, err
+}
+
+// Host_pubsub holds the state for a server call to Host.pubsub.
+// See server.Call for documentation.
+type Host_pubsub struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Host_pubsub) Args() Host_pubsub_Params {
+	return Host_pubsub_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Host_pubsub) AllocResults() (Host_pubsub_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_pubsub_Results{Struct: r}, err
+}
+
+// Host_anchor holds the state for a server call to Host.anchor.
+// See server.Call for documentation.
+type Host_anchor struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Host_anchor) Args() Host_anchor_Params {
+	return Host_anchor_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Host_anchor) AllocResults() (Host_anchor_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_anchor_Results{Struct: r}, err
+}
+
+// Host_executor holds the state for a server call to Host.executor.
+// See server.Call for documentation.
+type Host_executor struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Host_executor) Args() Host_executor_Params {
+	return Host_executor_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Host_executor) AllocResults() (Host_executor_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_executor_Results{Struct: r}, err
+}
+
+// Host_scheduler holds the state for a server call to Host.scheduler.
+// See server.Call for documentation.
+type Host_scheduler struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Host_scheduler) Args() Host_scheduler_Params {
+	return Host_scheduler_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Host_scheduler) AllocResults() (Host_scheduler_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_scheduler_Results{Struct: r}, err
+}
+
+// Host_cron holds the state for a server call to Host.cron.
+// See server.Call for documentation.
+type Host_cron struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Host_cron) Args() Host_cron_Params {
+	return Host_cron_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Host_cron) AllocResults() (Host_cron_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_cron_Results{Struct: r}, err
+}
+
+type Host_view_Params struct{ capnp.Struct }
+
+// Host_view_Params_TypeID is the unique identifier for the type Host_view_Params.
+const Host_view_Params_TypeID = 0xbb6879d8a23c3638
+
+func NewHost_view_Params(s *capnp.Segment) (Host_view_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_view_Params{st}, err
+}
+
+func NewRootHost_view_Params(s *capnp.Segment) (Host_view_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_view_Params{st}, err
+}
+
+func ReadRootHost_view_Params(msg *capnp.Message) (Host_view_Params, error) {
+	root, err := msg.Root()
+	return Host_view_Params{root.Struct()}, err
+}
+
+func (s Host_view_Params) String() string {
+	str, _ := text.Marshal(0xbb6879d8a23c3638, s.Struct)
+	return str
+}
+
+// Host_view_Params_List is a list of Host_view_Params.
+type Host_view_Params_List struct{ capnp.List }
+
+// NewHost_view_Params creates a new list of Host_view_Params.
+func NewHost_view_Params_List(s *capnp.Segment, sz int32) (Host_view_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Host_view_Params_List{l}, err
+}
+
+func (s Host_view_Params_List) At(i int) Host_view_Params { return Host_view_Params{s.List.Struct(i)} }
+
+func (s Host_view_Params_List) Set(i int, v Host_view_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_view_Params_List) String() string {
+	str, _ := text.MarshalList(0xbb6879d8a23c3638, s.List)
+	return str
+}
+
+// Host_view_Params_Future is a wrapper for a Host_view_Params promised by a client call.
+type Host_view_Params_Future struct{ *capnp.Future }
+
+func (p Host_view_Params_Future) Struct() (Host_view_Params, error) {
+	s, err := p.Future.Struct()
+	return Host_view_Params{s}, err
+}
+
+type Host_view_Results struct{ capnp.Struct }
+
+// Host_view_Results_TypeID is the unique identifier for the type Host_view_Results.
+const Host_view_Results_TypeID = 0xdbaa693eb97ab35a
+
+func NewHost_view_Results(s *capnp.Segment) (Host_view_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_view_Results{st}, err
+}
+
+func NewRootHost_view_Results(s *capnp.Segment) (Host_view_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_view_Results{st}, err
+}
+
+func ReadRootHost_view_Results(msg *capnp.Message) (Host_view_Results, error) {
+	root, err := msg.Root()
+	return Host_view_Results{root.Struct()}, err
+}
+
+func (s Host_view_Results) String() string {
+	str, _ := text.Marshal(0xdbaa693eb97ab35a, s.Struct)
+	return str
+}
+
+func (s Host_view_Results) View() (capnp.Ptr, error) {
+	return s.Struct.Ptr(0)
+}
+
+func (s Host_view_Results) HasView() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Host_view_Results) SetView(v capnp.Ptr) error {
+	return s.Struct.SetPtr(0, v)
+}
+
+// Host_view_Results_List is a list of Host_view_Results.
+type Host_view_Results_List struct{ capnp.List }
+
+// NewHost_view_Results creates a new list of Host_view_Results.
+func NewHost_view_Results_List(s *capnp.Segment, sz int32) (Host_view_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Host_view_Results_List{l}, err
+}
+
+func (s Host_view_Results_List) At(i int) Host_view_Results {
+	return Host_view_Results{s.List.Struct(i)}
+}
+
+func (s Host_view_Results_List) Set(i int, v Host_view_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_view_Results_List) String() string {
+	str, _ := text.MarshalList(0xdbaa693eb97ab35a, s.List)
+	return str
+}
+
+// Host_view_Results_Future is a wrapper for a Host_view_Results promised by a client call.
+type Host_view_Results_Future struct{ *capnp.Future }
+
+func (p Host_view_Results_Future) Struct() (Host_view_Results, error) {
+	s, err := p.Future.Struct()
+	return Host_view_Results{s}, err
+}
+
+func (p Host_view_Results_Future) View() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+type Host_pubsub_Params struct{ capnp.Struct }
+
+// Host_pubsub_Params_TypeID is the unique identifier for the type Host_pubsub_Params.
+const Host_pubsub_Params_TypeID = 0xaddd214f4e249c08
+
+func NewHost_pubsub_Params(s *capnp.Segment) (Host_pubsub_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_pubsub_Params{st}, err
+}
+
+func NewRootHost_pubsub_Params(s *capnp.Segment) (Host_pubsub_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_pubsub_Params{st}, err
+}
+
+func ReadRootHost_pubsub_Params(msg *capnp.Message) (Host_pubsub_Params, error) {
+	root, err := msg.Root()
+	return Host_pubsub_Params{root.Struct()}, err
+}
+
+func (s Host_pubsub_Params) String() string {
+	str, _ := text.Marshal(0xaddd214f4e249c08, s.Struct)
+	return str
+}
+
+// Host_pubsub_Params_List is a list of Host_pubsub_Params.
+type Host_pubsub_Params_List struct{ capnp.List }
+
+// NewHost_pubsub_Params creates a new list of Host_pubsub_Params.
+func NewHost_pubsub_Params_List(s *capnp.Segment, sz int32) (Host_pubsub_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Host_pubsub_Params_List{l}, err
+}
+
+func (s Host_pubsub_Params_List) At(i int) Host_pubsub_Params {
+	return Host_pubsub_Params{s.List.Struct(i)}
+}
+
+func (s Host_pubsub_Params_List) Set(i int, v Host_pubsub_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_pubsub_Params_List) String() string {
+	str, _ := text.MarshalList(0xaddd214f4e249c08, s.List)
+	return str
+}
+
+// Host_pubsub_Params_Future is a wrapper for a Host_pubsub_Params promised by a client call.
+type Host_pubsub_Params_Future struct{ *capnp.Future }
+
+func (p Host_pubsub_Params_Future) Struct() (Host_pubsub_Params, error) {
+	s, err := p.Future.Struct()
+	return Host_pubsub_Params{s}, err
+}
+
+type Host_pubsub_Results struct{ capnp.Struct }
+
+// Host_pubsub_Results_TypeID is the unique identifier for the type Host_pubsub_Results.
+const Host_pubsub_Results_TypeID = 0xf5218dc97e145e76
+
+func NewHost_pubsub_Results(s *capnp.Segment) (Host_pubsub_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_pubsub_Results{st}, err
+}
+
+func NewRootHost_pubsub_Results(s *capnp.Segment) (Host_pubsub_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_pubsub_Results{st}, err
+}
+
+func ReadRootHost_pubsub_Results(msg *capnp.Message) (Host_pubsub_Results, error) {
+	root, err := msg.Root()
+	return Host_pubsub_Results{root.Struct()}, err
+}
+
+func (s Host_pubsub_Results) String() string {
+	str, _ := text.Marshal(0xf5218dc97e145e76, s.Struct)
+	return str
+}
+
+func (s Host_pubsub_Results) Pubsub() (capnp.Ptr, error) {
+	return s.Struct.Ptr(0)
+}
+
+func (s Host_pubsub_Results) HasPubsub() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Host_pubsub_Results) SetPubsub(v capnp.Ptr) error {
+	return s.Struct.SetPtr(0, v)
+}
+
+// Host_pubsub_Results_List is a list of Host_pubsub_Results.
+type Host_pubsub_Results_List struct{ capnp.List }
+
+// NewHost_pubsub_Results creates a new list of Host_pubsub_Results.
+func NewHost_pubsub_Results_List(s *capnp.Segment, sz int32) (Host_pubsub_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Host_pubsub_Results_List{l}, err
+}
+
+func (s Host_pubsub_Results_List) At(i int) Host_pubsub_Results {
+	return Host_pubsub_Results{s.List.Struct(i)}
+}
+
+func (s Host_pubsub_Results_List) Set(i int, v Host_pubsub_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_pubsub_Results_List) String() string {
+	str, _ := text.MarshalList(0xf5218dc97e145e76, s.List)
+	return str
+}
+
+// Host_pubsub_Results_Future is a wrapper for a Host_pubsub_Results promised by a client call.
+type Host_pubsub_Results_Future struct{ *capnp.Future }
+
+func (p Host_pubsub_Results_Future) Struct() (Host_pubsub_Results, error) {
+	s, err := p.Future.Struct()
+	return Host_pubsub_Results{s}, err
+}
+
+func (p Host_pubsub_Results_Future) Pubsub() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+type Host_anchor_Params struct{ capnp.Struct }
+
+// Host_anchor_Params_TypeID is the unique identifier for the type Host_anchor_Params.
+const Host_anchor_Params_TypeID = 0xd0d7025f7aece185
+
+func NewHost_anchor_Params(s *capnp.Segment) (Host_anchor_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_anchor_Params{st}, err
+}
+
+func NewRootHost_anchor_Params(s *capnp.Segment) (Host_anchor_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_anchor_Params{st}, err
+}
+
+func ReadRootHost_anchor_Params(msg *capnp.Message) (Host_anchor_Params, error) {
+	root, err := msg.Root()
+	return Host_anchor_Params{root.Struct()}, err
+}
+
+func (s Host_anchor_Params) String() string {
+	str, _ := text.Marshal(0xd0d7025f7aece185, s.Struct)
+	return str
+}
+
+// Host_anchor_Params_List is a list of Host_anchor_Params.
+type Host_anchor_Params_List struct{ capnp.List }
+
+// NewHost_anchor_Params creates a new list of Host_anchor_Params.
+func NewHost_anchor_Params_List(s *capnp.Segment, sz int32) (Host_anchor_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Host_anchor_Params_List{l}, err
+}
+
+func (s Host_anchor_Params_List) At(i int) Host_anchor_Params {
+	return Host_anchor_Params{s.List.Struct(i)}
+}
+
+func (s Host_anchor_Params_List) Set(i int, v Host_anchor_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_anchor_Params_List) String() string {
+	str, _ := text.MarshalList(0xd0d7025f7aece185, s.List)
+	return str
+}
+
+// Host_anchor_Params_Future is a wrapper for a Host_anchor_Params promised by a client call.
+type Host_anchor_Params_Future struct{ *capnp.Future }
+
+func (p Host_anchor_Params_Future) Struct() (Host_anchor_Params, error) {
+	s, err := p.Future.Struct()
+	return Host_anchor_Params{s}, err
+}
+
+type Host_anchor_Results struct{ capnp.Struct }
+
+// Host_anchor_Results_TypeID is the unique identifier for the type Host_anchor_Results.
+const Host_anchor_Results_TypeID = 0x8307d6a99d8f4a19
+
+func NewHost_anchor_Results(s *capnp.Segment) (Host_anchor_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_anchor_Results{st}, err
+}
+
+func NewRootHost_anchor_Results(s *capnp.Segment) (Host_anchor_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_anchor_Results{st}, err
+}
+
+func ReadRootHost_anchor_Results(msg *capnp.Message) (Host_anchor_Results, error) {
+	root, err := msg.Root()
+	return Host_anchor_Results{root.Struct()}, err
+}
+
+func (s Host_anchor_Results) String() string {
+	str, _ := text.Marshal(0x8307d6a99d8f4a19, s.Struct)
+	return str
+}
+
+func (s Host_anchor_Results) Anchor() (capnp.Ptr, error) {
+	return s.Struct.Ptr(0)
+}
+
+func (s Host_anchor_Results) HasAnchor() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Host_anchor_Results) SetAnchor(v capnp.Ptr) error {
+	return s.Struct.SetPtr(0, v)
+}
+
+// Host_anchor_Results_List is a list of Host_anchor_Results.
+type Host_anchor_Results_List struct{ capnp.List }
+
+// NewHost_anchor_Results creates a new list of Host_anchor_Results.
+func NewHost_anchor_Results_List(s *capnp.Segment, sz int32) (Host_anchor_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Host_anchor_Results_List{l}, err
+}
+
+func (s Host_anchor_Results_List) At(i int) Host_anchor_Results {
+	return Host_anchor_Results{s.List.Struct(i)}
+}
+
+func (s Host_anchor_Results_List) Set(i int, v Host_anchor_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_anchor_Results_List) String() string {
+	str, _ := text.MarshalList(0x8307d6a99d8f4a19, s.List)
+	return str
+}
+
+// Host_anchor_Results_Future is a wrapper for a Host_anchor_Results promised by a client call.
+type Host_anchor_Results_Future struct{ *capnp.Future }
+
+func (p Host_anchor_Results_Future) Struct() (Host_anchor_Results, error) {
+	s, err := p.Future.Struct()
+	return Host_anchor_Results{s}, err
+}
+
+func (p Host_anchor_Results_Future) Anchor() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+type Host_executor_Params struct{ capnp.Struct }
+
+// Host_executor_Params_TypeID is the unique identifier for the type Host_executor_Params.
+const Host_executor_Params_TypeID = 0xff72167d6da5441d
+
+func NewHost_executor_Params(s *capnp.Segment) (Host_executor_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_executor_Params{st}, err
+}
+
+func NewRootHost_executor_Params(s *capnp.Segment) (Host_executor_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_executor_Params{st}, err
+}
+
+func ReadRootHost_executor_Params(msg *capnp.Message) (Host_executor_Params, error) {
+	root, err := msg.Root()
+	return Host_executor_Params{root.Struct()}, err
+}
+
+func (s Host_executor_Params) String() string {
+	str, _ := text.Marshal(0xff72167d6da5441d, s.Struct)
+	return str
+}
+
+// Host_executor_Params_List is a list of Host_executor_Params.
+type Host_executor_Params_List struct{ capnp.List }
+
+// NewHost_executor_Params creates a new list of Host_executor_Params.
+func NewHost_executor_Params_List(s *capnp.Segment, sz int32) (Host_executor_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Host_executor_Params_List{l}, err
+}
+
+func (s Host_executor_Params_List) At(i int) Host_executor_Params {
+	return Host_executor_Params{s.List.Struct(i)}
+}
+
+func (s Host_executor_Params_List) Set(i int, v Host_executor_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_executor_Params_List) String() string {
+	str, _ := text.MarshalList(0xff72167d6da5441d, s.List)
+	return str
+}
+
+// Host_executor_Params_Future is a wrapper for a Host_executor_Params promised by a client call.
+type Host_executor_Params_Future struct{ *capnp.Future }
+
+func (p Host_executor_Params_Future) Struct() (Host_executor_Params, error) {
+	s, err := p.Future.Struct()
+	return Host_executor_Params{s}, err
+}
+
+type Host_executor_Results struct{ capnp.Struct }
+
+// Host_executor_Results_TypeID is the unique identifier for the type Host_executor_Results.
+const Host_executor_Results_TypeID = 0xd86d221e404ce342
+
+func NewHost_executor_Results(s *capnp.Segment) (Host_executor_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_executor_Results{st}, err
+}
+
+func NewRootHost_executor_Results(s *capnp.Segment) (Host_executor_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_executor_Results{st}, err
+}
+
+func ReadRootHost_executor_Results(msg *capnp.Message) (Host_executor_Results, error) {
+	root, err := msg.Root()
+	return Host_executor_Results{root.Struct()}, err
+}
+
+func (s Host_executor_Results) String() string {
+	str, _ := text.Marshal(0xd86d221e404ce342, s.Struct)
+	return str
+}
+
+func (s Host_executor_Results) Executor() (capnp.Ptr, error) {
+	return s.Struct.Ptr(0)
+}
+
+func (s Host_executor_Results) HasExecutor() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Host_executor_Results) SetExecutor(v capnp.Ptr) error {
+	return s.Struct.SetPtr(0, v)
+}
+
+// Host_executor_Results_List is a list of Host_executor_Results.
+type Host_executor_Results_List struct{ capnp.List }
+
+// NewHost_executor_Results creates a new list of Host_executor_Results.
+func NewHost_executor_Results_List(s *capnp.Segment, sz int32) (Host_executor_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Host_executor_Results_List{l}, err
+}
+
+func (s Host_executor_Results_List) At(i int) Host_executor_Results {
+	return Host_executor_Results{s.List.Struct(i)}
+}
+
+func (s Host_executor_Results_List) Set(i int, v Host_executor_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_executor_Results_List) String() string {
+	str, _ := text.MarshalList(0xd86d221e404ce342, s.List)
+	return str
+}
+
+// Host_executor_Results_Future is a wrapper for a Host_executor_Results promised by a client call.
+type Host_executor_Results_Future struct{ *capnp.Future }
+
+func (p Host_executor_Results_Future) Struct() (Host_executor_Results, error) {
+	s, err := p.Future.Struct()
+	return Host_executor_Results{s}, err
+}
+
+func (p Host_executor_Results_Future) Executor() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+type Host_scheduler_Params struct{ capnp.Struct }
+
+// Host_scheduler_Params_TypeID is the unique identifier for the type Host_scheduler_Params.
+const Host_scheduler_Params_TypeID = 0xc9ab0ffe97b4db85
+
+func NewHost_scheduler_Params(s *capnp.Segment) (Host_scheduler_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_scheduler_Params{st}, err
+}
+
+func NewRootHost_scheduler_Params(s *capnp.Segment) (Host_scheduler_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_scheduler_Params{st}, err
+}
+
+func ReadRootHost_scheduler_Params(msg *capnp.Message) (Host_scheduler_Params, error) {
+	root, err := msg.Root()
+	return Host_scheduler_Params{root.Struct()}, err
+}
+
+func (s Host_scheduler_Params) String() string {
+	str, _ := text.Marshal(0xc9ab0ffe97b4db85, s.Struct)
+	return str
+}
+
+// Host_scheduler_Params_List is a list of Host_scheduler_Params.
+type Host_scheduler_Params_List struct{ capnp.List }
+
+// NewHost_scheduler_Params creates a new list of Host_scheduler_Params.
+func NewHost_scheduler_Params_List(s *capnp.Segment, sz int32) (Host_scheduler_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Host_scheduler_Params_List{l}, err
+}
+
+func (s Host_scheduler_Params_List) At(i int) Host_scheduler_Params {
+	return Host_scheduler_Params{s.List.Struct(i)}
+}
+
+func (s Host_scheduler_Params_List) Set(i int, v Host_scheduler_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_scheduler_Params_List) String() string {
+	str, _ := text.MarshalList(0xc9ab0ffe97b4db85, s.List)
+	return str
+}
+
+// Host_scheduler_Params_Future is a wrapper for a Host_scheduler_Params promised by a client call.
+type Host_scheduler_Params_Future struct{ *capnp.Future }
+
+func (p Host_scheduler_Params_Future) Struct() (Host_scheduler_Params, error) {
+	s, err := p.Future.Struct()
+	return Host_scheduler_Params{s}, err
+}
+
+type Host_scheduler_Results struct{ capnp.Struct }
+
+// Host_scheduler_Results_TypeID is the unique identifier for the type Host_scheduler_Results.
+const Host_scheduler_Results_TypeID = 0xe9fb18b9a2ace451
+
+func NewHost_scheduler_Results(s *capnp.Segment) (Host_scheduler_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_scheduler_Results{st}, err
+}
+
+func NewRootHost_scheduler_Results(s *capnp.Segment) (Host_scheduler_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_scheduler_Results{st}, err
+}
+
+func ReadRootHost_scheduler_Results(msg *capnp.Message) (Host_scheduler_Results, error) {
+	root, err := msg.Root()
+	return Host_scheduler_Results{root.Struct()}, err
+}
+
+func (s Host_scheduler_Results) String() string {
+	str, _ := text.Marshal(0xe9fb18b9a2ace451, s.Struct)
+	return str
+}
+
+func (s Host_scheduler_Results) Scheduler() (capnp.Ptr, error) {
+	return s.Struct.Ptr(0)
+}
+
+func (s Host_scheduler_Results) HasScheduler() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Host_scheduler_Results) SetScheduler(v capnp.Ptr) error {
+	return s.Struct.SetPtr(0, v)
+}
+
+// Host_scheduler_Results_List is a list of Host_scheduler_Results.
+type Host_scheduler_Results_List struct{ capnp.List }
+
+// NewHost_scheduler_Results creates a new list of Host_scheduler_Results.
+func NewHost_scheduler_Results_List(s *capnp.Segment, sz int32) (Host_scheduler_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Host_scheduler_Results_List{l}, err
+}
+
+func (s Host_scheduler_Results_List) At(i int) Host_scheduler_Results {
+	return Host_scheduler_Results{s.List.Struct(i)}
+}
+
+func (s Host_scheduler_Results_List) Set(i int, v Host_scheduler_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_scheduler_Results_List) String() string {
+	str, _ := text.MarshalList(0xe9fb18b9a2ace451, s.List)
+	return str
+}
+
+// Host_scheduler_Results_Future is a wrapper for a Host_scheduler_Results promised by a client call.
+type Host_scheduler_Results_Future struct{ *capnp.Future }
+
+func (p Host_scheduler_Results_Future) Struct() (Host_scheduler_Results, error) {
+	s, err := p.Future.Struct()
+	return Host_scheduler_Results{s}, err
+}
+
+func (p Host_scheduler_Results_Future) Scheduler() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+type Host_cron_Params struct{ capnp.Struct }
+
+// Host_cron_Params_TypeID is the unique identifier for the type Host_cron_Params.
+const Host_cron_Params_TypeID = 0xf8938efa1d810638
+
+func NewHost_cron_Params(s *capnp.Segment) (Host_cron_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_cron_Params{st}, err
+}
+
+func NewRootHost_cron_Params(s *capnp.Segment) (Host_cron_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Host_cron_Params{st}, err
+}
+
+func ReadRootHost_cron_Params(msg *capnp.Message) (Host_cron_Params, error) {
+	root, err := msg.Root()
+	return Host_cron_Params{root.Struct()}, err
+}
+
+func (s Host_cron_Params) String() string {
+	str, _ := text.Marshal(0xf8938efa1d810638, s.Struct)
+	return str
+}
+
+// Host_cron_Params_List is a list of Host_cron_Params.
+type Host_cron_Params_List struct{ capnp.List }
+
+// NewHost_cron_Params creates a new list of Host_cron_Params.
+func NewHost_cron_Params_List(s *capnp.Segment, sz int32) (Host_cron_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Host_cron_Params_List{l}, err
+}
+
+func (s Host_cron_Params_List) At(i int) Host_cron_Params { return Host_cron_Params{s.List.Struct(i)} }
+
+func (s Host_cron_Params_List) Set(i int, v Host_cron_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_cron_Params_List) String() string {
+	str, _ := text.MarshalList(0xf8938efa1d810638, s.List)
+	return str
+}
+
+// Host_cron_Params_Future is a wrapper for a Host_cron_Params promised by a client call.
+type Host_cron_Params_Future struct{ *capnp.Future }
+
+func (p Host_cron_Params_Future) Struct() (Host_cron_Params, error) {
+	s, err := p.Future.Struct()
+	return Host_cron_Params{s}, err
+}
+
+type Host_cron_Results struct{ capnp.Struct }
+
+// Host_cron_Results_TypeID is the unique identifier for the type Host_cron_Results.
+const Host_cron_Results_TypeID = 0xefa091f79d3a92bb
+
+func NewHost_cron_Results(s *capnp.Segment) (Host_cron_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_cron_Results{st}, err
+}
+
+func NewRootHost_cron_Results(s *capnp.Segment) (Host_cron_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Host_cron_Results{st}, err
+}
+
+func ReadRootHost_cron_Results(msg *capnp.Message) (Host_cron_Results, error) {
+	root, err := msg.Root()
+	return Host_cron_Results{root.Struct()}, err
+}
+
+func (s Host_cron_Results) String() string {
+	str, _ := text.Marshal(0xefa091f79d3a92bb, s.Struct)
+	return str
+}
+
+func (s Host_cron_Results) Cron() (capnp.Ptr, error) {
+	return s.Struct.Ptr(0)
+}
+
+func (s Host_cron_Results) HasCron() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Host_cron_Results) SetCron(v capnp.Ptr) error {
+	return s.Struct.SetPtr(0, v)
+}
+
+// Host_cron_Results_List is a list of Host_cron_Results.
+type Host_cron_Results_List struct{ capnp.List }
+
+// NewHost_cron_Results creates a new list of Host_cron_Results.
+func NewHost_cron_Results_List(s *capnp.Segment, sz int32) (Host_cron_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Host_cron_Results_List{l}, err
+}
+
+func (s Host_cron_Results_List) At(i int) Host_cron_Results {
+	return Host_cron_Results{s.List.Struct(i)}
+}
+
+func (s Host_cron_Results_List) Set(i int, v Host_cron_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Host_cron_Results_List) String() string {
+	str, _ := text.MarshalList(0xefa091f79d3a92bb, s.List)
+	return str
+}
+
+// Host_cron_Results_Future is a wrapper for a Host_cron_Results promised by a client call.
+type Host_cron_Results_Future struct{ *capnp.Future }
+
+func (p Host_cron_Results_Future) Struct() (Host_cron_Results, error) {
+	s, err := p.Future.Struct()
+	return Host_cron_Results{s}, err
+}
+
+func (p Host_cron_Results_Future) Cron() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+const schema_8cd363830e7847ea = "x\xda\x8c\x92MH\x15_\x18\xc6\xdf\xf7\x9c3\x8e\xfc" +
+	"\xff\xd9\xed8\x1a\xb6\xb00\xee\xcaE(\x92\xc8-\xba" +
+	"S\x08\x85\xf4q\xe7B\x9b\x16\xc58\x0d\\\xc1\xeb\x95" +
+	"\x19\xc7L(0\x91\x08\xb2\xa8\x16\"$\x94\xe0\xa2\xaf" +
+	"E\xd4F\xa4\x85\x0b\x17\xee\x82\xa8\x0c\x91\xe8\x03B\xe9" +
+	"\x03\x82\xa8\x0c\x9a83\xce\xdc\xe3G\xdan\xe0y\xe7" +
+	"9\xcf\xfb{\xde\xba!\xd4I\xbd\xf2Z\x010\x9a\x95" +
+	"\x12\x7f[\xcb\xd5\x91;/\xd4~\xe0[\x10@A\x15" +
+	"@{N\x16\x01\xb5W$\x0d\xe8\x97\xdeL\x1e=V" +
+	"3\xf7 \xd4\x99\x90\x7f\x92\xaf\xc0\xfc\xa6\xc6\xbd\xa33" +
+	"gs\x13\x920G\xde\x02\xf3\xf7|\x1e*m\xac\xfc" +
+	"0\x05\xfc\x7f\xea/\x1c\xec\xd9\xdco=\xbb\x0c\x80\xda" +
+	"$\xb9\xa0M\x11\x15\xa0a\x92\\Dm\x9c\xaa\x00\xfe" +
+	"\xc0\xec\xe3\xa1\xdf\x89{\xd3E\x9b\x86\xdb\xf4?\x04\xe6" +
+	"\x0f\xbc\xf9\xd8{\x8a\xbc|*=p\x89\x8a\x97\x0f\xbc" +
+	";\xaco\xdf\x99\x9f\x91\"7\xe4\xc5?\xa8yTd" +
+	">\xf1\xa8w|_\xdb\xddYy\xa7a\xba\x00\xa8\x8d" +
+	"\x04\xba\xf1\xfe\xfe\xe8x\xd5\xafy\xd9\xe0\x09-\x17\x06" +
+	"S\xc1\xc0\xc4\xf5\xd4\xc8\xf7k\xb7\xbe\xc8\x06\xf3\x81\xc1" +
+	"\xa7@\xef>Yq~z\xb0\xe6\x9b\xac\x971\x01\x8d" +
+	"3\xa17\x95\xf4U/^\xb9\xf1C\x8a^\xcf\x04\x9b" +
+	"\xea\xe6\xb1\xfc\xb9\xad\x8e/m[\xc9\x08B\x9d\x9f+" +
+	"\xb8]\xbb,\xb3\x93tt\xa6\x0e\x89o\xb3\xc3\xca\x15" +
+	"\x9cd6m\xbb^{\x97k0\xca\x00\x18\x02\xf0\xb2" +
+	"\x14\x80QJ\xd1\xa8 \x98\x0e\xc7\xb0\x9cQ@,\x07" +
+	"\\m\xd4\xe9\xb5\xba^k2\xb3\xc3t\xcc\xbc\xbbZ" +
+	"\xefn\xb3\xcf$3\xa6\xa3\xca*.\xa9\x90A4\xaa" +
+	"\xa8\x02\x10\x17\x8e\x11^\xfe\xb0\x16\x08\x1fS\xb1x%" +
+	"\x18\x91\xe1\xc3) |PE\x12\xf7\x88\xd1\xa9\xf1>" +
+	"\xa1y*\xd2\x98\x07F\x9d\xf2\xb6\x16 \xdcT\x91\xc5" +
+	"\x97\x81Q]\xfcx\x16\x08?\xa2\xa2\x12\x03\xc6\xa8)" +
+	"\xbe_d\xd9\xad&\xc46:\xa6\xc3\xa5\xf5\x88\x8f\x8e" +
+	"\xbe\xddc[^W\xc1\x01\x00\x1d}\xd7\xca\xd9\xa7\xbd" +
+	"v\x1b\xd0\xd11a9\x85\x0e\x1d3X\xa4G#:" +
+	"\xd1\xa0#\x10\x99y\x17\xe0\xafU\xad$\x1c{DO" +
+	"'\xb3a\x97\x00r\x9b-\x00\xc6&\x8aF\x15Y\x16" +
+	"r\xbdJ\x83\xca\xb2\xb6\x9bXy\x19\xb5\xc5\xcb\x08H" +
+	"\xaca\xb2\xc6fa,\\\xe6\x94\x95RI\xb0\xd6K" +
+	"%(n\x98J\x0c\xfd\xc3\xb5nt\xf6\xe1\xd8\x86i" +
+	"V\x9e\xf5\xeaJ\x96Z\xfd\x13\x00\x00\xff\xff\xa7Nt" +
+	"\xc8"
+
+func init() {
+	schemas.Register(schema_8cd363830e7847ea,
+		0x8307d6a99d8f4a19,
+		0xaddd214f4e249c08,
+		0xbb6879d8a23c3638,
+		0xc5e715360897ee3b,
+		0xc9ab0ffe97b4db85,
+		0xd0d7025f7aece185,
+		0xd86d221e404ce342,
+		0xdbaa693eb97ab35a,
+		0xe9fb18b9a2ace451,
+		0xefa091f79d3a92bb,
+		0xf5218dc97e145e76,
+		0xf8938efa1d810638,
+		0xff72167d6da5441d)
+}
@@ -0,0 +1,6507 @@
+// Code generated by capnpc-go. DO NOT EDIT.
+
+package process
+
+import (
+	capnp "capnproto.org/go/capnp/v3"
+	text "capnproto.org/go/capnp/v3/encoding/text"
+	schemas "capnproto.org/go/capnp/v3/schemas"
+	server "capnproto.org/go/capnp/v3/server"
+	context "context"
+	math "math"
+)
+
+type Executor struct{ Client *capnp.Client }
+
+// Executor_TypeID is the unique identifier for the type Executor.
+const Executor_TypeID = 0x952a4b2e869a6f43
+
+func (c Executor) Exec(ctx context.Context, params func(Executor_exec_Params) error) (Executor_exec_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x952a4b2e869a6f43,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Executor",
+			MethodName:    "exec",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 3}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_exec_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Executor_exec_Results_Future{Future: ans.Future()}, release
+}
+func (c Executor) Spawn(ctx context.Context, params func(Executor_spawn_Params) error) (Executor_spawn_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x952a4b2e869a6f43,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Executor",
+			MethodName:    "spawn",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 3}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_spawn_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Executor_spawn_Results_Future{Future: ans.Future()}, release
+}
+func (c Executor) Lookup(ctx context.Context, params func(Executor_lookup_Params) error) (Executor_lookup_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x952a4b2e869a6f43,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Executor",
+			MethodName:    "lookup",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Executor_lookup_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Executor_lookup_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Executor) AddRef() Executor {
+	return Executor{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Executor) Release() {
+	c.Client.Release()
+}
+
+// A Executor_Server is a Executor with a local implementation.
+type Executor_Server interface {
+	Exec(context.Context, Executor_exec) error
+
+	Spawn(context.Context, Executor_spawn) error
+
+	Lookup(context.Context, Executor_lookup) error
+}
+
+// Executor_NewServer creates a new Server from an implementation of Executor_Server.
+func Executor_NewServer(s Executor_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Executor_Methods(nil, s), s, c, policy)
+}
+
+// Executor_ServerToClient creates a new Client from an implementation of Executor_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Executor_ServerToClient(s Executor_Server, policy *server.Policy) Executor {
+	return Executor{Client: capnp.NewClient(Executor_NewServer(s, policy))}
+}
+
+// Executor_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Executor_Methods(methods []server.Method, s Executor_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 3)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x952a4b2e869a6f43,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Executor",
+			MethodName:    "exec",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Exec(ctx, Executor_exec{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x952a4b2e869a6f43,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Executor",
+			MethodName:    "spawn",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Spawn(ctx, Executor_spawn{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x952a4b2e869a6f43,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Executor",
+			MethodName:    "lookup",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Lookup(ctx, Executor_lookup{call})
+		},
+	})
+
+	return methods
+}
+
+// Executor_exec holds the state for a server call to Executor.exec.
+// See server.Call for documentation.
+type Executor_exec struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_exec) Args() Executor_exec_Params {
+	return Executor_exec_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_exec) AllocResults() (Executor_exec_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_exec_Results{Struct: r}, err
+}
+
+// Executor_spawn holds the state for a server call to Executor.spawn.
+// See server.Call for documentation.
+type Executor_spawn struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_spawn) Args() Executor_spawn_Params {
+	return Executor_spawn_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_spawn) AllocResults() (Executor_spawn_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_spawn_Results{Struct: r}, err
+}
+
+// Executor_lookup holds the state for a server call to Executor.lookup.
+// See server.Call for documentation.
+type Executor_lookup struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Executor_lookup) Args() Executor_lookup_Params {
+	return Executor_lookup_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Executor_lookup) AllocResults() (Executor_lookup_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_lookup_Results{Struct: r}, err
+}
+
+type Executor_exec_Params struct{ capnp.Struct }
+
+// Executor_exec_Params_TypeID is the unique identifier for the type Executor_exec_Params.
+const Executor_exec_Params_TypeID = 0xc770c09d25b47db6
+
+func NewExecutor_exec_Params(s *capnp.Segment) (Executor_exec_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Executor_exec_Params{st}, err
+}
+
+func NewRootExecutor_exec_Params(s *capnp.Segment) (Executor_exec_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Executor_exec_Params{st}, err
+}
+
+func ReadRootExecutor_exec_Params(msg *capnp.Message) (Executor_exec_Params, error) {
+	root, err := msg.Root()
+	return Executor_exec_Params{root.Struct()}, err
+}
+
+func (s Executor_exec_Params) String() string {
+	str, _ := text.Marshal(0xc770c09d25b47db6, s.Struct)
+	return str
+}
+
+func (s Executor_exec_Params) Cmd() (Command, error) {
+	p, err := s.Struct.Ptr(0)
+	return Command{Struct: p.Struct()}, err
+}
+
+func (s Executor_exec_Params) HasCmd() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Executor_exec_Params) SetCmd(v Command) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewCmd sets the cmd field to a newly
+// allocated Command struct, preferring placement in s's segment.
+func (s Executor_exec_Params) NewCmd() (Command, error) {
+	ss, err := NewCommand(s.Struct.Segment())
+	if err != nil {
+		return Command{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Executor_exec_Params) Stdout() Writer {
+	p, _ := s.Struct.Ptr(1)
+	return Writer{Client: p.Interface().Client()}
+}
+
+func (s Executor_exec_Params) HasStdout() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Executor_exec_Params) SetStdout(v Writer) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(1, in.ToPtr())
+}
+
+func (s Executor_exec_Params) Stderr() Writer {
+	p, _ := s.Struct.Ptr(2)
+	return Writer{Client: p.Interface().Client()}
+}
+
+func (s Executor_exec_Params) HasStderr() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Executor_exec_Params) SetStderr(v Writer) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(2, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(2, in.ToPtr())
+}
+
+// Executor_exec_Params_List is a list of Executor_exec_Params.
+type Executor_exec_Params_List struct{ capnp.List }
+
+// NewExecutor_exec_Params creates a new list of Executor_exec_Params.
+func NewExecutor_exec_Params_List(s *capnp.Segment, sz int32) (Executor_exec_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return Executor_exec_Params_List{l}, err
+}
+
+func (s Executor_exec_Params_List) At(i int) Executor_exec_Params {
+	return Executor_exec_Params{s.List.Struct(i)}
+}
+
+func (s Executor_exec_Params_List) Set(i int, v Executor_exec_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Executor_exec_Params_List) String() string {
+	str, _ := text.MarshalList(0xc770c09d25b47db6, s.List)
+	return str
+}
+
+// Executor_exec_Params_Future is a wrapper for a Executor_exec_Params promised by a client call.
+type Executor_exec_Params_Future struct{ *capnp.Future }
+
+func (p Executor_exec_Params_Future) Struct() (Executor_exec_Params, error) {
+	s, err := p.Future.Struct()
+	return Executor_exec_Params{s}, err
+}
+
+func (p Executor_exec_Params_Future) Cmd() Command_Future {
+	return Command_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Executor_exec_Params_Future) Stdout() Writer {
+	return Writer{Client: p.Future.Field(1, nil).Client()}
+}
+
+func (p Executor_exec_Params_Future) Stderr() Writer {
+	return Writer{Client: p.Future.Field(2, nil).Client()}
+}
+
+type Executor_exec_Results struct{ capnp.Struct }
+
+// Executor_exec_Results_TypeID is the unique identifier for the type Executor_exec_Results.
+const Executor_exec_Results_TypeID = 0x8ba9e3c5fd0f0545
+
+func NewExecutor_exec_Results(s *capnp.Segment) (Executor_exec_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_exec_Results{st}, err
+}
+
+func NewRootExecutor_exec_Results(s *capnp.Segment) (Executor_exec_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_exec_Results{st}, err
+}
+
+func ReadRootExecutor_exec_Results(msg *capnp.Message) (Executor_exec_Results, error) {
+	root, err := msg.Root()
+	return Executor_exec_Results{root.Struct()}, err
+}
+
+func (s Executor_exec_Results) String() string {
+	str, _ := text.Marshal(0x8ba9e3c5fd0f0545, s.Struct)
+	return str
+}
+
+func (s Executor_exec_Results) Proc() Process {
+	p, _ := s.Struct.Ptr(0)
+	return Process{Client: p.Interface().Client()}
+}
+
+func (s Executor_exec_Results) HasProc() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Executor_exec_Results) SetProc(v Process) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+// Executor_exec_Results_List is a list of Executor_exec_Results.
+type Executor_exec_Results_List struct{ capnp.List }
+
+// NewExecutor_exec_Results creates a new list of Executor_exec_Results.
+func NewExecutor_exec_Results_List(s *capnp.Segment, sz int32) (Executor_exec_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Executor_exec_Results_List{l}, err
+}
+
+func (s Executor_exec_Results_List) At(i int) Executor_exec_Results {
+	return Executor_exec_Results{s.List.Struct(i)}
+}
+
+func (s Executor_exec_Results_List) Set(i int, v Executor_exec_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Executor_exec_Results_List) String() string {
+	str, _ := text.MarshalList(0x8ba9e3c5fd0f0545, s.List)
+	return str
+}
+
+// Executor_exec_Results_Future is a wrapper for a Executor_exec_Results promised by a client call.
+type Executor_exec_Results_Future struct{ *capnp.Future }
+
+func (p Executor_exec_Results_Future) Struct() (Executor_exec_Results, error) {
+	s, err := p.Future.Struct()
+	return Executor_exec_Results{s}, err
+}
+
+func (p Executor_exec_Results_Future) Proc() Process {
+	return Process{Client: p.Future.Field(0, nil).Client()}
+}
+
+type Executor_spawn_Params struct{ capnp.Struct }
+
+// Executor_spawn_Params_TypeID is the unique identifier for the type Executor_spawn_Params.
+const Executor_spawn_Params_TypeID = 0x81d355122829226f
+
+func NewExecutor_spawn_Params(s *capnp.Segment) (Executor_spawn_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Executor_spawn_Params{st}, err
+}
+
+func NewRootExecutor_spawn_Params(s *capnp.Segment) (Executor_spawn_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Executor_spawn_Params{st}, err
+}
+
+func ReadRootExecutor_spawn_Params(msg *capnp.Message) (Executor_spawn_Params, error) {
+	root, err := msg.Root()
+	return Executor_spawn_Params{root.Struct()}, err
+}
+
+func (s Executor_spawn_Params) String() string {
+	str, _ := text.Marshal(0x81d355122829226f, s.Struct)
+	return str
+}
+
+func (s Executor_spawn_Params) Module() (Module, error) {
+	p, err := s.Struct.Ptr(0)
+	return Module{Struct: p.Struct()}, err
+}
+
+func (s Executor_spawn_Params) HasModule() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Executor_spawn_Params) SetModule(v Module) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewModule sets the module field to a newly
+// allocated Module struct, preferring placement in s's segment.
+func (s Executor_spawn_Params) NewModule() (Module, error) {
+	ss, err := NewModule(s.Struct.Segment())
+	if err != nil {
+		return Module{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Executor_spawn_Params) Stdout() Writer {
+	p, _ := s.Struct.Ptr(1)
+	return Writer{Client: p.Interface().Client()}
+}
+
+func (s Executor_spawn_Params) HasStdout() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Executor_spawn_Params) SetStdout(v Writer) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(1, in.ToPtr())
+}
+
+func (s Executor_spawn_Params) Stderr() Writer {
+	p, _ := s.Struct.Ptr(2)
+	return Writer{Client: p.Interface().Client()}
+}
+
+func (s Executor_spawn_Params) HasStderr() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Executor_spawn_Params) SetStderr(v Writer) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(2, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(2, in.ToPtr())
+}
+
+// Executor_spawn_Params_List is a list of Executor_spawn_Params.
+type Executor_spawn_Params_List struct{ capnp.List }
+
+// NewExecutor_spawn_Params creates a new list of Executor_spawn_Params.
+func NewExecutor_spawn_Params_List(s *capnp.Segment, sz int32) (Executor_spawn_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return Executor_spawn_Params_List{l}, err
+}
+
+func (s Executor_spawn_Params_List) At(i int) Executor_spawn_Params {
+	return Executor_spawn_Params{s.List.Struct(i)}
+}
+
+func (s Executor_spawn_Params_List) Set(i int, v Executor_spawn_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Executor_spawn_Params_List) String() string {
+	str, _ := text.MarshalList(0x81d355122829226f, s.List)
+	return str
+}
+
+// Executor_spawn_Params_Future is a wrapper for a Executor_spawn_Params promised by a client call.
+type Executor_spawn_Params_Future struct{ *capnp.Future }
+
+func (p Executor_spawn_Params_Future) Struct() (Executor_spawn_Params, error) {
+	s, err := p.Future.Struct()
+	return Executor_spawn_Params{s}, err
+}
+
+func (p Executor_spawn_Params_Future) Module() Module_Future {
+	return Module_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Executor_spawn_Params_Future) Stdout() Writer {
+	return Writer{Client: p.Future.Field(1, nil).Client()}
+}
+
+func (p Executor_spawn_Params_Future) Stderr() Writer {
+	return Writer{Client: p.Future.Field(2, nil).Client()}
+}
+
+type Executor_spawn_Results struct{ capnp.Struct }
+
+// Executor_spawn_Results_TypeID is the unique identifier for the type Executor_spawn_Results.
+const Executor_spawn_Results_TypeID = 0xb62a7ac11e3a40e1
+
+func NewExecutor_spawn_Results(s *capnp.Segment) (Executor_spawn_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_spawn_Results{st}, err
+}
+
+func NewRootExecutor_spawn_Results(s *capnp.Segment) (Executor_spawn_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_spawn_Results{st}, err
+}
+
+func ReadRootExecutor_spawn_Results(msg *capnp.Message) (Executor_spawn_Results, error) {
+	root, err := msg.Root()
+	return Executor_spawn_Results{root.Struct()}, err
+}
+
+func (s Executor_spawn_Results) String() string {
+	str, _ := text.Marshal(0xb62a7ac11e3a40e1, s.Struct)
+	return str
+}
+
+func (s Executor_spawn_Results) Proc() Process {
+	p, _ := s.Struct.Ptr(0)
+	return Process{Client: p.Interface().Client()}
+}
+
+func (s Executor_spawn_Results) HasProc() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Executor_spawn_Results) SetProc(v Process) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+// Executor_spawn_Results_List is a list of Executor_spawn_Results.
+type Executor_spawn_Results_List struct{ capnp.List }
+
+// NewExecutor_spawn_Results creates a new list of Executor_spawn_Results.
+func NewExecutor_spawn_Results_List(s *capnp.Segment, sz int32) (Executor_spawn_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Executor_spawn_Results_List{l}, err
+}
+
+func (s Executor_spawn_Results_List) At(i int) Executor_spawn_Results {
+	return Executor_spawn_Results{s.List.Struct(i)}
+}
+
+func (s Executor_spawn_Results_List) Set(i int, v Executor_spawn_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Executor_spawn_Results_List) String() string {
+	str, _ := text.MarshalList(0xb62a7ac11e3a40e1, s.List)
+	return str
+}
+
+// Executor_spawn_Results_Future is a wrapper for a Executor_spawn_Results promised by a client call.
+type Executor_spawn_Results_Future struct{ *capnp.Future }
+
+func (p Executor_spawn_Results_Future) Struct() (Executor_spawn_Results, error) {
+	s, err := p.Future.Struct()
+	return Executor_spawn_Results{s}, err
+}
+
+func (p Executor_spawn_Results_Future) Proc() Process {
+	return Process{Client: p.Future.Field(0, nil).Client()}
+}
+
+type Executor_lookup_Params struct{ capnp.Struct }
+
+// Executor_lookup_Params_TypeID is the unique identifier for the type Executor_lookup_Params.
+const Executor_lookup_Params_TypeID = 0x946b28c9fe2f1034
+
+func NewExecutor_lookup_Params(s *capnp.Segment) (Executor_lookup_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_lookup_Params{st}, err
+}
+
+func NewRootExecutor_lookup_Params(s *capnp.Segment) (Executor_lookup_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_lookup_Params{st}, err
+}
+
+func ReadRootExecutor_lookup_Params(msg *capnp.Message) (Executor_lookup_Params, error) {
+	root, err := msg.Root()
+	return Executor_lookup_Params{root.Struct()}, err
+}
+
+func (s Executor_lookup_Params) String() string {
+	str, _ := text.Marshal(0x946b28c9fe2f1034, s.Struct)
+	return str
+}
+
+func (s Executor_lookup_Params) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Executor_lookup_Params) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Executor_lookup_Params) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Executor_lookup_Params) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Executor_lookup_Params_List is a list of Executor_lookup_Params.
+type Executor_lookup_Params_List struct{ capnp.List }
+
+// NewExecutor_lookup_Params creates a new list of Executor_lookup_Params.
+func NewExecutor_lookup_Params_List(s *capnp.Segment, sz int32) (Executor_lookup_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Executor_lookup_Params_List{l}, err
+}
+
+func (s Executor_lookup_Params_List) At(i int) Executor_lookup_Params {
+	return Executor_lookup_Params{s.List.Struct(i)}
+}
+
+func (s Executor_lookup_Params_List) Set(i int, v Executor_lookup_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Executor_lookup_Params_List) String() string {
+	str, _ := text.MarshalList(0x946b28c9fe2f1034, s.List)
+	return str
+}
+
+// Executor_lookup_Params_Future is a wrapper for a Executor_lookup_Params promised by a client call.
+type Executor_lookup_Params_Future struct{ *capnp.Future }
+
+func (p Executor_lookup_Params_Future) Struct() (Executor_lookup_Params, error) {
+	s, err := p.Future.Struct()
+	return Executor_lookup_Params{s}, err
+}
+
+type Executor_lookup_Results struct{ capnp.Struct }
+
+// Executor_lookup_Results_TypeID is the unique identifier for the type Executor_lookup_Results.
+const Executor_lookup_Results_TypeID = 0xbaa36489cf773057
+
+func NewExecutor_lookup_Results(s *capnp.Segment) (Executor_lookup_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_lookup_Results{st}, err
+}
+
+func NewRootExecutor_lookup_Results(s *capnp.Segment) (Executor_lookup_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Executor_lookup_Results{st}, err
+}
+
+func ReadRootExecutor_lookup_Results(msg *capnp.Message) (Executor_lookup_Results, error) {
+	root, err := msg.Root()
+	return Executor_lookup_Results{root.Struct()}, err
+}
+
+func (s Executor_lookup_Results) String() string {
+	str, _ := text.Marshal(0xbaa36489cf773057, s.Struct)
+	return str
+}
+
+func (s Executor_lookup_Results) Proc() Process {
+	p, _ := s.Struct.Ptr(0)
+	return Process{Client: p.Interface().Client()}
+}
+
+func (s Executor_lookup_Results) HasProc() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Executor_lookup_Results) SetProc(v Process) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+// Executor_lookup_Results_List is a list of Executor_lookup_Results.
+type Executor_lookup_Results_List struct{ capnp.List }
+
+// NewExecutor_lookup_Results creates a new list of Executor_lookup_Results.
+func NewExecutor_lookup_Results_List(s *capnp.Segment, sz int32) (Executor_lookup_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Executor_lookup_Results_List{l}, err
+}
+
+func (s Executor_lookup_Results_List) At(i int) Executor_lookup_Results {
+	return Executor_lookup_Results{s.List.Struct(i)}
+}
+
+func (s Executor_lookup_Results_List) Set(i int, v Executor_lookup_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Executor_lookup_Results_List) String() string {
+	str, _ := text.MarshalList(0xbaa36489cf773057, s.List)
+	return str
+}
+
+// Executor_lookup_Results_Future is a wrapper for a Executor_lookup_Results promised by a client call.
+type Executor_lookup_Results_Future struct{ *capnp.Future }
+
+func (p Executor_lookup_Results_Future) Struct() (Executor_lookup_Results, error) {
+	s, err := p.Future.Struct()
+	return Executor_lookup_Results{s}, err
+}
+
+func (p Executor_lookup_Results_Future) Proc() Process {
+	return Process{Client: p.Future.Field(0, nil).Client()}
+}
+
+type Command struct{ capnp.Struct }
+
+// Command_TypeID is the unique identifier for the type Command.
+const Command_TypeID = 0x8878a93857528c01
+
+func NewCommand(s *capnp.Segment) (Command, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 7})
+	return Command{st}, err
+}
+
+func NewRootCommand(s *capnp.Segment) (Command, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 7})
+	return Command{st}, err
+}
+
+func ReadRootCommand(msg *capnp.Message) (Command, error) {
+	root, err := msg.Root()
+	return Command{root.Struct()}, err
+}
+
+func (s Command) String() string {
+	str, _ := text.Marshal(0x8878a93857528c01, s.Struct)
+	return str
+}
+
+func (s Command) Path() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Command) HasPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Command) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Command) SetPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Command) Args() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Command) HasArgs() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Command) SetArgs(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Command) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Command) Env() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(2)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Command) HasEnv() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Command) SetEnv(v capnp.TextList) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewEnv sets the env field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Command) NewEnv(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+func (s Command) Dir() (string, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.Text(), err
+}
+
+func (s Command) HasDir() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Command) DirBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s Command) SetDir(v string) error {
+	return s.Struct.SetText(3, v)
+}
+
+func (s Command) Restart() (RestartPolicy, error) {
+	p, err := s.Struct.Ptr(4)
+	return RestartPolicy{Struct: p.Struct()}, err
+}
+
+func (s Command) HasRestart() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s Command) SetRestart(v RestartPolicy) error {
+	return s.Struct.SetPtr(4, v.Struct.ToPtr())
+}
+
+// NewRestart sets the restart field to a newly
+// allocated RestartPolicy struct, preferring placement in s's segment.
+func (s Command) NewRestart() (RestartPolicy, error) {
+	ss, err := NewRestartPolicy(s.Struct.Segment())
+	if err != nil {
+		return RestartPolicy{}, err
+	}
+	err = s.Struct.SetPtr(4, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Command) Limits() (Limits, error) {
+	p, err := s.Struct.Ptr(5)
+	return Limits{Struct: p.Struct()}, err
+}
+
+func (s Command) HasLimits() bool {
+	return s.Struct.HasPtr(5)
+}
+
+func (s Command) SetLimits(v Limits) error {
+	return s.Struct.SetPtr(5, v.Struct.ToPtr())
+}
+
+// NewLimits sets the limits field to a newly
+// allocated Limits struct, preferring placement in s's segment.
+func (s Command) NewLimits() (Limits, error) {
+	ss, err := NewLimits(s.Struct.Segment())
+	if err != nil {
+		return Limits{}, err
+	}
+	err = s.Struct.SetPtr(5, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Command) Caps() (CapSet_Entry_List, error) {
+	p, err := s.Struct.Ptr(6)
+	return CapSet_Entry_List{List: p.List()}, err
+}
+
+func (s Command) HasCaps() bool {
+	return s.Struct.HasPtr(6)
+}
+
+func (s Command) SetCaps(v CapSet_Entry_List) error {
+	return s.Struct.SetPtr(6, v.List.ToPtr())
+}
+
+// NewCaps sets the caps field to a newly
+// allocated CapSet_Entry_List, preferring placement in s's segment.
+func (s Command) NewCaps(n int32) (CapSet_Entry_List, error) {
+	l, err := NewCapSet_Entry_List(s.Struct.Segment(), n)
+	if err != nil {
+		return CapSet_Entry_List{}, err
+	}
+	err = s.Struct.SetPtr(6, l.List.ToPtr())
+	return l, err
+}
+
+// Command_List is a list of Command.
+type Command_List struct{ capnp.List }
+
+// NewCommand creates a new list of Command.
+func NewCommand_List(s *capnp.Segment, sz int32) (Command_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 7}, sz)
+	return Command_List{l}, err
+}
+
+func (s Command_List) At(i int) Command { return Command{s.List.Struct(i)} }
+
+func (s Command_List) Set(i int, v Command) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Command_List) String() string {
+	str, _ := text.MarshalList(0x8878a93857528c01, s.List)
+	return str
+}
+
+// Command_Future is a wrapper for a Command promised by a client call.
+type Command_Future struct{ *capnp.Future }
+
+func (p Command_Future) Struct() (Command, error) {
+	s, err := p.Future.Struct()
+	return Command{s}, err
+}
+
+func (p Command_Future) Restart() RestartPolicy_Future {
+	return RestartPolicy_Future{Future: p.Future.Field(4, nil)}
+}
+
+func (p Command_Future) Limits() Limits_Future {
+	return Limits_Future{Future: p.Future.Field(5, nil)}
+}
+
+type CapSet struct{ Client *capnp.Client }
+
+// CapSet_TypeID is the unique identifier for the type CapSet.
+const CapSet_TypeID = 0xde74f3c5b89a197a
+
+func (c CapSet) Lookup(ctx context.Context, params func(CapSet_lookup_Params) error) (CapSet_lookup_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xde74f3c5b89a197a,
+			MethodID:      0,
+			InterfaceName: "process.capnp:CapSet",
+			MethodName:    "lookup",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapSet_lookup_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return CapSet_lookup_Results_Future{Future: ans.Future()}, release
+}
+func (c CapSet) Ls(ctx context.Context, params func(CapSet_ls_Params) error) (CapSet_ls_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xde74f3c5b89a197a,
+			MethodID:      1,
+			InterfaceName: "process.capnp:CapSet",
+			MethodName:    "ls",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(CapSet_ls_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return CapSet_ls_Results_Future{Future: ans.Future()}, release
+}
+
+func (c CapSet) AddRef() CapSet {
+	return CapSet{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c CapSet) Release() {
+	c.Client.Release()
+}
+
+// A CapSet_Server is a CapSet with a local implementation.
+type CapSet_Server interface {
+	Lookup(context.Context, CapSet_lookup) error
+
+	Ls(context.Context, CapSet_ls) error
+}
+
+// CapSet_NewServer creates a new Server from an implementation of CapSet_Server.
+func CapSet_NewServer(s CapSet_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(CapSet_Methods(nil, s), s, c, policy)
+}
+
+// CapSet_ServerToClient creates a new Client from an implementation of CapSet_Server.
+// The caller is responsible for calling Release on the returned Client.
+func CapSet_ServerToClient(s CapSet_Server, policy *server.Policy) CapSet {
+	return CapSet{Client: capnp.NewClient(CapSet_NewServer(s, policy))}
+}
+
+// CapSet_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func CapSet_Methods(methods []server.Method, s CapSet_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xde74f3c5b89a197a,
+			MethodID:      0,
+			InterfaceName: "process.capnp:CapSet",
+			MethodName:    "lookup",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Lookup(ctx, CapSet_lookup{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xde74f3c5b89a197a,
+			MethodID:      1,
+			InterfaceName: "process.capnp:CapSet",
+			MethodName:    "ls",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Ls(ctx, CapSet_ls{call})
+		},
+	})
+
+	return methods
+}
+
+// CapSet_lookup holds the state for a server call to CapSet.lookup.
+// See server.Call for documentation.
+type CapSet_lookup struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CapSet_lookup) Args() CapSet_lookup_Params {
+	return CapSet_lookup_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c CapSet_lookup) AllocResults() (CapSet_lookup_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_lookup_Results{Struct: r}, err
+}
+
+// CapSet_ls holds the state for a server call to CapSet.ls.
+// See server.Call for documentation.
+type CapSet_ls struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c CapSet_ls) Args() CapSet_ls_Params {
+	return CapSet_ls_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c CapSet_ls) AllocResults() (CapSet_ls_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_ls_Results{Struct: r}, err
+}
+
+type CapSet_Entry struct{ capnp.Struct }
+
+// CapSet_Entry_TypeID is the unique identifier for the type CapSet_Entry.
+const CapSet_Entry_TypeID = 0xf2ac53587047d982
+
+func NewCapSet_Entry(s *capnp.Segment) (CapSet_Entry, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return CapSet_Entry{st}, err
+}
+
+func NewRootCapSet_Entry(s *capnp.Segment) (CapSet_Entry, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return CapSet_Entry{st}, err
+}
+
+func ReadRootCapSet_Entry(msg *capnp.Message) (CapSet_Entry, error) {
+	root, err := msg.Root()
+	return CapSet_Entry{root.Struct()}, err
+}
+
+func (s CapSet_Entry) String() string {
+	str, _ := text.Marshal(0xf2ac53587047d982, s.Struct)
+	return str
+}
+
+func (s CapSet_Entry) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s CapSet_Entry) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s CapSet_Entry) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CapSet_Entry) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s CapSet_Entry) Cap() (capnp.Ptr, error) {
+	return s.Struct.Ptr(1)
+}
+
+func (s CapSet_Entry) HasCap() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s CapSet_Entry) SetCap(v capnp.Ptr) error {
+	return s.Struct.SetPtr(1, v)
+}
+
+// CapSet_Entry_List is a list of CapSet_Entry.
+type CapSet_Entry_List struct{ capnp.List }
+
+// NewCapSet_Entry creates a new list of CapSet_Entry.
+func NewCapSet_Entry_List(s *capnp.Segment, sz int32) (CapSet_Entry_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return CapSet_Entry_List{l}, err
+}
+
+func (s CapSet_Entry_List) At(i int) CapSet_Entry { return CapSet_Entry{s.List.Struct(i)} }
+
+func (s CapSet_Entry_List) Set(i int, v CapSet_Entry) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s CapSet_Entry_List) String() string {
+	str, _ := text.MarshalList(0xf2ac53587047d982, s.List)
+	return str
+}
+
+// CapSet_Entry_Future is a wrapper for a CapSet_Entry promised by a client call.
+type CapSet_Entry_Future struct{ *capnp.Future }
+
+func (p CapSet_Entry_Future) Struct() (CapSet_Entry, error) {
+	s, err := p.Future.Struct()
+	return CapSet_Entry{s}, err
+}
+
+func (p CapSet_Entry_Future) Cap() *capnp.Future {
+	return p.Future.Field(1, nil)
+}
+
+type CapSet_lookup_Params struct{ capnp.Struct }
+
+// CapSet_lookup_Params_TypeID is the unique identifier for the type CapSet_lookup_Params.
+const CapSet_lookup_Params_TypeID = 0x918233097a8b17aa
+
+func NewCapSet_lookup_Params(s *capnp.Segment) (CapSet_lookup_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_lookup_Params{st}, err
+}
+
+func NewRootCapSet_lookup_Params(s *capnp.Segment) (CapSet_lookup_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_lookup_Params{st}, err
+}
+
+func ReadRootCapSet_lookup_Params(msg *capnp.Message) (CapSet_lookup_Params, error) {
+	root, err := msg.Root()
+	return CapSet_lookup_Params{root.Struct()}, err
+}
+
+func (s CapSet_lookup_Params) String() string {
+	str, _ := text.Marshal(0x918233097a8b17aa, s.Struct)
+	return str
+}
+
+func (s CapSet_lookup_Params) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s CapSet_lookup_Params) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s CapSet_lookup_Params) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CapSet_lookup_Params) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// CapSet_lookup_Params_List is a list of CapSet_lookup_Params.
+type CapSet_lookup_Params_List struct{ capnp.List }
+
+// NewCapSet_lookup_Params creates a new list of CapSet_lookup_Params.
+func NewCapSet_lookup_Params_List(s *capnp.Segment, sz int32) (CapSet_lookup_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return CapSet_lookup_Params_List{l}, err
+}
+
+func (s CapSet_lookup_Params_List) At(i int) CapSet_lookup_Params {
+	return CapSet_lookup_Params{s.List.Struct(i)}
+}
+
+func (s CapSet_lookup_Params_List) Set(i int, v CapSet_lookup_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s CapSet_lookup_Params_List) String() string {
+	str, _ := text.MarshalList(0x918233097a8b17aa, s.List)
+	return str
+}
+
+// CapSet_lookup_Params_Future is a wrapper for a CapSet_lookup_Params promised by a client call.
+type CapSet_lookup_Params_Future struct{ *capnp.Future }
+
+func (p CapSet_lookup_Params_Future) Struct() (CapSet_lookup_Params, error) {
+	s, err := p.Future.Struct()
+	return CapSet_lookup_Params{s}, err
+}
+
+type CapSet_lookup_Results struct{ capnp.Struct }
+
+// CapSet_lookup_Results_TypeID is the unique identifier for the type CapSet_lookup_Results.
+const CapSet_lookup_Results_TypeID = 0xfcfc2af4b8fc038e
+
+func NewCapSet_lookup_Results(s *capnp.Segment) (CapSet_lookup_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_lookup_Results{st}, err
+}
+
+func NewRootCapSet_lookup_Results(s *capnp.Segment) (CapSet_lookup_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_lookup_Results{st}, err
+}
+
+func ReadRootCapSet_lookup_Results(msg *capnp.Message) (CapSet_lookup_Results, error) {
+	root, err := msg.Root()
+	return CapSet_lookup_Results{root.Struct()}, err
+}
+
+func (s CapSet_lookup_Results) String() string {
+	str, _ := text.Marshal(0xfcfc2af4b8fc038e, s.Struct)
+	return str
+}
+
+func (s CapSet_lookup_Results) Cap() (capnp.Ptr, error) {
+	return s.Struct.Ptr(0)
+}
+
+func (s CapSet_lookup_Results) HasCap() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s CapSet_lookup_Results) SetCap(v capnp.Ptr) error {
+	return s.Struct.SetPtr(0, v)
+}
+
+// CapSet_lookup_Results_List is a list of CapSet_lookup_Results.
+type CapSet_lookup_Results_List struct{ capnp.List }
+
+// NewCapSet_lookup_Results creates a new list of CapSet_lookup_Results.
+func NewCapSet_lookup_Results_List(s *capnp.Segment, sz int32) (CapSet_lookup_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return CapSet_lookup_Results_List{l}, err
+}
+
+func (s CapSet_lookup_Results_List) At(i int) CapSet_lookup_Results {
+	return CapSet_lookup_Results{s.List.Struct(i)}
+}
+
+func (s CapSet_lookup_Results_List) Set(i int, v CapSet_lookup_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s CapSet_lookup_Results_List) String() string {
+	str, _ := text.MarshalList(0xfcfc2af4b8fc038e, s.List)
+	return str
+}
+
+// CapSet_lookup_Results_Future is a wrapper for a CapSet_lookup_Results promised by a client call.
+type CapSet_lookup_Results_Future struct{ *capnp.Future }
+
+func (p CapSet_lookup_Results_Future) Struct() (CapSet_lookup_Results, error) {
+	s, err := p.Future.Struct()
+	return CapSet_lookup_Results{s}, err
+}
+
+func (p CapSet_lookup_Results_Future) Cap() *capnp.Future {
+	return p.Future.Field(0, nil)
+}
+
+type CapSet_ls_Params struct{ capnp.Struct }
+
+// CapSet_ls_Params_TypeID is the unique identifier for the type CapSet_ls_Params.
+const CapSet_ls_Params_TypeID = 0xf79ea5718265b4e8
+
+func NewCapSet_ls_Params(s *capnp.Segment) (CapSet_ls_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapSet_ls_Params{st}, err
+}
+
+func NewRootCapSet_ls_Params(s *capnp.Segment) (CapSet_ls_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CapSet_ls_Params{st}, err
+}
+
+func ReadRootCapSet_ls_Params(msg *capnp.Message) (CapSet_ls_Params, error) {
+	root, err := msg.Root()
+	return CapSet_ls_Params{root.Struct()}, err
+}
+
+func (s CapSet_ls_Params) String() string {
+	str, _ := text.Marshal(0xf79ea5718265b4e8, s.Struct)
+	return str
+}
+
+// CapSet_ls_Params_List is a list of CapSet_ls_Params.
+type CapSet_ls_Params_List struct{ capnp.List }
+
+// NewCapSet_ls_Params creates a new list of CapSet_ls_Params.
+func NewCapSet_ls_Params_List(s *capnp.Segment, sz int32) (CapSet_ls_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return CapSet_ls_Params_List{l}, err
+}
+
+func (s CapSet_ls_Params_List) At(i int) CapSet_ls_Params { return CapSet_ls_Params{s.List.Struct(i)} }
+
+func (s CapSet_ls_Params_List) Set(i int, v CapSet_ls_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s CapSet_ls_Params_List) String() string {
+	str, _ := text.MarshalList(0xf79ea5718265b4e8, s.List)
+	return str
+}
+
+// CapSet_ls_Params_Future is a wrapper for a CapSet_ls_Params promised by a client call.
+type CapSet_ls_Params_Future struct{ *capnp.Future }
+
+func (p CapSet_ls_Params_Future) Struct() (CapSet_ls_Params, error) {
+	s, err := p.Future.Struct()
+	return CapSet_ls_Params{s}, err
+}
+
+type CapSet_ls_Results struct{ capnp.Struct }
+
+// CapSet_ls_Results_TypeID is the unique identifier for the type CapSet_ls_Results.
+const CapSet_ls_Results_TypeID = 0xb3c8eb0a0dc92d02
+
+func NewCapSet_ls_Results(s *capnp.Segment) (CapSet_ls_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_ls_Results{st}, err
+}
+
+func NewRootCapSet_ls_Results(s *capnp.Segment) (CapSet_ls_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CapSet_ls_Results{st}, err
+}
+
+func ReadRootCapSet_ls_Results(msg *capnp.Message) (CapSet_ls_Results, error) {
+	root, err := msg.Root()
+	return CapSet_ls_Results{root.Struct()}, err
+}
+
+func (s CapSet_ls_Results) String() string {
+	str, _ := text.Marshal(0xb3c8eb0a0dc92d02, s.Struct)
+	return str
+}
+
+func (s CapSet_ls_Results) Names() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s CapSet_ls_Results) HasNames() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s CapSet_ls_Results) SetNames(v capnp.TextList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewNames sets the names field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s CapSet_ls_Results) NewNames(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// CapSet_ls_Results_List is a list of CapSet_ls_Results.
+type CapSet_ls_Results_List struct{ capnp.List }
+
+// NewCapSet_ls_Results creates a new list of CapSet_ls_Results.
+func NewCapSet_ls_Results_List(s *capnp.Segment, sz int32) (CapSet_ls_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return CapSet_ls_Results_List{l}, err
+}
+
+func (s CapSet_ls_Results_List) At(i int) CapSet_ls_Results {
+	return CapSet_ls_Results{s.List.Struct(i)}
+}
+
+func (s CapSet_ls_Results_List) Set(i int, v CapSet_ls_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s CapSet_ls_Results_List) String() string {
+	str, _ := text.MarshalList(0xb3c8eb0a0dc92d02, s.List)
+	return str
+}
+
+// CapSet_ls_Results_Future is a wrapper for a CapSet_ls_Results promised by a client call.
+type CapSet_ls_Results_Future struct{ *capnp.Future }
+
+func (p CapSet_ls_Results_Future) Struct() (CapSet_ls_Results, error) {
+	s, err := p.Future.Struct()
+	return CapSet_ls_Results{s}, err
+}
+
+type Limits struct{ capnp.Struct }
+
+// Limits_TypeID is the unique identifier for the type Limits.
+const Limits_TypeID = 0xa3af3825285de38a
+
+func NewLimits(s *capnp.Segment) (Limits, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Limits{st}, err
+}
+
+func NewRootLimits(s *capnp.Segment) (Limits, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Limits{st}, err
+}
+
+func ReadRootLimits(msg *capnp.Message) (Limits, error) {
+	root, err := msg.Root()
+	return Limits{root.Struct()}, err
+}
+
+func (s Limits) String() string {
+	str, _ := text.Marshal(0xa3af3825285de38a, s.Struct)
+	return str
+}
+
+func (s Limits) Cpu() float64 {
+	return math.Float64frombits(s.Struct.Uint64(0))
+}
+
+func (s Limits) SetCpu(v float64) {
+	s.Struct.SetUint64(0, math.Float64bits(v))
+}
+
+func (s Limits) Memory() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Limits) SetMemory(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Limits) Pids() uint32 {
+	return s.Struct.Uint32(16)
+}
+
+func (s Limits) SetPids(v uint32) {
+	s.Struct.SetUint32(16, v)
+}
+
+// Limits_List is a list of Limits.
+type Limits_List struct{ capnp.List }
+
+// NewLimits creates a new list of Limits.
+func NewLimits_List(s *capnp.Segment, sz int32) (Limits_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return Limits_List{l}, err
+}
+
+func (s Limits_List) At(i int) Limits { return Limits{s.List.Struct(i)} }
+
+func (s Limits_List) Set(i int, v Limits) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Limits_List) String() string {
+	str, _ := text.MarshalList(0xa3af3825285de38a, s.List)
+	return str
+}
+
+// Limits_Future is a wrapper for a Limits promised by a client call.
+type Limits_Future struct{ *capnp.Future }
+
+func (p Limits_Future) Struct() (Limits, error) {
+	s, err := p.Future.Struct()
+	return Limits{s}, err
+}
+
+type RestartPolicy struct{ capnp.Struct }
+
+// RestartPolicy_TypeID is the unique identifier for the type RestartPolicy.
+const RestartPolicy_TypeID = 0xb83bff7899bbfdce
+
+func NewRestartPolicy(s *capnp.Segment) (RestartPolicy, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return RestartPolicy{st}, err
+}
+
+func NewRootRestartPolicy(s *capnp.Segment) (RestartPolicy, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return RestartPolicy{st}, err
+}
+
+func ReadRootRestartPolicy(msg *capnp.Message) (RestartPolicy, error) {
+	root, err := msg.Root()
+	return RestartPolicy{root.Struct()}, err
+}
+
+func (s RestartPolicy) String() string {
+	str, _ := text.Marshal(0xb83bff7899bbfdce, s.Struct)
+	return str
+}
+
+func (s RestartPolicy) Mode() RestartPolicy_Mode {
+	return RestartPolicy_Mode(s.Struct.Uint16(0))
+}
+
+func (s RestartPolicy) SetMode(v RestartPolicy_Mode) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s RestartPolicy) Backoff() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s RestartPolicy) SetBackoff(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+func (s RestartPolicy) MaxRestarts() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s RestartPolicy) SetMaxRestarts(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+// RestartPolicy_List is a list of RestartPolicy.
+type RestartPolicy_List struct{ capnp.List }
+
+// NewRestartPolicy creates a new list of RestartPolicy.
+func NewRestartPolicy_List(s *capnp.Segment, sz int32) (RestartPolicy_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return RestartPolicy_List{l}, err
+}
+
+func (s RestartPolicy_List) At(i int) RestartPolicy { return RestartPolicy{s.List.Struct(i)} }
+
+func (s RestartPolicy_List) Set(i int, v RestartPolicy) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s RestartPolicy_List) String() string {
+	str, _ := text.MarshalList(0xb83bff7899bbfdce, s.List)
+	return str
+}
+
+// RestartPolicy_Future is a wrapper for a RestartPolicy promised by a client call.
+type RestartPolicy_Future struct{ *capnp.Future }
+
+func (p RestartPolicy_Future) Struct() (RestartPolicy, error) {
+	s, err := p.Future.Struct()
+	return RestartPolicy{s}, err
+}
+
+type RestartPolicy_Mode uint16
+
+// RestartPolicy_Mode_TypeID is the unique identifier for the type RestartPolicy_Mode.
+const RestartPolicy_Mode_TypeID = 0xa6cc1e50dfc0805f
+
+// Values of RestartPolicy_Mode.
+const (
+	RestartPolicy_Mode_never     RestartPolicy_Mode = 0
+	RestartPolicy_Mode_onFailure RestartPolicy_Mode = 1
+	RestartPolicy_Mode_always    RestartPolicy_Mode = 2
+)
+
+// String returns the enum's constant name.
+func (c RestartPolicy_Mode) String() string {
+	switch c {
+	case RestartPolicy_Mode_never:
+		return "never"
+	case RestartPolicy_Mode_onFailure:
+		return "onFailure"
+	case RestartPolicy_Mode_always:
+		return "always"
+
+	default:
+		return ""
+	}
+}
+
+// RestartPolicy_ModeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func RestartPolicy_ModeFromString(c string) RestartPolicy_Mode {
+	switch c {
+	case "never":
+		return RestartPolicy_Mode_never
+	case "onFailure":
+		return RestartPolicy_Mode_onFailure
+	case "always":
+		return RestartPolicy_Mode_always
+
+	default:
+		return 0
+	}
+}
+
+type RestartPolicy_Mode_List struct{ capnp.List }
+
+func NewRestartPolicy_Mode_List(s *capnp.Segment, sz int32) (RestartPolicy_Mode_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return RestartPolicy_Mode_List{l.List}, err
+}
+
+func (l RestartPolicy_Mode_List) At(i int) RestartPolicy_Mode {
+	ul := capnp.UInt16List{List: l.List}
+	return RestartPolicy_Mode(ul.At(i))
+}
+
+func (l RestartPolicy_Mode_List) Set(i int, v RestartPolicy_Mode) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Module struct{ capnp.Struct }
+
+// Module_TypeID is the unique identifier for the type Module.
+const Module_TypeID = 0x8aad1f9d185a1a6c
+
+func NewModule(s *capnp.Segment) (Module, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
+	return Module{st}, err
+}
+
+func NewRootModule(s *capnp.Segment) (Module, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5})
+	return Module{st}, err
+}
+
+func ReadRootModule(msg *capnp.Message) (Module, error) {
+	root, err := msg.Root()
+	return Module{root.Struct()}, err
+}
+
+func (s Module) String() string {
+	str, _ := text.Marshal(0x8aad1f9d185a1a6c, s.Struct)
+	return str
+}
+
+func (s Module) Binary() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Module) HasBinary() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Module) SetBinary(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s Module) Args() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Module) HasArgs() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Module) SetArgs(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Module) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Module) Env() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(2)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Module) HasEnv() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Module) SetEnv(v capnp.TextList) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewEnv sets the env field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Module) NewEnv(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+func (s Module) Preopens() (Module_Preopen_List, error) {
+	p, err := s.Struct.Ptr(3)
+	return Module_Preopen_List{List: p.List()}, err
+}
+
+func (s Module) HasPreopens() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Module) SetPreopens(v Module_Preopen_List) error {
+	return s.Struct.SetPtr(3, v.List.ToPtr())
+}
+
+// NewPreopens sets the preopens field to a newly
+// allocated Module_Preopen_List, preferring placement in s's segment.
+func (s Module) NewPreopens(n int32) (Module_Preopen_List, error) {
+	l, err := NewModule_Preopen_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Module_Preopen_List{}, err
+	}
+	err = s.Struct.SetPtr(3, l.List.ToPtr())
+	return l, err
+}
+
+func (s Module) Caps() (Module_Cap_List, error) {
+	p, err := s.Struct.Ptr(4)
+	return Module_Cap_List{List: p.List()}, err
+}
+
+func (s Module) HasCaps() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s Module) SetCaps(v Module_Cap_List) error {
+	return s.Struct.SetPtr(4, v.List.ToPtr())
+}
+
+// NewCaps sets the caps field to a newly
+// allocated Module_Cap_List, preferring placement in s's segment.
+func (s Module) NewCaps(n int32) (Module_Cap_List, error) {
+	l, err := NewModule_Cap_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Module_Cap_List{}, err
+	}
+	err = s.Struct.SetPtr(4, l.List.ToPtr())
+	return l, err
+}
+
+func (s Module) MemoryPages() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Module) SetMemoryPages(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Module) Timeout() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s Module) SetTimeout(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+func (s Module) CpuTime() int64 {
+	return int64(s.Struct.Uint64(16))
+}
+
+func (s Module) SetCpuTime(v int64) {
+	s.Struct.SetUint64(16, uint64(v))
+}
+
+// Module_List is a list of Module.
+type Module_List struct{ capnp.List }
+
+// NewModule creates a new list of Module.
+func NewModule_List(s *capnp.Segment, sz int32) (Module_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 5}, sz)
+	return Module_List{l}, err
+}
+
+func (s Module_List) At(i int) Module { return Module{s.List.Struct(i)} }
+
+func (s Module_List) Set(i int, v Module) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Module_List) String() string {
+	str, _ := text.MarshalList(0x8aad1f9d185a1a6c, s.List)
+	return str
+}
+
+// Module_Future is a wrapper for a Module promised by a client call.
+type Module_Future struct{ *capnp.Future }
+
+func (p Module_Future) Struct() (Module, error) {
+	s, err := p.Future.Struct()
+	return Module{s}, err
+}
+
+type Module_Preopen struct{ capnp.Struct }
+
+// Module_Preopen_TypeID is the unique identifier for the type Module_Preopen.
+const Module_Preopen_TypeID = 0xe304726442eebf8e
+
+func NewModule_Preopen(s *capnp.Segment) (Module_Preopen, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Module_Preopen{st}, err
+}
+
+func NewRootModule_Preopen(s *capnp.Segment) (Module_Preopen, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Module_Preopen{st}, err
+}
+
+func ReadRootModule_Preopen(msg *capnp.Message) (Module_Preopen, error) {
+	root, err := msg.Root()
+	return Module_Preopen{root.Struct()}, err
+}
+
+func (s Module_Preopen) String() string {
+	str, _ := text.Marshal(0xe304726442eebf8e, s.Struct)
+	return str
+}
+
+func (s Module_Preopen) HostPath() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Module_Preopen) HasHostPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Module_Preopen) HostPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Module_Preopen) SetHostPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Module_Preopen) GuestPath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Module_Preopen) HasGuestPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Module_Preopen) GuestPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Module_Preopen) SetGuestPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Module_Preopen) ReadOnly() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Module_Preopen) SetReadOnly(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+// Module_Preopen_List is a list of Module_Preopen.
+type Module_Preopen_List struct{ capnp.List }
+
+// NewModule_Preopen creates a new list of Module_Preopen.
+func NewModule_Preopen_List(s *capnp.Segment, sz int32) (Module_Preopen_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return Module_Preopen_List{l}, err
+}
+
+func (s Module_Preopen_List) At(i int) Module_Preopen { return Module_Preopen{s.List.Struct(i)} }
+
+func (s Module_Preopen_List) Set(i int, v Module_Preopen) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Module_Preopen_List) String() string {
+	str, _ := text.MarshalList(0xe304726442eebf8e, s.List)
+	return str
+}
+
+// Module_Preopen_Future is a wrapper for a Module_Preopen promised by a client call.
+type Module_Preopen_Future struct{ *capnp.Future }
+
+func (p Module_Preopen_Future) Struct() (Module_Preopen, error) {
+	s, err := p.Future.Struct()
+	return Module_Preopen{s}, err
+}
+
+type Module_Cap struct{ capnp.Struct }
+
+// Module_Cap_TypeID is the unique identifier for the type Module_Cap.
+const Module_Cap_TypeID = 0xc8f096d6ce51986a
+
+func NewModule_Cap(s *capnp.Segment) (Module_Cap, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Module_Cap{st}, err
+}
+
+func NewRootModule_Cap(s *capnp.Segment) (Module_Cap, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Module_Cap{st}, err
+}
+
+func ReadRootModule_Cap(msg *capnp.Message) (Module_Cap, error) {
+	root, err := msg.Root()
+	return Module_Cap{root.Struct()}, err
+}
+
+func (s Module_Cap) String() string {
+	str, _ := text.Marshal(0xc8f096d6ce51986a, s.Struct)
+	return str
+}
+
+func (s Module_Cap) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Module_Cap) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Module_Cap) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Module_Cap) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Module_Cap) Kind() Module_Cap_Kind {
+	return Module_Cap_Kind(s.Struct.Uint16(0))
+}
+
+func (s Module_Cap) SetKind(v Module_Cap_Kind) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Module_Cap) Client() (capnp.Ptr, error) {
+	return s.Struct.Ptr(1)
+}
+
+func (s Module_Cap) HasClient() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Module_Cap) SetClient(v capnp.Ptr) error {
+	return s.Struct.SetPtr(1, v)
+}
+
+// Module_Cap_List is a list of Module_Cap.
+type Module_Cap_List struct{ capnp.List }
+
+// NewModule_Cap creates a new list of Module_Cap.
+func NewModule_Cap_List(s *capnp.Segment, sz int32) (Module_Cap_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return Module_Cap_List{l}, err
+}
+
+func (s Module_Cap_List) At(i int) Module_Cap { return Module_Cap{s.List.Struct(i)} }
+
+func (s Module_Cap_List) Set(i int, v Module_Cap) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Module_Cap_List) String() string {
+	str, _ := text.MarshalList(0xc8f096d6ce51986a, s.List)
+	return str
+}
+
+// Module_Cap_Future is a wrapper for a Module_Cap promised by a client call.
+type Module_Cap_Future struct{ *capnp.Future }
+
+func (p Module_Cap_Future) Struct() (Module_Cap, error) {
+	s, err := p.Future.Struct()
+	return Module_Cap{s}, err
+}
+
+func (p Module_Cap_Future) Client() *capnp.Future {
+	return p.Future.Field(1, nil)
+}
+
+type Module_Cap_Kind uint16
+
+// Module_Cap_Kind_TypeID is the unique identifier for the type Module_Cap_Kind.
+const Module_Cap_Kind_TypeID = 0xaa871b44f5ff6829
+
+// Values of Module_Cap_Kind.
+const (
+	Module_Cap_Kind_topic  Module_Cap_Kind = 0
+	Module_Cap_Kind_anchor Module_Cap_Kind = 1
+)
+
+// String returns the enum's constant name.
+func (c Module_Cap_Kind) String() string {
+	switch c {
+	case Module_Cap_Kind_topic:
+		return "topic"
+	case Module_Cap_Kind_anchor:
+		return "anchor"
+
+	default:
+		return ""
+	}
+}
+
+// Module_Cap_KindFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Module_Cap_KindFromString(c string) Module_Cap_Kind {
+	switch c {
+	case "topic":
+		return Module_Cap_Kind_topic
+	case "anchor":
+		return Module_Cap_Kind_anchor
+
+	default:
+		return 0
+	}
+}
+
+type Module_Cap_Kind_List struct{ capnp.List }
+
+func NewModule_Cap_Kind_List(s *capnp.Segment, sz int32) (Module_Cap_Kind_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return Module_Cap_Kind_List{l.List}, err
+}
+
+func (l Module_Cap_Kind_List) At(i int) Module_Cap_Kind {
+	ul := capnp.UInt16List{List: l.List}
+	return Module_Cap_Kind(ul.At(i))
+}
+
+func (l Module_Cap_Kind_List) Set(i int, v Module_Cap_Kind) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Process struct{ Client *capnp.Client }
+
+// Process_TypeID is the unique identifier for the type Process.
+const Process_TypeID = 0xe9b6b195be73b902
+
+func (c Process) Wait(ctx context.Context, params func(Process_wait_Params) error) (Process_wait_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "wait",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_wait_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Process_wait_Results_Future{Future: ans.Future()}, release
+}
+func (c Process) Kill(ctx context.Context, params func(Process_kill_Params) error) (Process_kill_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "kill",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_kill_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Process_kill_Results_Future{Future: ans.Future()}, release
+}
+func (c Process) Stdin(ctx context.Context, params func(Process_stdin_Params) error) (Process_stdin_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stdin",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_stdin_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Process_stdin_Results_Future{Future: ans.Future()}, release
+}
+func (c Process) Id(ctx context.Context, params func(Process_id_Params) error) (Process_id_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      3,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "id",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_id_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Process_id_Results_Future{Future: ans.Future()}, release
+}
+func (c Process) Logs(ctx context.Context, params func(Process_logs_Params) error) (Process_logs_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      4,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "logs",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 2}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Process_logs_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Process_logs_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Process) AddRef() Process {
+	return Process{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Process) Release() {
+	c.Client.Release()
+}
+
+// A Process_Server is a Process with a local implementation.
+type Process_Server interface {
+	Wait(context.Context, Process_wait) error
+
+	Kill(context.Context, Process_kill) error
+
+	Stdin(context.Context, Process_stdin) error
+
+	Id(context.Context, Process_id) error
+
+	Logs(context.Context, Process_logs) error
+}
+
+// Process_NewServer creates a new Server from an implementation of Process_Server.
+func Process_NewServer(s Process_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Process_Methods(nil, s), s, c, policy)
+}
+
+// Process_ServerToClient creates a new Client from an implementation of Process_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Process_ServerToClient(s Process_Server, policy *server.Policy) Process {
+	return Process{Client: capnp.NewClient(Process_NewServer(s, policy))}
+}
+
+// Process_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Process_Methods(methods []server.Method, s Process_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 5)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "wait",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Wait(ctx, Process_wait{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "kill",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Kill(ctx, Process_kill{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "stdin",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Stdin(ctx, Process_stdin{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      3,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "id",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Id(ctx, Process_id{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe9b6b195be73b902,
+			MethodID:      4,
+			InterfaceName: "process.capnp:Process",
+			MethodName:    "logs",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Logs(ctx, Process_logs{call})
+		},
+	})
+
+	return methods
+}
+
+// Process_wait holds the state for a server call to Process.wait.
+// See server.Call for documentation.
+type Process_wait struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Process_wait) Args() Process_wait_Params {
+	return Process_wait_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Process_wait) AllocResults() (Process_wait_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Process_wait_Results{Struct: r}, err
+}
+
+// Process_kill holds the state for a server call to Process.kill.
+// See server.Call for documentation.
+type Process_kill struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Process_kill) Args() Process_kill_Params {
+	return Process_kill_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Process_kill) AllocResults() (Process_kill_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_kill_Results{Struct: r}, err
+}
+
+// Process_stdin holds the state for a server call to Process.stdin.
+// See server.Call for documentation.
+type Process_stdin struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Process_stdin) Args() Process_stdin_Params {
+	return Process_stdin_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Process_stdin) AllocResults() (Process_stdin_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdin_Results{Struct: r}, err
+}
+
+// Process_id holds the state for a server call to Process.id.
+// See server.Call for documentation.
+type Process_id struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Process_id) Args() Process_id_Params {
+	return Process_id_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Process_id) AllocResults() (Process_id_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_id_Results{Struct: r}, err
+}
+
+// Process_logs holds the state for a server call to Process.logs.
+// See server.Call for documentation.
+type Process_logs struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Process_logs) Args() Process_logs_Params {
+	return Process_logs_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Process_logs) AllocResults() (Process_logs_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_logs_Results{Struct: r}, err
+}
+
+type Process_wait_Params struct{ capnp.Struct }
+
+// Process_wait_Params_TypeID is the unique identifier for the type Process_wait_Params.
+const Process_wait_Params_TypeID = 0xa33bf59d5dc4c83d
+
+func NewProcess_wait_Params(s *capnp.Segment) (Process_wait_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_wait_Params{st}, err
+}
+
+func NewRootProcess_wait_Params(s *capnp.Segment) (Process_wait_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_wait_Params{st}, err
+}
+
+func ReadRootProcess_wait_Params(msg *capnp.Message) (Process_wait_Params, error) {
+	root, err := msg.Root()
+	return Process_wait_Params{root.Struct()}, err
+}
+
+func (s Process_wait_Params) String() string {
+	str, _ := text.Marshal(0xa33bf59d5dc4c83d, s.Struct)
+	return str
+}
+
+// Process_wait_Params_List is a list of Process_wait_Params.
+type Process_wait_Params_List struct{ capnp.List }
+
+// NewProcess_wait_Params creates a new list of Process_wait_Params.
+func NewProcess_wait_Params_List(s *capnp.Segment, sz int32) (Process_wait_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Process_wait_Params_List{l}, err
+}
+
+func (s Process_wait_Params_List) At(i int) Process_wait_Params {
+	return Process_wait_Params{s.List.Struct(i)}
+}
+
+func (s Process_wait_Params_List) Set(i int, v Process_wait_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_wait_Params_List) String() string {
+	str, _ := text.MarshalList(0xa33bf59d5dc4c83d, s.List)
+	return str
+}
+
+// Process_wait_Params_Future is a wrapper for a Process_wait_Params promised by a client call.
+type Process_wait_Params_Future struct{ *capnp.Future }
+
+func (p Process_wait_Params_Future) Struct() (Process_wait_Params, error) {
+	s, err := p.Future.Struct()
+	return Process_wait_Params{s}, err
+}
+
+type Process_wait_Results struct{ capnp.Struct }
+
+// Process_wait_Results_TypeID is the unique identifier for the type Process_wait_Results.
+const Process_wait_Results_TypeID = 0xab4efb8690ff3553
+
+func NewProcess_wait_Results(s *capnp.Segment) (Process_wait_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Process_wait_Results{st}, err
+}
+
+func NewRootProcess_wait_Results(s *capnp.Segment) (Process_wait_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Process_wait_Results{st}, err
+}
+
+func ReadRootProcess_wait_Results(msg *capnp.Message) (Process_wait_Results, error) {
+	root, err := msg.Root()
+	return Process_wait_Results{root.Struct()}, err
+}
+
+func (s Process_wait_Results) String() string {
+	str, _ := text.Marshal(0xab4efb8690ff3553, s.Struct)
+	return str
+}
+
+func (s Process_wait_Results) ExitCode() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s Process_wait_Results) SetExitCode(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+func (s Process_wait_Results) OomKilled() bool {
+	return s.Struct.Bit(32)
+}
+
+func (s Process_wait_Results) SetOomKilled(v bool) {
+	s.Struct.SetBit(32, v)
+}
+
+// Process_wait_Results_List is a list of Process_wait_Results.
+type Process_wait_Results_List struct{ capnp.List }
+
+// NewProcess_wait_Results creates a new list of Process_wait_Results.
+func NewProcess_wait_Results_List(s *capnp.Segment, sz int32) (Process_wait_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return Process_wait_Results_List{l}, err
+}
+
+func (s Process_wait_Results_List) At(i int) Process_wait_Results {
+	return Process_wait_Results{s.List.Struct(i)}
+}
+
+func (s Process_wait_Results_List) Set(i int, v Process_wait_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_wait_Results_List) String() string {
+	str, _ := text.MarshalList(0xab4efb8690ff3553, s.List)
+	return str
+}
+
+// Process_wait_Results_Future is a wrapper for a Process_wait_Results promised by a client call.
+type Process_wait_Results_Future struct{ *capnp.Future }
+
+func (p Process_wait_Results_Future) Struct() (Process_wait_Results, error) {
+	s, err := p.Future.Struct()
+	return Process_wait_Results{s}, err
+}
+
+type Process_kill_Params struct{ capnp.Struct }
+
+// Process_kill_Params_TypeID is the unique identifier for the type Process_kill_Params.
+const Process_kill_Params_TypeID = 0xd664a71cbac34a9c
+
+func NewProcess_kill_Params(s *capnp.Segment) (Process_kill_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Process_kill_Params{st}, err
+}
+
+func NewRootProcess_kill_Params(s *capnp.Segment) (Process_kill_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Process_kill_Params{st}, err
+}
+
+func ReadRootProcess_kill_Params(msg *capnp.Message) (Process_kill_Params, error) {
+	root, err := msg.Root()
+	return Process_kill_Params{root.Struct()}, err
+}
+
+func (s Process_kill_Params) String() string {
+	str, _ := text.Marshal(0xd664a71cbac34a9c, s.Struct)
+	return str
+}
+
+func (s Process_kill_Params) Signal() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s Process_kill_Params) SetSignal(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+// Process_kill_Params_List is a list of Process_kill_Params.
+type Process_kill_Params_List struct{ capnp.List }
+
+// NewProcess_kill_Params creates a new list of Process_kill_Params.
+func NewProcess_kill_Params_List(s *capnp.Segment, sz int32) (Process_kill_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return Process_kill_Params_List{l}, err
+}
+
+func (s Process_kill_Params_List) At(i int) Process_kill_Params {
+	return Process_kill_Params{s.List.Struct(i)}
+}
+
+func (s Process_kill_Params_List) Set(i int, v Process_kill_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_kill_Params_List) String() string {
+	str, _ := text.MarshalList(0xd664a71cbac34a9c, s.List)
+	return str
+}
+
+// Process_kill_Params_Future is a wrapper for a Process_kill_Params promised by a client call.
+type Process_kill_Params_Future struct{ *capnp.Future }
+
+func (p Process_kill_Params_Future) Struct() (Process_kill_Params, error) {
+	s, err := p.Future.Struct()
+	return Process_kill_Params{s}, err
+}
+
+type Process_kill_Results struct{ capnp.Struct }
+
+// Process_kill_Results_TypeID is the unique identifier for the type Process_kill_Results.
+const Process_kill_Results_TypeID = 0xc99fc62e554cea0d
+
+func NewProcess_kill_Results(s *capnp.Segment) (Process_kill_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_kill_Results{st}, err
+}
+
+func NewRootProcess_kill_Results(s *capnp.Segment) (Process_kill_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_kill_Results{st}, err
+}
+
+func ReadRootProcess_kill_Results(msg *capnp.Message) (Process_kill_Results, error) {
+	root, err := msg.Root()
+	return Process_kill_Results{root.Struct()}, err
+}
+
+func (s Process_kill_Results) String() string {
+	str, _ := text.Marshal(0xc99fc62e554cea0d, s.Struct)
+	return str
+}
+
+// Process_kill_Results_List is a list of Process_kill_Results.
+type Process_kill_Results_List struct{ capnp.List }
+
+// NewProcess_kill_Results creates a new list of Process_kill_Results.
+func NewProcess_kill_Results_List(s *capnp.Segment, sz int32) (Process_kill_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Process_kill_Results_List{l}, err
+}
+
+func (s Process_kill_Results_List) At(i int) Process_kill_Results {
+	return Process_kill_Results{s.List.Struct(i)}
+}
+
+func (s Process_kill_Results_List) Set(i int, v Process_kill_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_kill_Results_List) String() string {
+	str, _ := text.MarshalList(0xc99fc62e554cea0d, s.List)
+	return str
+}
+
+// Process_kill_Results_Future is a wrapper for a Process_kill_Results promised by a client call.
+type Process_kill_Results_Future struct{ *capnp.Future }
+
+func (p Process_kill_Results_Future) Struct() (Process_kill_Results, error) {
+	s, err := p.Future.Struct()
+	return Process_kill_Results{s}, err
+}
+
+type Process_stdin_Params struct{ capnp.Struct }
+
+// Process_stdin_Params_TypeID is the unique identifier for the type Process_stdin_Params.
+const Process_stdin_Params_TypeID = 0xefbb03ff97812424
+
+func NewProcess_stdin_Params(s *capnp.Segment) (Process_stdin_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stdin_Params{st}, err
+}
+
+func NewRootProcess_stdin_Params(s *capnp.Segment) (Process_stdin_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_stdin_Params{st}, err
+}
+
+func ReadRootProcess_stdin_Params(msg *capnp.Message) (Process_stdin_Params, error) {
+	root, err := msg.Root()
+	return Process_stdin_Params{root.Struct()}, err
+}
+
+func (s Process_stdin_Params) String() string {
+	str, _ := text.Marshal(0xefbb03ff97812424, s.Struct)
+	return str
+}
+
+// Process_stdin_Params_List is a list of Process_stdin_Params.
+type Process_stdin_Params_List struct{ capnp.List }
+
+// NewProcess_stdin_Params creates a new list of Process_stdin_Params.
+func NewProcess_stdin_Params_List(s *capnp.Segment, sz int32) (Process_stdin_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Process_stdin_Params_List{l}, err
+}
+
+func (s Process_stdin_Params_List) At(i int) Process_stdin_Params {
+	return Process_stdin_Params{s.List.Struct(i)}
+}
+
+func (s Process_stdin_Params_List) Set(i int, v Process_stdin_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_stdin_Params_List) String() string {
+	str, _ := text.MarshalList(0xefbb03ff97812424, s.List)
+	return str
+}
+
+// Process_stdin_Params_Future is a wrapper for a Process_stdin_Params promised by a client call.
+type Process_stdin_Params_Future struct{ *capnp.Future }
+
+func (p Process_stdin_Params_Future) Struct() (Process_stdin_Params, error) {
+	s, err := p.Future.Struct()
+	return Process_stdin_Params{s}, err
+}
+
+type Process_stdin_Results struct{ capnp.Struct }
+
+// Process_stdin_Results_TypeID is the unique identifier for the type Process_stdin_Results.
+const Process_stdin_Results_TypeID = 0xca9e4d456b92bb79
+
+func NewProcess_stdin_Results(s *capnp.Segment) (Process_stdin_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdin_Results{st}, err
+}
+
+func NewRootProcess_stdin_Results(s *capnp.Segment) (Process_stdin_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_stdin_Results{st}, err
+}
+
+func ReadRootProcess_stdin_Results(msg *capnp.Message) (Process_stdin_Results, error) {
+	root, err := msg.Root()
+	return Process_stdin_Results{root.Struct()}, err
+}
+
+func (s Process_stdin_Results) String() string {
+	str, _ := text.Marshal(0xca9e4d456b92bb79, s.Struct)
+	return str
+}
+
+func (s Process_stdin_Results) Stdin() Writer {
+	p, _ := s.Struct.Ptr(0)
+	return Writer{Client: p.Interface().Client()}
+}
+
+func (s Process_stdin_Results) HasStdin() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Process_stdin_Results) SetStdin(v Writer) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+// Process_stdin_Results_List is a list of Process_stdin_Results.
+type Process_stdin_Results_List struct{ capnp.List }
+
+// NewProcess_stdin_Results creates a new list of Process_stdin_Results.
+func NewProcess_stdin_Results_List(s *capnp.Segment, sz int32) (Process_stdin_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Process_stdin_Results_List{l}, err
+}
+
+func (s Process_stdin_Results_List) At(i int) Process_stdin_Results {
+	return Process_stdin_Results{s.List.Struct(i)}
+}
+
+func (s Process_stdin_Results_List) Set(i int, v Process_stdin_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_stdin_Results_List) String() string {
+	str, _ := text.MarshalList(0xca9e4d456b92bb79, s.List)
+	return str
+}
+
+// Process_stdin_Results_Future is a wrapper for a Process_stdin_Results promised by a client call.
+type Process_stdin_Results_Future struct{ *capnp.Future }
+
+func (p Process_stdin_Results_Future) Struct() (Process_stdin_Results, error) {
+	s, err := p.Future.Struct()
+	return Process_stdin_Results{s}, err
+}
+
+func (p Process_stdin_Results_Future) Stdin() Writer {
+	return Writer{Client: p.Future.Field(0, nil).Client()}
+}
+
+type Process_id_Params struct{ capnp.Struct }
+
+// Process_id_Params_TypeID is the unique identifier for the type Process_id_Params.
+const Process_id_Params_TypeID = 0xe006f62e6fbb3fdc
+
+func NewProcess_id_Params(s *capnp.Segment) (Process_id_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_id_Params{st}, err
+}
+
+func NewRootProcess_id_Params(s *capnp.Segment) (Process_id_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_id_Params{st}, err
+}
+
+func ReadRootProcess_id_Params(msg *capnp.Message) (Process_id_Params, error) {
+	root, err := msg.Root()
+	return Process_id_Params{root.Struct()}, err
+}
+
+func (s Process_id_Params) String() string {
+	str, _ := text.Marshal(0xe006f62e6fbb3fdc, s.Struct)
+	return str
+}
+
+// Process_id_Params_List is a list of Process_id_Params.
+type Process_id_Params_List struct{ capnp.List }
+
+// NewProcess_id_Params creates a new list of Process_id_Params.
+func NewProcess_id_Params_List(s *capnp.Segment, sz int32) (Process_id_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Process_id_Params_List{l}, err
+}
+
+func (s Process_id_Params_List) At(i int) Process_id_Params {
+	return Process_id_Params{s.List.Struct(i)}
+}
+
+func (s Process_id_Params_List) Set(i int, v Process_id_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_id_Params_List) String() string {
+	str, _ := text.MarshalList(0xe006f62e6fbb3fdc, s.List)
+	return str
+}
+
+// Process_id_Params_Future is a wrapper for a Process_id_Params promised by a client call.
+type Process_id_Params_Future struct{ *capnp.Future }
+
+func (p Process_id_Params_Future) Struct() (Process_id_Params, error) {
+	s, err := p.Future.Struct()
+	return Process_id_Params{s}, err
+}
+
+type Process_id_Results struct{ capnp.Struct }
+
+// Process_id_Results_TypeID is the unique identifier for the type Process_id_Results.
+const Process_id_Results_TypeID = 0xe476143300f40d48
+
+func NewProcess_id_Results(s *capnp.Segment) (Process_id_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_id_Results{st}, err
+}
+
+func NewRootProcess_id_Results(s *capnp.Segment) (Process_id_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Process_id_Results{st}, err
+}
+
+func ReadRootProcess_id_Results(msg *capnp.Message) (Process_id_Results, error) {
+	root, err := msg.Root()
+	return Process_id_Results{root.Struct()}, err
+}
+
+func (s Process_id_Results) String() string {
+	str, _ := text.Marshal(0xe476143300f40d48, s.Struct)
+	return str
+}
+
+func (s Process_id_Results) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Process_id_Results) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Process_id_Results) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Process_id_Results) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Process_id_Results_List is a list of Process_id_Results.
+type Process_id_Results_List struct{ capnp.List }
+
+// NewProcess_id_Results creates a new list of Process_id_Results.
+func NewProcess_id_Results_List(s *capnp.Segment, sz int32) (Process_id_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Process_id_Results_List{l}, err
+}
+
+func (s Process_id_Results_List) At(i int) Process_id_Results {
+	return Process_id_Results{s.List.Struct(i)}
+}
+
+func (s Process_id_Results_List) Set(i int, v Process_id_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_id_Results_List) String() string {
+	str, _ := text.MarshalList(0xe476143300f40d48, s.List)
+	return str
+}
+
+// Process_id_Results_Future is a wrapper for a Process_id_Results promised by a client call.
+type Process_id_Results_Future struct{ *capnp.Future }
+
+func (p Process_id_Results_Future) Struct() (Process_id_Results, error) {
+	s, err := p.Future.Struct()
+	return Process_id_Results{s}, err
+}
+
+type Process_logs_Params struct{ capnp.Struct }
+
+// Process_logs_Params_TypeID is the unique identifier for the type Process_logs_Params.
+const Process_logs_Params_TypeID = 0xb126efa6374d3fed
+
+func NewProcess_logs_Params(s *capnp.Segment) (Process_logs_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Process_logs_Params{st}, err
+}
+
+func NewRootProcess_logs_Params(s *capnp.Segment) (Process_logs_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Process_logs_Params{st}, err
+}
+
+func ReadRootProcess_logs_Params(msg *capnp.Message) (Process_logs_Params, error) {
+	root, err := msg.Root()
+	return Process_logs_Params{root.Struct()}, err
+}
+
+func (s Process_logs_Params) String() string {
+	str, _ := text.Marshal(0xb126efa6374d3fed, s.Struct)
+	return str
+}
+
+func (s Process_logs_Params) Follow() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Process_logs_Params) SetFollow(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Process_logs_Params) Tail() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Process_logs_Params) SetTail(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Process_logs_Params) Stdout() Writer {
+	p, _ := s.Struct.Ptr(0)
+	return Writer{Client: p.Interface().Client()}
+}
+
+func (s Process_logs_Params) HasStdout() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Process_logs_Params) SetStdout(v Writer) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+func (s Process_logs_Params) Stderr() Writer {
+	p, _ := s.Struct.Ptr(1)
+	return Writer{Client: p.Interface().Client()}
+}
+
+func (s Process_logs_Params) HasStderr() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Process_logs_Params) SetStderr(v Writer) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(1, in.ToPtr())
+}
+
+// Process_logs_Params_List is a list of Process_logs_Params.
+type Process_logs_Params_List struct{ capnp.List }
+
+// NewProcess_logs_Params creates a new list of Process_logs_Params.
+func NewProcess_logs_Params_List(s *capnp.Segment, sz int32) (Process_logs_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return Process_logs_Params_List{l}, err
+}
+
+func (s Process_logs_Params_List) At(i int) Process_logs_Params {
+	return Process_logs_Params{s.List.Struct(i)}
+}
+
+func (s Process_logs_Params_List) Set(i int, v Process_logs_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_logs_Params_List) String() string {
+	str, _ := text.MarshalList(0xb126efa6374d3fed, s.List)
+	return str
+}
+
+// Process_logs_Params_Future is a wrapper for a Process_logs_Params promised by a client call.
+type Process_logs_Params_Future struct{ *capnp.Future }
+
+func (p Process_logs_Params_Future) Struct() (Process_logs_Params, error) {
+	s, err := p.Future.Struct()
+	return Process_logs_Params{s}, err
+}
+
+func (p Process_logs_Params_Future) Stdout() Writer {
+	return Writer{Client: p.Future.Field(0, nil).Client()}
+}
+
+func (p Process_logs_Params_Future) Stderr() Writer {
+	return Writer{Client: p.Future.Field(1, nil).Client()}
+}
+
+type Process_logs_Results struct{ capnp.Struct }
+
+// Process_logs_Results_TypeID is the unique identifier for the type Process_logs_Results.
+const Process_logs_Results_TypeID = 0xab9a9d0645e54619
+
+func NewProcess_logs_Results(s *capnp.Segment) (Process_logs_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_logs_Results{st}, err
+}
+
+func NewRootProcess_logs_Results(s *capnp.Segment) (Process_logs_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Process_logs_Results{st}, err
+}
+
+func ReadRootProcess_logs_Results(msg *capnp.Message) (Process_logs_Results, error) {
+	root, err := msg.Root()
+	return Process_logs_Results{root.Struct()}, err
+}
+
+func (s Process_logs_Results) String() string {
+	str, _ := text.Marshal(0xab9a9d0645e54619, s.Struct)
+	return str
+}
+
+// Process_logs_Results_List is a list of Process_logs_Results.
+type Process_logs_Results_List struct{ capnp.List }
+
+// NewProcess_logs_Results creates a new list of Process_logs_Results.
+func NewProcess_logs_Results_List(s *capnp.Segment, sz int32) (Process_logs_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Process_logs_Results_List{l}, err
+}
+
+func (s Process_logs_Results_List) At(i int) Process_logs_Results {
+	return Process_logs_Results{s.List.Struct(i)}
+}
+
+func (s Process_logs_Results_List) Set(i int, v Process_logs_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Process_logs_Results_List) String() string {
+	str, _ := text.MarshalList(0xab9a9d0645e54619, s.List)
+	return str
+}
+
+// Process_logs_Results_Future is a wrapper for a Process_logs_Results promised by a client call.
+type Process_logs_Results_Future struct{ *capnp.Future }
+
+func (p Process_logs_Results_Future) Struct() (Process_logs_Results, error) {
+	s, err := p.Future.Struct()
+	return Process_logs_Results{s}, err
+}
+
+type Log struct{ capnp.Struct }
+
+// Log_TypeID is the unique identifier for the type Log.
+const Log_TypeID = 0xba2aac5c2c0a368f
+
+func NewLog(s *capnp.Segment) (Log, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Log{st}, err
+}
+
+func NewRootLog(s *capnp.Segment) (Log, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Log{st}, err
+}
+
+func ReadRootLog(msg *capnp.Message) (Log, error) {
+	root, err := msg.Root()
+	return Log{root.Struct()}, err
+}
+
+func (s Log) String() string {
+	str, _ := text.Marshal(0xba2aac5c2c0a368f, s.Struct)
+	return str
+}
+
+func (s Log) Status() (Status, error) {
+	p, err := s.Struct.Ptr(0)
+	return Status{Struct: p.Struct()}, err
+}
+
+func (s Log) HasStatus() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Log) SetStatus(v Status) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewStatus sets the status field to a newly
+// allocated Status struct, preferring placement in s's segment.
+func (s Log) NewStatus() (Status, error) {
+	ss, err := NewStatus(s.Struct.Segment())
+	if err != nil {
+		return Status{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Log) Entries() (Log_Entry_List, error) {
+	p, err := s.Struct.Ptr(1)
+	return Log_Entry_List{List: p.List()}, err
+}
+
+func (s Log) HasEntries() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Log) SetEntries(v Log_Entry_List) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewEntries sets the entries field to a newly
+// allocated Log_Entry_List, preferring placement in s's segment.
+func (s Log) NewEntries(n int32) (Log_Entry_List, error) {
+	l, err := NewLog_Entry_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Log_Entry_List{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+// Log_List is a list of Log.
+type Log_List struct{ capnp.List }
+
+// NewLog creates a new list of Log.
+func NewLog_List(s *capnp.Segment, sz int32) (Log_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return Log_List{l}, err
+}
+
+func (s Log_List) At(i int) Log { return Log{s.List.Struct(i)} }
+
+func (s Log_List) Set(i int, v Log) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Log_List) String() string {
+	str, _ := text.MarshalList(0xba2aac5c2c0a368f, s.List)
+	return str
+}
+
+// Log_Future is a wrapper for a Log promised by a client call.
+type Log_Future struct{ *capnp.Future }
+
+func (p Log_Future) Struct() (Log, error) {
+	s, err := p.Future.Struct()
+	return Log{s}, err
+}
+
+func (p Log_Future) Status() Status_Future {
+	return Status_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Log_Entry struct{ capnp.Struct }
+
+// Log_Entry_TypeID is the unique identifier for the type Log_Entry.
+const Log_Entry_TypeID = 0xa9c6cc7ac622110a
+
+func NewLog_Entry(s *capnp.Segment) (Log_Entry, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Log_Entry{st}, err
+}
+
+func NewRootLog_Entry(s *capnp.Segment) (Log_Entry, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Log_Entry{st}, err
+}
+
+func ReadRootLog_Entry(msg *capnp.Message) (Log_Entry, error) {
+	root, err := msg.Root()
+	return Log_Entry{root.Struct()}, err
+}
+
+func (s Log_Entry) String() string {
+	str, _ := text.Marshal(0xa9c6cc7ac622110a, s.Struct)
+	return str
+}
+
+func (s Log_Entry) Stream() Log_Stream {
+	return Log_Stream(s.Struct.Uint16(0))
+}
+
+func (s Log_Entry) SetStream(v Log_Stream) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Log_Entry) Data() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Log_Entry) HasData() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Log_Entry) SetData(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+// Log_Entry_List is a list of Log_Entry.
+type Log_Entry_List struct{ capnp.List }
+
+// NewLog_Entry creates a new list of Log_Entry.
+func NewLog_Entry_List(s *capnp.Segment, sz int32) (Log_Entry_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return Log_Entry_List{l}, err
+}
+
+func (s Log_Entry_List) At(i int) Log_Entry { return Log_Entry{s.List.Struct(i)} }
+
+func (s Log_Entry_List) Set(i int, v Log_Entry) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Log_Entry_List) String() string {
+	str, _ := text.MarshalList(0xa9c6cc7ac622110a, s.List)
+	return str
+}
+
+// Log_Entry_Future is a wrapper for a Log_Entry promised by a client call.
+type Log_Entry_Future struct{ *capnp.Future }
+
+func (p Log_Entry_Future) Struct() (Log_Entry, error) {
+	s, err := p.Future.Struct()
+	return Log_Entry{s}, err
+}
+
+type Log_Stream uint16
+
+// Log_Stream_TypeID is the unique identifier for the type Log_Stream.
+const Log_Stream_TypeID = 0xa2fc87bddbd7698e
+
+// Values of Log_Stream.
+const (
+	Log_Stream_stdout Log_Stream = 0
+	Log_Stream_stderr Log_Stream = 1
+)
+
+// String returns the enum's constant name.
+func (c Log_Stream) String() string {
+	switch c {
+	case Log_Stream_stdout:
+		return "stdout"
+	case Log_Stream_stderr:
+		return "stderr"
+
+	default:
+		return ""
+	}
+}
+
+// Log_StreamFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Log_StreamFromString(c string) Log_Stream {
+	switch c {
+	case "stdout":
+		return Log_Stream_stdout
+	case "stderr":
+		return Log_Stream_stderr
+
+	default:
+		return 0
+	}
+}
+
+type Log_Stream_List struct{ capnp.List }
+
+func NewLog_Stream_List(s *capnp.Segment, sz int32) (Log_Stream_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return Log_Stream_List{l.List}, err
+}
+
+func (l Log_Stream_List) At(i int) Log_Stream {
+	ul := capnp.UInt16List{List: l.List}
+	return Log_Stream(ul.At(i))
+}
+
+func (l Log_Stream_List) Set(i int, v Log_Stream) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Status struct{ capnp.Struct }
+
+// Status_TypeID is the unique identifier for the type Status.
+const Status_TypeID = 0x89cd7815498fb993
+
+func NewStatus(s *capnp.Segment) (Status, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Status{st}, err
+}
+
+func NewRootStatus(s *capnp.Segment) (Status, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Status{st}, err
+}
+
+func ReadRootStatus(msg *capnp.Message) (Status, error) {
+	root, err := msg.Root()
+	return Status{root.Struct()}, err
+}
+
+func (s Status) String() string {
+	str, _ := text.Marshal(0x89cd7815498fb993, s.Struct)
+	return str
+}
+
+func (s Status) State() Status_State {
+	return Status_State(s.Struct.Uint16(0))
+}
+
+func (s Status) SetState(v Status_State) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Status) Pid() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s Status) SetPid(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+func (s Status) ExitCode() int32 {
+	return int32(s.Struct.Uint32(4))
+}
+
+func (s Status) SetExitCode(v int32) {
+	s.Struct.SetUint32(4, uint32(v))
+}
+
+func (s Status) Path() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Status) HasPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Status) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Status) SetPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Status) Restarts() uint32 {
+	return s.Struct.Uint32(16)
+}
+
+func (s Status) SetRestarts(v uint32) {
+	s.Struct.SetUint32(16, v)
+}
+
+func (s Status) OomKilled() bool {
+	return s.Struct.Bit(16)
+}
+
+func (s Status) SetOomKilled(v bool) {
+	s.Struct.SetBit(16, v)
+}
+
+// Status_List is a list of Status.
+type Status_List struct{ capnp.List }
+
+// NewStatus creates a new list of Status.
+func NewStatus_List(s *capnp.Segment, sz int32) (Status_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return Status_List{l}, err
+}
+
+func (s Status_List) At(i int) Status { return Status{s.List.Struct(i)} }
+
+func (s Status_List) Set(i int, v Status) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Status_List) String() string {
+	str, _ := text.MarshalList(0x89cd7815498fb993, s.List)
+	return str
+}
+
+// Status_Future is a wrapper for a Status promised by a client call.
+type Status_Future struct{ *capnp.Future }
+
+func (p Status_Future) Struct() (Status, error) {
+	s, err := p.Future.Struct()
+	return Status{s}, err
+}
+
+type Status_State uint16
+
+// Status_State_TypeID is the unique identifier for the type Status_State.
+const Status_State_TypeID = 0x832b5e5c1823da78
+
+// Values of Status_State.
+const (
+	Status_State_running Status_State = 0
+	Status_State_exited  Status_State = 1
+	Status_State_failed  Status_State = 2
+)
+
+// String returns the enum's constant name.
+func (c Status_State) String() string {
+	switch c {
+	case Status_State_running:
+		return "running"
+	case Status_State_exited:
+		return "exited"
+	case Status_State_failed:
+		return "failed"
+
+	default:
+		return ""
+	}
+}
+
+// Status_StateFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Status_StateFromString(c string) Status_State {
+	switch c {
+	case "running":
+		return Status_State_running
+	case "exited":
+		return Status_State_exited
+	case "failed":
+		return Status_State_failed
+
+	default:
+		return 0
+	}
+}
+
+type Status_State_List struct{ capnp.List }
+
+func NewStatus_State_List(s *capnp.Segment, sz int32) (Status_State_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return Status_State_List{l.List}, err
+}
+
+func (l Status_State_List) At(i int) Status_State {
+	ul := capnp.UInt16List{List: l.List}
+	return Status_State(ul.At(i))
+}
+
+func (l Status_State_List) Set(i int, v Status_State) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Writer struct{ Client *capnp.Client }
+
+// Writer_TypeID is the unique identifier for the type Writer.
+const Writer_TypeID = 0xa6d08cbed6788196
+
+func (c Writer) Write(ctx context.Context, params func(Writer_write_Params) error) (Writer_write_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xa6d08cbed6788196,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Writer",
+			MethodName:    "write",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Writer_write_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Writer_write_Results_Future{Future: ans.Future()}, release
+}
+func (c Writer) Close(ctx context.Context, params func(Writer_close_Params) error) (Writer_close_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xa6d08cbed6788196,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Writer",
+			MethodName:    "close",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Writer_close_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Writer_close_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Writer) AddRef() Writer {
+	return Writer{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Writer) Release() {
+	c.Client.Release()
+}
+
+// A Writer_Server is a Writer with a local implementation.
+type Writer_Server interface {
+	Write(context.Context, Writer_write) error
+
+	Close(context.Context, Writer_close) error
+}
+
+// Writer_NewServer creates a new Server from an implementation of Writer_Server.
+func Writer_NewServer(s Writer_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Writer_Methods(nil, s), s, c, policy)
+}
+
+// Writer_ServerToClient creates a new Client from an implementation of Writer_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Writer_ServerToClient(s Writer_Server, policy *server.Policy) Writer {
+	return Writer{Client: capnp.NewClient(Writer_NewServer(s, policy))}
+}
+
+// Writer_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Writer_Methods(methods []server.Method, s Writer_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa6d08cbed6788196,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Writer",
+			MethodName:    "write",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Write(ctx, Writer_write{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa6d08cbed6788196,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Writer",
+			MethodName:    "close",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Close(ctx, Writer_close{call})
+		},
+	})
+
+	return methods
+}
+
+// Writer_write holds the state for a server call to Writer.write.
+// See server.Call for documentation.
+type Writer_write struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Writer_write) Args() Writer_write_Params {
+	return Writer_write_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Writer_write) AllocResults() (Writer_write_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_write_Results{Struct: r}, err
+}
+
+// Writer_close holds the state for a server call to Writer.close.
+// See server.Call for documentation.
+type Writer_close struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Writer_close) Args() Writer_close_Params {
+	return Writer_close_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Writer_close) AllocResults() (Writer_close_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_close_Results{Struct: r}, err
+}
+
+type Writer_write_Params struct{ capnp.Struct }
+
+// Writer_write_Params_TypeID is the unique identifier for the type Writer_write_Params.
+const Writer_write_Params_TypeID = 0xd2620cf11701080f
+
+func NewWriter_write_Params(s *capnp.Segment) (Writer_write_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Writer_write_Params{st}, err
+}
+
+func NewRootWriter_write_Params(s *capnp.Segment) (Writer_write_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Writer_write_Params{st}, err
+}
+
+func ReadRootWriter_write_Params(msg *capnp.Message) (Writer_write_Params, error) {
+	root, err := msg.Root()
+	return Writer_write_Params{root.Struct()}, err
+}
+
+func (s Writer_write_Params) String() string {
+	str, _ := text.Marshal(0xd2620cf11701080f, s.Struct)
+	return str
+}
+
+func (s Writer_write_Params) Data() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Writer_write_Params) HasData() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Writer_write_Params) SetData(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+// Writer_write_Params_List is a list of Writer_write_Params.
+type Writer_write_Params_List struct{ capnp.List }
+
+// NewWriter_write_Params creates a new list of Writer_write_Params.
+func NewWriter_write_Params_List(s *capnp.Segment, sz int32) (Writer_write_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Writer_write_Params_List{l}, err
+}
+
+func (s Writer_write_Params_List) At(i int) Writer_write_Params {
+	return Writer_write_Params{s.List.Struct(i)}
+}
+
+func (s Writer_write_Params_List) Set(i int, v Writer_write_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Writer_write_Params_List) String() string {
+	str, _ := text.MarshalList(0xd2620cf11701080f, s.List)
+	return str
+}
+
+// Writer_write_Params_Future is a wrapper for a Writer_write_Params promised by a client call.
+type Writer_write_Params_Future struct{ *capnp.Future }
+
+func (p Writer_write_Params_Future) Struct() (Writer_write_Params, error) {
+	s, err := p.Future.Struct()
+	return Writer_write_Params{s}, err
+}
+
+type Writer_write_Results struct{ capnp.Struct }
+
+// Writer_write_Results_TypeID is the unique identifier for the type Writer_write_Results.
+const Writer_write_Results_TypeID = 0x9c0b5e68c50a23a2
+
+func NewWriter_write_Results(s *capnp.Segment) (Writer_write_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_write_Results{st}, err
+}
+
+func NewRootWriter_write_Results(s *capnp.Segment) (Writer_write_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_write_Results{st}, err
+}
+
+func ReadRootWriter_write_Results(msg *capnp.Message) (Writer_write_Results, error) {
+	root, err := msg.Root()
+	return Writer_write_Results{root.Struct()}, err
+}
+
+func (s Writer_write_Results) String() string {
+	str, _ := text.Marshal(0x9c0b5e68c50a23a2, s.Struct)
+	return str
+}
+
+// Writer_write_Results_List is a list of Writer_write_Results.
+type Writer_write_Results_List struct{ capnp.List }
+
+// NewWriter_write_Results creates a new list of Writer_write_Results.
+func NewWriter_write_Results_List(s *capnp.Segment, sz int32) (Writer_write_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Writer_write_Results_List{l}, err
+}
+
+func (s Writer_write_Results_List) At(i int) Writer_write_Results {
+	return Writer_write_Results{s.List.Struct(i)}
+}
+
+func (s Writer_write_Results_List) Set(i int, v Writer_write_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Writer_write_Results_List) String() string {
+	str, _ := text.MarshalList(0x9c0b5e68c50a23a2, s.List)
+	return str
+}
+
+// Writer_write_Results_Future is a wrapper for a Writer_write_Results promised by a client call.
+type Writer_write_Results_Future struct{ *capnp.Future }
+
+func (p Writer_write_Results_Future) Struct() (Writer_write_Results, error) {
+	s, err := p.Future.Struct()
+	return Writer_write_Results{s}, err
+}
+
+type Writer_close_Params struct{ capnp.Struct }
+
+// Writer_close_Params_TypeID is the unique identifier for the type Writer_close_Params.
+const Writer_close_Params_TypeID = 0xf65e7520673573be
+
+func NewWriter_close_Params(s *capnp.Segment) (Writer_close_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_close_Params{st}, err
+}
+
+func NewRootWriter_close_Params(s *capnp.Segment) (Writer_close_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_close_Params{st}, err
+}
+
+func ReadRootWriter_close_Params(msg *capnp.Message) (Writer_close_Params, error) {
+	root, err := msg.Root()
+	return Writer_close_Params{root.Struct()}, err
+}
+
+func (s Writer_close_Params) String() string {
+	str, _ := text.Marshal(0xf65e7520673573be, s.Struct)
+	return str
+}
+
+// Writer_close_Params_List is a list of Writer_close_Params.
+type Writer_close_Params_List struct{ capnp.List }
+
+// NewWriter_close_Params creates a new list of Writer_close_Params.
+func NewWriter_close_Params_List(s *capnp.Segment, sz int32) (Writer_close_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Writer_close_Params_List{l}, err
+}
+
+func (s Writer_close_Params_List) At(i int) Writer_close_Params {
+	return Writer_close_Params{s.List.Struct(i)}
+}
+
+func (s Writer_close_Params_List) Set(i int, v Writer_close_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Writer_close_Params_List) String() string {
+	str, _ := text.MarshalList(0xf65e7520673573be, s.List)
+	return str
+}
+
+// Writer_close_Params_Future is a wrapper for a Writer_close_Params promised by a client call.
+type Writer_close_Params_Future struct{ *capnp.Future }
+
+func (p Writer_close_Params_Future) Struct() (Writer_close_Params, error) {
+	s, err := p.Future.Struct()
+	return Writer_close_Params{s}, err
+}
+
+type Writer_close_Results struct{ capnp.Struct }
+
+// Writer_close_Results_TypeID is the unique identifier for the type Writer_close_Results.
+const Writer_close_Results_TypeID = 0xc57ddd2ce50821dc
+
+func NewWriter_close_Results(s *capnp.Segment) (Writer_close_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_close_Results{st}, err
+}
+
+func NewRootWriter_close_Results(s *capnp.Segment) (Writer_close_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Writer_close_Results{st}, err
+}
+
+func ReadRootWriter_close_Results(msg *capnp.Message) (Writer_close_Results, error) {
+	root, err := msg.Root()
+	return Writer_close_Results{root.Struct()}, err
+}
+
+func (s Writer_close_Results) String() string {
+	str, _ := text.Marshal(0xc57ddd2ce50821dc, s.Struct)
+	return str
+}
+
+// Writer_close_Results_List is a list of Writer_close_Results.
+type Writer_close_Results_List struct{ capnp.List }
+
+// NewWriter_close_Results creates a new list of Writer_close_Results.
+func NewWriter_close_Results_List(s *capnp.Segment, sz int32) (Writer_close_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Writer_close_Results_List{l}, err
+}
+
+func (s Writer_close_Results_List) At(i int) Writer_close_Results {
+	return Writer_close_Results{s.List.Struct(i)}
+}
+
+func (s Writer_close_Results_List) Set(i int, v Writer_close_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Writer_close_Results_List) String() string {
+	str, _ := text.MarshalList(0xc57ddd2ce50821dc, s.List)
+	return str
+}
+
+// Writer_close_Results_Future is a wrapper for a Writer_close_Results promised by a client call.
+type Writer_close_Results_Future struct{ *capnp.Future }
+
+func (p Writer_close_Results_Future) Struct() (Writer_close_Results, error) {
+	s, err := p.Future.Struct()
+	return Writer_close_Results{s}, err
+}
+
+type Scheduler struct{ Client *capnp.Client }
+
+// Scheduler_TypeID is the unique identifier for the type Scheduler.
+const Scheduler_TypeID = 0xf966031afbf0d9fb
+
+func (c Scheduler) Submit(ctx context.Context, params func(Scheduler_submit_Params) error) (Scheduler_submit_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf966031afbf0d9fb,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Scheduler",
+			MethodName:    "submit",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Scheduler_submit_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Scheduler_submit_Results_Future{Future: ans.Future()}, release
+}
+func (c Scheduler) Ls(ctx context.Context, params func(Scheduler_ls_Params) error) (Scheduler_ls_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf966031afbf0d9fb,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Scheduler",
+			MethodName:    "ls",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Scheduler_ls_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Scheduler_ls_Results_Future{Future: ans.Future()}, release
+}
+func (c Scheduler) Lookup(ctx context.Context, params func(Scheduler_lookup_Params) error) (Scheduler_lookup_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf966031afbf0d9fb,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Scheduler",
+			MethodName:    "lookup",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Scheduler_lookup_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Scheduler_lookup_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Scheduler) AddRef() Scheduler {
+	return Scheduler{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Scheduler) Release() {
+	c.Client.Release()
+}
+
+// A Scheduler_Server is a Scheduler with a local implementation.
+type Scheduler_Server interface {
+	Submit(context.Context, Scheduler_submit) error
+
+	Ls(context.Context, Scheduler_ls) error
+
+	Lookup(context.Context, Scheduler_lookup) error
+}
+
+// Scheduler_NewServer creates a new Server from an implementation of Scheduler_Server.
+func Scheduler_NewServer(s Scheduler_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Scheduler_Methods(nil, s), s, c, policy)
+}
+
+// Scheduler_ServerToClient creates a new Client from an implementation of Scheduler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Scheduler_ServerToClient(s Scheduler_Server, policy *server.Policy) Scheduler {
+	return Scheduler{Client: capnp.NewClient(Scheduler_NewServer(s, policy))}
+}
+
+// Scheduler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Scheduler_Methods(methods []server.Method, s Scheduler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 3)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf966031afbf0d9fb,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Scheduler",
+			MethodName:    "submit",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Submit(ctx, Scheduler_submit{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf966031afbf0d9fb,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Scheduler",
+			MethodName:    "ls",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Ls(ctx, Scheduler_ls{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf966031afbf0d9fb,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Scheduler",
+			MethodName:    "lookup",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Lookup(ctx, Scheduler_lookup{call})
+		},
+	})
+
+	return methods
+}
+
+// Scheduler_submit holds the state for a server call to Scheduler.submit.
+// See server.Call for documentation.
+type Scheduler_submit struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Scheduler_submit) Args() Scheduler_submit_Params {
+	return Scheduler_submit_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Scheduler_submit) AllocResults() (Scheduler_submit_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_submit_Results{Struct: r}, err
+}
+
+// Scheduler_ls holds the state for a server call to Scheduler.ls.
+// See server.Call for documentation.
+type Scheduler_ls struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Scheduler_ls) Args() Scheduler_ls_Params {
+	return Scheduler_ls_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Scheduler_ls) AllocResults() (Scheduler_ls_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_ls_Results{Struct: r}, err
+}
+
+// Scheduler_lookup holds the state for a server call to Scheduler.lookup.
+// See server.Call for documentation.
+type Scheduler_lookup struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Scheduler_lookup) Args() Scheduler_lookup_Params {
+	return Scheduler_lookup_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Scheduler_lookup) AllocResults() (Scheduler_lookup_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_lookup_Results{Struct: r}, err
+}
+
+type Scheduler_submit_Params struct{ capnp.Struct }
+
+// Scheduler_submit_Params_TypeID is the unique identifier for the type Scheduler_submit_Params.
+const Scheduler_submit_Params_TypeID = 0xe15f0d9335b2dd1a
+
+func NewScheduler_submit_Params(s *capnp.Segment) (Scheduler_submit_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_submit_Params{st}, err
+}
+
+func NewRootScheduler_submit_Params(s *capnp.Segment) (Scheduler_submit_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_submit_Params{st}, err
+}
+
+func ReadRootScheduler_submit_Params(msg *capnp.Message) (Scheduler_submit_Params, error) {
+	root, err := msg.Root()
+	return Scheduler_submit_Params{root.Struct()}, err
+}
+
+func (s Scheduler_submit_Params) String() string {
+	str, _ := text.Marshal(0xe15f0d9335b2dd1a, s.Struct)
+	return str
+}
+
+func (s Scheduler_submit_Params) Spec() (JobSpec, error) {
+	p, err := s.Struct.Ptr(0)
+	return JobSpec{Struct: p.Struct()}, err
+}
+
+func (s Scheduler_submit_Params) HasSpec() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Scheduler_submit_Params) SetSpec(v JobSpec) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewSpec sets the spec field to a newly
+// allocated JobSpec struct, preferring placement in s's segment.
+func (s Scheduler_submit_Params) NewSpec() (JobSpec, error) {
+	ss, err := NewJobSpec(s.Struct.Segment())
+	if err != nil {
+		return JobSpec{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Scheduler_submit_Params_List is a list of Scheduler_submit_Params.
+type Scheduler_submit_Params_List struct{ capnp.List }
+
+// NewScheduler_submit_Params creates a new list of Scheduler_submit_Params.
+func NewScheduler_submit_Params_List(s *capnp.Segment, sz int32) (Scheduler_submit_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Scheduler_submit_Params_List{l}, err
+}
+
+func (s Scheduler_submit_Params_List) At(i int) Scheduler_submit_Params {
+	return Scheduler_submit_Params{s.List.Struct(i)}
+}
+
+func (s Scheduler_submit_Params_List) Set(i int, v Scheduler_submit_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Scheduler_submit_Params_List) String() string {
+	str, _ := text.MarshalList(0xe15f0d9335b2dd1a, s.List)
+	return str
+}
+
+// Scheduler_submit_Params_Future is a wrapper for a Scheduler_submit_Params promised by a client call.
+type Scheduler_submit_Params_Future struct{ *capnp.Future }
+
+func (p Scheduler_submit_Params_Future) Struct() (Scheduler_submit_Params, error) {
+	s, err := p.Future.Struct()
+	return Scheduler_submit_Params{s}, err
+}
+
+func (p Scheduler_submit_Params_Future) Spec() JobSpec_Future {
+	return JobSpec_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Scheduler_submit_Results struct{ capnp.Struct }
+
+// Scheduler_submit_Results_TypeID is the unique identifier for the type Scheduler_submit_Results.
+const Scheduler_submit_Results_TypeID = 0xc523a117fecc37ec
+
+func NewScheduler_submit_Results(s *capnp.Segment) (Scheduler_submit_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_submit_Results{st}, err
+}
+
+func NewRootScheduler_submit_Results(s *capnp.Segment) (Scheduler_submit_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_submit_Results{st}, err
+}
+
+func ReadRootScheduler_submit_Results(msg *capnp.Message) (Scheduler_submit_Results, error) {
+	root, err := msg.Root()
+	return Scheduler_submit_Results{root.Struct()}, err
+}
+
+func (s Scheduler_submit_Results) String() string {
+	str, _ := text.Marshal(0xc523a117fecc37ec, s.Struct)
+	return str
+}
+
+func (s Scheduler_submit_Results) Job() Job {
+	p, _ := s.Struct.Ptr(0)
+	return Job{Client: p.Interface().Client()}
+}
+
+func (s Scheduler_submit_Results) HasJob() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Scheduler_submit_Results) SetJob(v Job) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+// Scheduler_submit_Results_List is a list of Scheduler_submit_Results.
+type Scheduler_submit_Results_List struct{ capnp.List }
+
+// NewScheduler_submit_Results creates a new list of Scheduler_submit_Results.
+func NewScheduler_submit_Results_List(s *capnp.Segment, sz int32) (Scheduler_submit_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Scheduler_submit_Results_List{l}, err
+}
+
+func (s Scheduler_submit_Results_List) At(i int) Scheduler_submit_Results {
+	return Scheduler_submit_Results{s.List.Struct(i)}
+}
+
+func (s Scheduler_submit_Results_List) Set(i int, v Scheduler_submit_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Scheduler_submit_Results_List) String() string {
+	str, _ := text.MarshalList(0xc523a117fecc37ec, s.List)
+	return str
+}
+
+// Scheduler_submit_Results_Future is a wrapper for a Scheduler_submit_Results promised by a client call.
+type Scheduler_submit_Results_Future struct{ *capnp.Future }
+
+func (p Scheduler_submit_Results_Future) Struct() (Scheduler_submit_Results, error) {
+	s, err := p.Future.Struct()
+	return Scheduler_submit_Results{s}, err
+}
+
+func (p Scheduler_submit_Results_Future) Job() Job {
+	return Job{Client: p.Future.Field(0, nil).Client()}
+}
+
+type Scheduler_ls_Params struct{ capnp.Struct }
+
+// Scheduler_ls_Params_TypeID is the unique identifier for the type Scheduler_ls_Params.
+const Scheduler_ls_Params_TypeID = 0x9452aa16bc772a66
+
+func NewScheduler_ls_Params(s *capnp.Segment) (Scheduler_ls_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Scheduler_ls_Params{st}, err
+}
+
+func NewRootScheduler_ls_Params(s *capnp.Segment) (Scheduler_ls_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Scheduler_ls_Params{st}, err
+}
+
+func ReadRootScheduler_ls_Params(msg *capnp.Message) (Scheduler_ls_Params, error) {
+	root, err := msg.Root()
+	return Scheduler_ls_Params{root.Struct()}, err
+}
+
+func (s Scheduler_ls_Params) String() string {
+	str, _ := text.Marshal(0x9452aa16bc772a66, s.Struct)
+	return str
+}
+
+// Scheduler_ls_Params_List is a list of Scheduler_ls_Params.
+type Scheduler_ls_Params_List struct{ capnp.List }
+
+// NewScheduler_ls_Params creates a new list of Scheduler_ls_Params.
+func NewScheduler_ls_Params_List(s *capnp.Segment, sz int32) (Scheduler_ls_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Scheduler_ls_Params_List{l}, err
+}
+
+func (s Scheduler_ls_Params_List) At(i int) Scheduler_ls_Params {
+	return Scheduler_ls_Params{s.List.Struct(i)}
+}
+
+func (s Scheduler_ls_Params_List) Set(i int, v Scheduler_ls_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Scheduler_ls_Params_List) String() string {
+	str, _ := text.MarshalList(0x9452aa16bc772a66, s.List)
+	return str
+}
+
+// Scheduler_ls_Params_Future is a wrapper for a Scheduler_ls_Params promised by a client call.
+type Scheduler_ls_Params_Future struct{ *capnp.Future }
+
+func (p Scheduler_ls_Params_Future) Struct() (Scheduler_ls_Params, error) {
+	s, err := p.Future.Struct()
+	return Scheduler_ls_Params{s}, err
+}
+
+type Scheduler_ls_Results struct{ capnp.Struct }
+
+// Scheduler_ls_Results_TypeID is the unique identifier for the type Scheduler_ls_Results.
+const Scheduler_ls_Results_TypeID = 0xc2fc099c5b6e041a
+
+func NewScheduler_ls_Results(s *capnp.Segment) (Scheduler_ls_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_ls_Results{st}, err
+}
+
+func NewRootScheduler_ls_Results(s *capnp.Segment) (Scheduler_ls_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_ls_Results{st}, err
+}
+
+func ReadRootScheduler_ls_Results(msg *capnp.Message) (Scheduler_ls_Results, error) {
+	root, err := msg.Root()
+	return Scheduler_ls_Results{root.Struct()}, err
+}
+
+func (s Scheduler_ls_Results) String() string {
+	str, _ := text.Marshal(0xc2fc099c5b6e041a, s.Struct)
+	return str
+}
+
+func (s Scheduler_ls_Results) Jobs() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Scheduler_ls_Results) HasJobs() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Scheduler_ls_Results) SetJobs(v capnp.TextList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewJobs sets the jobs field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Scheduler_ls_Results) NewJobs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Scheduler_ls_Results_List is a list of Scheduler_ls_Results.
+type Scheduler_ls_Results_List struct{ capnp.List }
+
+// NewScheduler_ls_Results creates a new list of Scheduler_ls_Results.
+func NewScheduler_ls_Results_List(s *capnp.Segment, sz int32) (Scheduler_ls_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Scheduler_ls_Results_List{l}, err
+}
+
+func (s Scheduler_ls_Results_List) At(i int) Scheduler_ls_Results {
+	return Scheduler_ls_Results{s.List.Struct(i)}
+}
+
+func (s Scheduler_ls_Results_List) Set(i int, v Scheduler_ls_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Scheduler_ls_Results_List) String() string {
+	str, _ := text.MarshalList(0xc2fc099c5b6e041a, s.List)
+	return str
+}
+
+// Scheduler_ls_Results_Future is a wrapper for a Scheduler_ls_Results promised by a client call.
+type Scheduler_ls_Results_Future struct{ *capnp.Future }
+
+func (p Scheduler_ls_Results_Future) Struct() (Scheduler_ls_Results, error) {
+	s, err := p.Future.Struct()
+	return Scheduler_ls_Results{s}, err
+}
+
+type Scheduler_lookup_Params struct{ capnp.Struct }
+
+// Scheduler_lookup_Params_TypeID is the unique identifier for the type Scheduler_lookup_Params.
+const Scheduler_lookup_Params_TypeID = 0xc91ace2532631722
+
+func NewScheduler_lookup_Params(s *capnp.Segment) (Scheduler_lookup_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_lookup_Params{st}, err
+}
+
+func NewRootScheduler_lookup_Params(s *capnp.Segment) (Scheduler_lookup_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_lookup_Params{st}, err
+}
+
+func ReadRootScheduler_lookup_Params(msg *capnp.Message) (Scheduler_lookup_Params, error) {
+	root, err := msg.Root()
+	return Scheduler_lookup_Params{root.Struct()}, err
+}
+
+func (s Scheduler_lookup_Params) String() string {
+	str, _ := text.Marshal(0xc91ace2532631722, s.Struct)
+	return str
+}
+
+func (s Scheduler_lookup_Params) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Scheduler_lookup_Params) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Scheduler_lookup_Params) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Scheduler_lookup_Params) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Scheduler_lookup_Params_List is a list of Scheduler_lookup_Params.
+type Scheduler_lookup_Params_List struct{ capnp.List }
+
+// NewScheduler_lookup_Params creates a new list of Scheduler_lookup_Params.
+func NewScheduler_lookup_Params_List(s *capnp.Segment, sz int32) (Scheduler_lookup_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Scheduler_lookup_Params_List{l}, err
+}
+
+func (s Scheduler_lookup_Params_List) At(i int) Scheduler_lookup_Params {
+	return Scheduler_lookup_Params{s.List.Struct(i)}
+}
+
+func (s Scheduler_lookup_Params_List) Set(i int, v Scheduler_lookup_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Scheduler_lookup_Params_List) String() string {
+	str, _ := text.MarshalList(0xc91ace2532631722, s.List)
+	return str
+}
+
+// Scheduler_lookup_Params_Future is a wrapper for a Scheduler_lookup_Params promised by a client call.
+type Scheduler_lookup_Params_Future struct{ *capnp.Future }
+
+func (p Scheduler_lookup_Params_Future) Struct() (Scheduler_lookup_Params, error) {
+	s, err := p.Future.Struct()
+	return Scheduler_lookup_Params{s}, err
+}
+
+type Scheduler_lookup_Results struct{ capnp.Struct }
+
+// Scheduler_lookup_Results_TypeID is the unique identifier for the type Scheduler_lookup_Results.
+const Scheduler_lookup_Results_TypeID = 0xb69a625f54e3eb0d
+
+func NewScheduler_lookup_Results(s *capnp.Segment) (Scheduler_lookup_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_lookup_Results{st}, err
+}
+
+func NewRootScheduler_lookup_Results(s *capnp.Segment) (Scheduler_lookup_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Scheduler_lookup_Results{st}, err
+}
+
+func ReadRootScheduler_lookup_Results(msg *capnp.Message) (Scheduler_lookup_Results, error) {
+	root, err := msg.Root()
+	return Scheduler_lookup_Results{root.Struct()}, err
+}
+
+func (s Scheduler_lookup_Results) String() string {
+	str, _ := text.Marshal(0xb69a625f54e3eb0d, s.Struct)
+	return str
+}
+
+func (s Scheduler_lookup_Results) Job() Job {
+	p, _ := s.Struct.Ptr(0)
+	return Job{Client: p.Interface().Client()}
+}
+
+func (s Scheduler_lookup_Results) HasJob() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Scheduler_lookup_Results) SetJob(v Job) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+// Scheduler_lookup_Results_List is a list of Scheduler_lookup_Results.
+type Scheduler_lookup_Results_List struct{ capnp.List }
+
+// NewScheduler_lookup_Results creates a new list of Scheduler_lookup_Results.
+func NewScheduler_lookup_Results_List(s *capnp.Segment, sz int32) (Scheduler_lookup_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Scheduler_lookup_Results_List{l}, err
+}
+
+func (s Scheduler_lookup_Results_List) At(i int) Scheduler_lookup_Results {
+	return Scheduler_lookup_Results{s.List.Struct(i)}
+}
+
+func (s Scheduler_lookup_Results_List) Set(i int, v Scheduler_lookup_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Scheduler_lookup_Results_List) String() string {
+	str, _ := text.MarshalList(0xb69a625f54e3eb0d, s.List)
+	return str
+}
+
+// Scheduler_lookup_Results_Future is a wrapper for a Scheduler_lookup_Results promised by a client call.
+type Scheduler_lookup_Results_Future struct{ *capnp.Future }
+
+func (p Scheduler_lookup_Results_Future) Struct() (Scheduler_lookup_Results, error) {
+	s, err := p.Future.Struct()
+	return Scheduler_lookup_Results{s}, err
+}
+
+func (p Scheduler_lookup_Results_Future) Job() Job {
+	return Job{Client: p.Future.Field(0, nil).Client()}
+}
+
+type JobSpec struct{ capnp.Struct }
+
+// JobSpec_TypeID is the unique identifier for the type JobSpec.
+const JobSpec_TypeID = 0xc01b2257514995f1
+
+func NewJobSpec(s *capnp.Segment) (JobSpec, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	return JobSpec{st}, err
+}
+
+func NewRootJobSpec(s *capnp.Segment) (JobSpec, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	return JobSpec{st}, err
+}
+
+func ReadRootJobSpec(msg *capnp.Message) (JobSpec, error) {
+	root, err := msg.Root()
+	return JobSpec{root.Struct()}, err
+}
+
+func (s JobSpec) String() string {
+	str, _ := text.Marshal(0xc01b2257514995f1, s.Struct)
+	return str
+}
+
+func (s JobSpec) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s JobSpec) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s JobSpec) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s JobSpec) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s JobSpec) Command() (Command, error) {
+	p, err := s.Struct.Ptr(1)
+	return Command{Struct: p.Struct()}, err
+}
+
+func (s JobSpec) HasCommand() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s JobSpec) SetCommand(v Command) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewCommand sets the command field to a newly
+// allocated Command struct, preferring placement in s's segment.
+func (s JobSpec) NewCommand() (Command, error) {
+	ss, err := NewCommand(s.Struct.Segment())
+	if err != nil {
+		return Command{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s JobSpec) Module() (Module, error) {
+	p, err := s.Struct.Ptr(2)
+	return Module{Struct: p.Struct()}, err
+}
+
+func (s JobSpec) HasModule() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s JobSpec) SetModule(v Module) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewModule sets the module field to a newly
+// allocated Module struct, preferring placement in s's segment.
+func (s JobSpec) NewModule() (Module, error) {
+	ss, err := NewModule(s.Struct.Segment())
+	if err != nil {
+		return Module{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s JobSpec) Requests() (Limits, error) {
+	p, err := s.Struct.Ptr(3)
+	return Limits{Struct: p.Struct()}, err
+}
+
+func (s JobSpec) HasRequests() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s JobSpec) SetRequests(v Limits) error {
+	return s.Struct.SetPtr(3, v.Struct.ToPtr())
+}
+
+// NewRequests sets the requests field to a newly
+// allocated Limits struct, preferring placement in s's segment.
+func (s JobSpec) NewRequests() (Limits, error) {
+	ss, err := NewLimits(s.Struct.Segment())
+	if err != nil {
+		return Limits{}, err
+	}
+	err = s.Struct.SetPtr(3, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s JobSpec) Selector() (JobSpec_Label_List, error) {
+	p, err := s.Struct.Ptr(4)
+	return JobSpec_Label_List{List: p.List()}, err
+}
+
+func (s JobSpec) HasSelector() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s JobSpec) SetSelector(v JobSpec_Label_List) error {
+	return s.Struct.SetPtr(4, v.List.ToPtr())
+}
+
+// NewSelector sets the selector field to a newly
+// allocated JobSpec_Label_List, preferring placement in s's segment.
+func (s JobSpec) NewSelector(n int32) (JobSpec_Label_List, error) {
+	l, err := NewJobSpec_Label_List(s.Struct.Segment(), n)
+	if err != nil {
+		return JobSpec_Label_List{}, err
+	}
+	err = s.Struct.SetPtr(4, l.List.ToPtr())
+	return l, err
+}
+
+func (s JobSpec) Replicas() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s JobSpec) SetReplicas(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// JobSpec_List is a list of JobSpec.
+type JobSpec_List struct{ capnp.List }
+
+// NewJobSpec creates a new list of JobSpec.
+func NewJobSpec_List(s *capnp.Segment, sz int32) (JobSpec_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
+	return JobSpec_List{l}, err
+}
+
+func (s JobSpec_List) At(i int) JobSpec { return JobSpec{s.List.Struct(i)} }
+
+func (s JobSpec_List) Set(i int, v JobSpec) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s JobSpec_List) String() string {
+	str, _ := text.MarshalList(0xc01b2257514995f1, s.List)
+	return str
+}
+
+// JobSpec_Future is a wrapper for a JobSpec promised by a client call.
+type JobSpec_Future struct{ *capnp.Future }
+
+func (p JobSpec_Future) Struct() (JobSpec, error) {
+	s, err := p.Future.Struct()
+	return JobSpec{s}, err
+}
+
+func (p JobSpec_Future) Command() Command_Future {
+	return Command_Future{Future: p.Future.Field(1, nil)}
+}
+
+func (p JobSpec_Future) Module() Module_Future {
+	return Module_Future{Future: p.Future.Field(2, nil)}
+}
+
+func (p JobSpec_Future) Requests() Limits_Future {
+	return Limits_Future{Future: p.Future.Field(3, nil)}
+}
+
+type JobSpec_Label struct{ capnp.Struct }
+
+// JobSpec_Label_TypeID is the unique identifier for the type JobSpec_Label.
+const JobSpec_Label_TypeID = 0xf64fb912c4cd0556
+
+func NewJobSpec_Label(s *capnp.Segment) (JobSpec_Label, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return JobSpec_Label{st}, err
+}
+
+func NewRootJobSpec_Label(s *capnp.Segment) (JobSpec_Label, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return JobSpec_Label{st}, err
+}
+
+func ReadRootJobSpec_Label(msg *capnp.Message) (JobSpec_Label, error) {
+	root, err := msg.Root()
+	return JobSpec_Label{root.Struct()}, err
+}
+
+func (s JobSpec_Label) String() string {
+	str, _ := text.Marshal(0xf64fb912c4cd0556, s.Struct)
+	return str
+}
+
+func (s JobSpec_Label) Key() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s JobSpec_Label) HasKey() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s JobSpec_Label) KeyBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s JobSpec_Label) SetKey(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s JobSpec_Label) Value() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s JobSpec_Label) HasValue() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s JobSpec_Label) ValueBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s JobSpec_Label) SetValue(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// JobSpec_Label_List is a list of JobSpec_Label.
+type JobSpec_Label_List struct{ capnp.List }
+
+// NewJobSpec_Label creates a new list of JobSpec_Label.
+func NewJobSpec_Label_List(s *capnp.Segment, sz int32) (JobSpec_Label_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return JobSpec_Label_List{l}, err
+}
+
+func (s JobSpec_Label_List) At(i int) JobSpec_Label { return JobSpec_Label{s.List.Struct(i)} }
+
+func (s JobSpec_Label_List) Set(i int, v JobSpec_Label) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s JobSpec_Label_List) String() string {
+	str, _ := text.MarshalList(0xf64fb912c4cd0556, s.List)
+	return str
+}
+
+// JobSpec_Label_Future is a wrapper for a JobSpec_Label promised by a client call.
+type JobSpec_Label_Future struct{ *capnp.Future }
+
+func (p JobSpec_Label_Future) Struct() (JobSpec_Label, error) {
+	s, err := p.Future.Struct()
+	return JobSpec_Label{s}, err
+}
+
+type Job struct{ Client *capnp.Client }
+
+// Job_TypeID is the unique identifier for the type Job.
+const Job_TypeID = 0x902ea34428602d7f
+
+func (c Job) Status(ctx context.Context, params func(Job_status_Params) error) (Job_status_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x902ea34428602d7f,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Job",
+			MethodName:    "status",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Job_status_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Job_status_Results_Future{Future: ans.Future()}, release
+}
+func (c Job) Stop(ctx context.Context, params func(Job_stop_Params) error) (Job_stop_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x902ea34428602d7f,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Job",
+			MethodName:    "stop",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Job_stop_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Job_stop_Results_Future{Future: ans.Future()}, release
+}
+func (c Job) Name(ctx context.Context, params func(Job_name_Params) error) (Job_name_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x902ea34428602d7f,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Job",
+			MethodName:    "name",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Job_name_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Job_name_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Job) AddRef() Job {
+	return Job{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Job) Release() {
+	c.Client.Release()
+}
+
+// A Job_Server is a Job with a local implementation.
+type Job_Server interface {
+	Status(context.Context, Job_status) error
+
+	Stop(context.Context, Job_stop) error
+
+	Name(context.Context, Job_name) error
+}
+
+// Job_NewServer creates a new Server from an implementation of Job_Server.
+func Job_NewServer(s Job_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Job_Methods(nil, s), s, c, policy)
+}
+
+// Job_ServerToClient creates a new Client from an implementation of Job_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Job_ServerToClient(s Job_Server, policy *server.Policy) Job {
+	return Job{Client: capnp.NewClient(Job_NewServer(s, policy))}
+}
+
+// Job_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Job_Methods(methods []server.Method, s Job_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 3)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x902ea34428602d7f,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Job",
+			MethodName:    "status",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Status(ctx, Job_status{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x902ea34428602d7f,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Job",
+			MethodName:    "stop",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Stop(ctx, Job_stop{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x902ea34428602d7f,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Job",
+			MethodName:    "name",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Name(ctx, Job_name{call})
+		},
+	})
+
+	return methods
+}
+
+// Job_status holds the state for a server call to Job.status.
+// See server.Call for documentation.
+type Job_status struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Job_status) Args() Job_status_Params {
+	return Job_status_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Job_status) AllocResults() (Job_status_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Job_status_Results{Struct: r}, err
+}
+
+// Job_stop holds the state for a server call to Job.stop.
+// See server.Call for documentation.
+type Job_stop struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Job_stop) Args() Job_stop_Params {
+	return Job_stop_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Job_stop) AllocResults() (Job_stop_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_stop_Results{Struct: r}, err
+}
+
+// Job_name holds the state for a server call to Job.name.
+// See server.Call for documentation.
+type Job_name struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Job_name) Args() Job_name_Params {
+	return Job_name_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Job_name) AllocResults() (Job_name_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Job_name_Results{Struct: r}, err
+}
+
+type Job_Replica struct{ capnp.Struct }
+
+// Job_Replica_TypeID is the unique identifier for the type Job_Replica.
+const Job_Replica_TypeID = 0x88505aafee3521bb
+
+func NewJob_Replica(s *capnp.Segment) (Job_Replica, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Job_Replica{st}, err
+}
+
+func NewRootJob_Replica(s *capnp.Segment) (Job_Replica, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Job_Replica{st}, err
+}
+
+func ReadRootJob_Replica(msg *capnp.Message) (Job_Replica, error) {
+	root, err := msg.Root()
+	return Job_Replica{root.Struct()}, err
+}
+
+func (s Job_Replica) String() string {
+	str, _ := text.Marshal(0x88505aafee3521bb, s.Struct)
+	return str
+}
+
+func (s Job_Replica) Host() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Job_Replica) HasHost() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Job_Replica) HostBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Job_Replica) SetHost(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Job_Replica) State() Job_Replica_State {
+	return Job_Replica_State(s.Struct.Uint16(0))
+}
+
+func (s Job_Replica) SetState(v Job_Replica_State) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Job_Replica) ExitCode() int32 {
+	return int32(s.Struct.Uint32(4))
+}
+
+func (s Job_Replica) SetExitCode(v int32) {
+	s.Struct.SetUint32(4, uint32(v))
+}
+
+func (s Job_Replica) Placements() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Job_Replica) SetPlacements(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+// Job_Replica_List is a list of Job_Replica.
+type Job_Replica_List struct{ capnp.List }
+
+// NewJob_Replica creates a new list of Job_Replica.
+func NewJob_Replica_List(s *capnp.Segment, sz int32) (Job_Replica_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return Job_Replica_List{l}, err
+}
+
+func (s Job_Replica_List) At(i int) Job_Replica { return Job_Replica{s.List.Struct(i)} }
+
+func (s Job_Replica_List) Set(i int, v Job_Replica) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Job_Replica_List) String() string {
+	str, _ := text.MarshalList(0x88505aafee3521bb, s.List)
+	return str
+}
+
+// Job_Replica_Future is a wrapper for a Job_Replica promised by a client call.
+type Job_Replica_Future struct{ *capnp.Future }
+
+func (p Job_Replica_Future) Struct() (Job_Replica, error) {
+	s, err := p.Future.Struct()
+	return Job_Replica{s}, err
+}
+
+type Job_Replica_State uint16
+
+// Job_Replica_State_TypeID is the unique identifier for the type Job_Replica_State.
+const Job_Replica_State_TypeID = 0xed4d5e112eab8152
+
+// Values of Job_Replica_State.
+const (
+	Job_Replica_State_pending Job_Replica_State = 0
+	Job_Replica_State_running Job_Replica_State = 1
+	Job_Replica_State_exited  Job_Replica_State = 2
+)
+
+// String returns the enum's constant name.
+func (c Job_Replica_State) String() string {
+	switch c {
+	case Job_Replica_State_pending:
+		return "pending"
+	case Job_Replica_State_running:
+		return "running"
+	case Job_Replica_State_exited:
+		return "exited"
+
+	default:
+		return ""
+	}
+}
+
+// Job_Replica_StateFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Job_Replica_StateFromString(c string) Job_Replica_State {
+	switch c {
+	case "pending":
+		return Job_Replica_State_pending
+	case "running":
+		return Job_Replica_State_running
+	case "exited":
+		return Job_Replica_State_exited
+
+	default:
+		return 0
+	}
+}
+
+type Job_Replica_State_List struct{ capnp.List }
+
+func NewJob_Replica_State_List(s *capnp.Segment, sz int32) (Job_Replica_State_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return Job_Replica_State_List{l.List}, err
+}
+
+func (l Job_Replica_State_List) At(i int) Job_Replica_State {
+	ul := capnp.UInt16List{List: l.List}
+	return Job_Replica_State(ul.At(i))
+}
+
+func (l Job_Replica_State_List) Set(i int, v Job_Replica_State) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Job_status_Params struct{ capnp.Struct }
+
+// Job_status_Params_TypeID is the unique identifier for the type Job_status_Params.
+const Job_status_Params_TypeID = 0xc68b36e6b53c914f
+
+func NewJob_status_Params(s *capnp.Segment) (Job_status_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_status_Params{st}, err
+}
+
+func NewRootJob_status_Params(s *capnp.Segment) (Job_status_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_status_Params{st}, err
+}
+
+func ReadRootJob_status_Params(msg *capnp.Message) (Job_status_Params, error) {
+	root, err := msg.Root()
+	return Job_status_Params{root.Struct()}, err
+}
+
+func (s Job_status_Params) String() string {
+	str, _ := text.Marshal(0xc68b36e6b53c914f, s.Struct)
+	return str
+}
+
+// Job_status_Params_List is a list of Job_status_Params.
+type Job_status_Params_List struct{ capnp.List }
+
+// NewJob_status_Params creates a new list of Job_status_Params.
+func NewJob_status_Params_List(s *capnp.Segment, sz int32) (Job_status_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Job_status_Params_List{l}, err
+}
+
+func (s Job_status_Params_List) At(i int) Job_status_Params {
+	return Job_status_Params{s.List.Struct(i)}
+}
+
+func (s Job_status_Params_List) Set(i int, v Job_status_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Job_status_Params_List) String() string {
+	str, _ := text.MarshalList(0xc68b36e6b53c914f, s.List)
+	return str
+}
+
+// Job_status_Params_Future is a wrapper for a Job_status_Params promised by a client call.
+type Job_status_Params_Future struct{ *capnp.Future }
+
+func (p Job_status_Params_Future) Struct() (Job_status_Params, error) {
+	s, err := p.Future.Struct()
+	return Job_status_Params{s}, err
+}
+
+type Job_status_Results struct{ capnp.Struct }
+
+// Job_status_Results_TypeID is the unique identifier for the type Job_status_Results.
+const Job_status_Results_TypeID = 0xff370d1ac03c87c0
+
+func NewJob_status_Results(s *capnp.Segment) (Job_status_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Job_status_Results{st}, err
+}
+
+func NewRootJob_status_Results(s *capnp.Segment) (Job_status_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Job_status_Results{st}, err
+}
+
+func ReadRootJob_status_Results(msg *capnp.Message) (Job_status_Results, error) {
+	root, err := msg.Root()
+	return Job_status_Results{root.Struct()}, err
+}
+
+func (s Job_status_Results) String() string {
+	str, _ := text.Marshal(0xff370d1ac03c87c0, s.Struct)
+	return str
+}
+
+func (s Job_status_Results) Replicas() (Job_Replica_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Job_Replica_List{List: p.List()}, err
+}
+
+func (s Job_status_Results) HasReplicas() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Job_status_Results) SetReplicas(v Job_Replica_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewReplicas sets the replicas field to a newly
+// allocated Job_Replica_List, preferring placement in s's segment.
+func (s Job_status_Results) NewReplicas(n int32) (Job_Replica_List, error) {
+	l, err := NewJob_Replica_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Job_Replica_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Job_status_Results_List is a list of Job_status_Results.
+type Job_status_Results_List struct{ capnp.List }
+
+// NewJob_status_Results creates a new list of Job_status_Results.
+func NewJob_status_Results_List(s *capnp.Segment, sz int32) (Job_status_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Job_status_Results_List{l}, err
+}
+
+func (s Job_status_Results_List) At(i int) Job_status_Results {
+	return Job_status_Results{s.List.Struct(i)}
+}
+
+func (s Job_status_Results_List) Set(i int, v Job_status_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Job_status_Results_List) String() string {
+	str, _ := text.MarshalList(0xff370d1ac03c87c0, s.List)
+	return str
+}
+
+// Job_status_Results_Future is a wrapper for a Job_status_Results promised by a client call.
+type Job_status_Results_Future struct{ *capnp.Future }
+
+func (p Job_status_Results_Future) Struct() (Job_status_Results, error) {
+	s, err := p.Future.Struct()
+	return Job_status_Results{s}, err
+}
+
+type Job_stop_Params struct{ capnp.Struct }
+
+// Job_stop_Params_TypeID is the unique identifier for the type Job_stop_Params.
+const Job_stop_Params_TypeID = 0xbea28b6fbac1d949
+
+func NewJob_stop_Params(s *capnp.Segment) (Job_stop_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_stop_Params{st}, err
+}
+
+func NewRootJob_stop_Params(s *capnp.Segment) (Job_stop_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_stop_Params{st}, err
+}
+
+func ReadRootJob_stop_Params(msg *capnp.Message) (Job_stop_Params, error) {
+	root, err := msg.Root()
+	return Job_stop_Params{root.Struct()}, err
+}
+
+func (s Job_stop_Params) String() string {
+	str, _ := text.Marshal(0xbea28b6fbac1d949, s.Struct)
+	return str
+}
+
+// Job_stop_Params_List is a list of Job_stop_Params.
+type Job_stop_Params_List struct{ capnp.List }
+
+// NewJob_stop_Params creates a new list of Job_stop_Params.
+func NewJob_stop_Params_List(s *capnp.Segment, sz int32) (Job_stop_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Job_stop_Params_List{l}, err
+}
+
+func (s Job_stop_Params_List) At(i int) Job_stop_Params { return Job_stop_Params{s.List.Struct(i)} }
+
+func (s Job_stop_Params_List) Set(i int, v Job_stop_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Job_stop_Params_List) String() string {
+	str, _ := text.MarshalList(0xbea28b6fbac1d949, s.List)
+	return str
+}
+
+// Job_stop_Params_Future is a wrapper for a Job_stop_Params promised by a client call.
+type Job_stop_Params_Future struct{ *capnp.Future }
+
+func (p Job_stop_Params_Future) Struct() (Job_stop_Params, error) {
+	s, err := p.Future.Struct()
+	return Job_stop_Params{s}, err
+}
+
+type Job_stop_Results struct{ capnp.Struct }
+
+// Job_stop_Results_TypeID is the unique identifier for the type Job_stop_Results.
+const Job_stop_Results_TypeID = 0xbacb6dedd972832e
+
+func NewJob_stop_Results(s *capnp.Segment) (Job_stop_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_stop_Results{st}, err
+}
+
+func NewRootJob_stop_Results(s *capnp.Segment) (Job_stop_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_stop_Results{st}, err
+}
+
+func ReadRootJob_stop_Results(msg *capnp.Message) (Job_stop_Results, error) {
+	root, err := msg.Root()
+	return Job_stop_Results{root.Struct()}, err
+}
+
+func (s Job_stop_Results) String() string {
+	str, _ := text.Marshal(0xbacb6dedd972832e, s.Struct)
+	return str
+}
+
+// Job_stop_Results_List is a list of Job_stop_Results.
+type Job_stop_Results_List struct{ capnp.List }
+
+// NewJob_stop_Results creates a new list of Job_stop_Results.
+func NewJob_stop_Results_List(s *capnp.Segment, sz int32) (Job_stop_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Job_stop_Results_List{l}, err
+}
+
+func (s Job_stop_Results_List) At(i int) Job_stop_Results { return Job_stop_Results{s.List.Struct(i)} }
+
+func (s Job_stop_Results_List) Set(i int, v Job_stop_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Job_stop_Results_List) String() string {
+	str, _ := text.MarshalList(0xbacb6dedd972832e, s.List)
+	return str
+}
+
+// Job_stop_Results_Future is a wrapper for a Job_stop_Results promised by a client call.
+type Job_stop_Results_Future struct{ *capnp.Future }
+
+func (p Job_stop_Results_Future) Struct() (Job_stop_Results, error) {
+	s, err := p.Future.Struct()
+	return Job_stop_Results{s}, err
+}
+
+type Job_name_Params struct{ capnp.Struct }
+
+// Job_name_Params_TypeID is the unique identifier for the type Job_name_Params.
+const Job_name_Params_TypeID = 0xa415390085aee071
+
+func NewJob_name_Params(s *capnp.Segment) (Job_name_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_name_Params{st}, err
+}
+
+func NewRootJob_name_Params(s *capnp.Segment) (Job_name_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Job_name_Params{st}, err
+}
+
+func ReadRootJob_name_Params(msg *capnp.Message) (Job_name_Params, error) {
+	root, err := msg.Root()
+	return Job_name_Params{root.Struct()}, err
+}
+
+func (s Job_name_Params) String() string {
+	str, _ := text.Marshal(0xa415390085aee071, s.Struct)
+	return str
+}
+
+// Job_name_Params_List is a list of Job_name_Params.
+type Job_name_Params_List struct{ capnp.List }
+
+// NewJob_name_Params creates a new list of Job_name_Params.
+func NewJob_name_Params_List(s *capnp.Segment, sz int32) (Job_name_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Job_name_Params_List{l}, err
+}
+
+func (s Job_name_Params_List) At(i int) Job_name_Params { return Job_name_Params{s.List.Struct(i)} }
+
+func (s Job_name_Params_List) Set(i int, v Job_name_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Job_name_Params_List) String() string {
+	str, _ := text.MarshalList(0xa415390085aee071, s.List)
+	return str
+}
+
+// Job_name_Params_Future is a wrapper for a Job_name_Params promised by a client call.
+type Job_name_Params_Future struct{ *capnp.Future }
+
+func (p Job_name_Params_Future) Struct() (Job_name_Params, error) {
+	s, err := p.Future.Struct()
+	return Job_name_Params{s}, err
+}
+
+type Job_name_Results struct{ capnp.Struct }
+
+// Job_name_Results_TypeID is the unique identifier for the type Job_name_Results.
+const Job_name_Results_TypeID = 0xbfbb9b996270553b
+
+func NewJob_name_Results(s *capnp.Segment) (Job_name_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Job_name_Results{st}, err
+}
+
+func NewRootJob_name_Results(s *capnp.Segment) (Job_name_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Job_name_Results{st}, err
+}
+
+func ReadRootJob_name_Results(msg *capnp.Message) (Job_name_Results, error) {
+	root, err := msg.Root()
+	return Job_name_Results{root.Struct()}, err
+}
+
+func (s Job_name_Results) String() string {
+	str, _ := text.Marshal(0xbfbb9b996270553b, s.Struct)
+	return str
+}
+
+func (s Job_name_Results) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Job_name_Results) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Job_name_Results) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Job_name_Results) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Job_name_Results_List is a list of Job_name_Results.
+type Job_name_Results_List struct{ capnp.List }
+
+// NewJob_name_Results creates a new list of Job_name_Results.
+func NewJob_name_Results_List(s *capnp.Segment, sz int32) (Job_name_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Job_name_Results_List{l}, err
+}
+
+func (s Job_name_Results_List) At(i int) Job_name_Results { return Job_name_Results{s.List.Struct(i)} }
+
+func (s Job_name_Results_List) Set(i int, v Job_name_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Job_name_Results_List) String() string {
+	str, _ := text.MarshalList(0xbfbb9b996270553b, s.List)
+	return str
+}
+
+// Job_name_Results_Future is a wrapper for a Job_name_Results promised by a client call.
+type Job_name_Results_Future struct{ *capnp.Future }
+
+func (p Job_name_Results_Future) Struct() (Job_name_Results, error) {
+	s, err := p.Future.Struct()
+	return Job_name_Results{s}, err
+}
+
+type Cron struct{ Client *capnp.Client }
+
+// Cron_TypeID is the unique identifier for the type Cron.
+const Cron_TypeID = 0xd2beb83f79937dfa
+
+func (c Cron) Add(ctx context.Context, params func(Cron_add_Params) error) (Cron_add_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xd2beb83f79937dfa,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Cron",
+			MethodName:    "add",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Cron_add_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Cron_add_Results_Future{Future: ans.Future()}, release
+}
+func (c Cron) Rm(ctx context.Context, params func(Cron_rm_Params) error) (Cron_rm_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xd2beb83f79937dfa,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Cron",
+			MethodName:    "rm",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Cron_rm_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Cron_rm_Results_Future{Future: ans.Future()}, release
+}
+func (c Cron) Ls(ctx context.Context, params func(Cron_ls_Params) error) (Cron_ls_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xd2beb83f79937dfa,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Cron",
+			MethodName:    "ls",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Cron_ls_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Cron_ls_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Cron) AddRef() Cron {
+	return Cron{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Cron) Release() {
+	c.Client.Release()
+}
+
+// A Cron_Server is a Cron with a local implementation.
+type Cron_Server interface {
+	Add(context.Context, Cron_add) error
+
+	Rm(context.Context, Cron_rm) error
+
+	Ls(context.Context, Cron_ls) error
+}
+
+// Cron_NewServer creates a new Server from an implementation of Cron_Server.
+func Cron_NewServer(s Cron_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Cron_Methods(nil, s), s, c, policy)
+}
+
+// Cron_ServerToClient creates a new Client from an implementation of Cron_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Cron_ServerToClient(s Cron_Server, policy *server.Policy) Cron {
+	return Cron{Client: capnp.NewClient(Cron_NewServer(s, policy))}
+}
+
+// Cron_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Cron_Methods(methods []server.Method, s Cron_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 3)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xd2beb83f79937dfa,
+			MethodID:      0,
+			InterfaceName: "process.capnp:Cron",
+			MethodName:    "add",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Add(ctx, Cron_add{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xd2beb83f79937dfa,
+			MethodID:      1,
+			InterfaceName: "process.capnp:Cron",
+			MethodName:    "rm",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Rm(ctx, Cron_rm{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xd2beb83f79937dfa,
+			MethodID:      2,
+			InterfaceName: "process.capnp:Cron",
+			MethodName:    "ls",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Ls(ctx, Cron_ls{call})
+		},
+	})
+
+	return methods
+}
+
+// Cron_add holds the state for a server call to Cron.add.
+// See server.Call for documentation.
+type Cron_add struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Cron_add) Args() Cron_add_Params {
+	return Cron_add_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Cron_add) AllocResults() (Cron_add_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_add_Results{Struct: r}, err
+}
+
+// Cron_rm holds the state for a server call to Cron.rm.
+// See server.Call for documentation.
+type Cron_rm struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Cron_rm) Args() Cron_rm_Params {
+	return Cron_rm_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Cron_rm) AllocResults() (Cron_rm_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_rm_Results{Struct: r}, err
+}
+
+// Cron_ls holds the state for a server call to Cron.ls.
+// See server.Call for documentation.
+type Cron_ls struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Cron_ls) Args() Cron_ls_Params {
+	return Cron_ls_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Cron_ls) AllocResults() (Cron_ls_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Cron_ls_Results{Struct: r}, err
+}
+
+type Cron_add_Params struct{ capnp.Struct }
+
+// Cron_add_Params_TypeID is the unique identifier for the type Cron_add_Params.
+const Cron_add_Params_TypeID = 0xb0d5a18fede322b8
+
+func NewCron_add_Params(s *capnp.Segment) (Cron_add_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Cron_add_Params{st}, err
+}
+
+func NewRootCron_add_Params(s *capnp.Segment) (Cron_add_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Cron_add_Params{st}, err
+}
+
+func ReadRootCron_add_Params(msg *capnp.Message) (Cron_add_Params, error) {
+	root, err := msg.Root()
+	return Cron_add_Params{root.Struct()}, err
+}
+
+func (s Cron_add_Params) String() string {
+	str, _ := text.Marshal(0xb0d5a18fede322b8, s.Struct)
+	return str
+}
+
+func (s Cron_add_Params) Entry() (CronEntry, error) {
+	p, err := s.Struct.Ptr(0)
+	return CronEntry{Struct: p.Struct()}, err
+}
+
+func (s Cron_add_Params) HasEntry() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Cron_add_Params) SetEntry(v CronEntry) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewEntry sets the entry field to a newly
+// allocated CronEntry struct, preferring placement in s's segment.
+func (s Cron_add_Params) NewEntry() (CronEntry, error) {
+	ss, err := NewCronEntry(s.Struct.Segment())
+	if err != nil {
+		return CronEntry{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Cron_add_Params_List is a list of Cron_add_Params.
+type Cron_add_Params_List struct{ capnp.List }
+
+// NewCron_add_Params creates a new list of Cron_add_Params.
+func NewCron_add_Params_List(s *capnp.Segment, sz int32) (Cron_add_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Cron_add_Params_List{l}, err
+}
+
+func (s Cron_add_Params_List) At(i int) Cron_add_Params { return Cron_add_Params{s.List.Struct(i)} }
+
+func (s Cron_add_Params_List) Set(i int, v Cron_add_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Cron_add_Params_List) String() string {
+	str, _ := text.MarshalList(0xb0d5a18fede322b8, s.List)
+	return str
+}
+
+// Cron_add_Params_Future is a wrapper for a Cron_add_Params promised by a client call.
+type Cron_add_Params_Future struct{ *capnp.Future }
+
+func (p Cron_add_Params_Future) Struct() (Cron_add_Params, error) {
+	s, err := p.Future.Struct()
+	return Cron_add_Params{s}, err
+}
+
+func (p Cron_add_Params_Future) Entry() CronEntry_Future {
+	return CronEntry_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Cron_add_Results struct{ capnp.Struct }
+
+// Cron_add_Results_TypeID is the unique identifier for the type Cron_add_Results.
+const Cron_add_Results_TypeID = 0xb104bb4640097f63
+
+func NewCron_add_Results(s *capnp.Segment) (Cron_add_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_add_Results{st}, err
+}
+
+func NewRootCron_add_Results(s *capnp.Segment) (Cron_add_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_add_Results{st}, err
+}
+
+func ReadRootCron_add_Results(msg *capnp.Message) (Cron_add_Results, error) {
+	root, err := msg.Root()
+	return Cron_add_Results{root.Struct()}, err
+}
+
+func (s Cron_add_Results) String() string {
+	str, _ := text.Marshal(0xb104bb4640097f63, s.Struct)
+	return str
+}
+
+// Cron_add_Results_List is a list of Cron_add_Results.
+type Cron_add_Results_List struct{ capnp.List }
+
+// NewCron_add_Results creates a new list of Cron_add_Results.
+func NewCron_add_Results_List(s *capnp.Segment, sz int32) (Cron_add_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Cron_add_Results_List{l}, err
+}
+
+func (s Cron_add_Results_List) At(i int) Cron_add_Results { return Cron_add_Results{s.List.Struct(i)} }
+
+func (s Cron_add_Results_List) Set(i int, v Cron_add_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Cron_add_Results_List) String() string {
+	str, _ := text.MarshalList(0xb104bb4640097f63, s.List)
+	return str
+}
+
+// Cron_add_Results_Future is a wrapper for a Cron_add_Results promised by a client call.
+type Cron_add_Results_Future struct{ *capnp.Future }
+
+func (p Cron_add_Results_Future) Struct() (Cron_add_Results, error) {
+	s, err := p.Future.Struct()
+	return Cron_add_Results{s}, err
+}
+
+type Cron_rm_Params struct{ capnp.Struct }
+
+// Cron_rm_Params_TypeID is the unique identifier for the type Cron_rm_Params.
+const Cron_rm_Params_TypeID = 0xd598528b38f83dc3
+
+func NewCron_rm_Params(s *capnp.Segment) (Cron_rm_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Cron_rm_Params{st}, err
+}
+
+func NewRootCron_rm_Params(s *capnp.Segment) (Cron_rm_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Cron_rm_Params{st}, err
+}
+
+func ReadRootCron_rm_Params(msg *capnp.Message) (Cron_rm_Params, error) {
+	root, err := msg.Root()
+	return Cron_rm_Params{root.Struct()}, err
+}
+
+func (s Cron_rm_Params) String() string {
+	str, _ := text.Marshal(0xd598528b38f83dc3, s.Struct)
+	return str
+}
+
+func (s Cron_rm_Params) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Cron_rm_Params) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Cron_rm_Params) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Cron_rm_Params) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Cron_rm_Params_List is a list of Cron_rm_Params.
+type Cron_rm_Params_List struct{ capnp.List }
+
+// NewCron_rm_Params creates a new list of Cron_rm_Params.
+func NewCron_rm_Params_List(s *capnp.Segment, sz int32) (Cron_rm_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Cron_rm_Params_List{l}, err
+}
+
+func (s Cron_rm_Params_List) At(i int) Cron_rm_Params { return Cron_rm_Params{s.List.Struct(i)} }
+
+func (s Cron_rm_Params_List) Set(i int, v Cron_rm_Params) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Cron_rm_Params_List) String() string {
+	str, _ := text.MarshalList(0xd598528b38f83dc3, s.List)
+	return str
+}
+
+// Cron_rm_Params_Future is a wrapper for a Cron_rm_Params promised by a client call.
+type Cron_rm_Params_Future struct{ *capnp.Future }
+
+func (p Cron_rm_Params_Future) Struct() (Cron_rm_Params, error) {
+	s, err := p.Future.Struct()
+	return Cron_rm_Params{s}, err
+}
+
+type Cron_rm_Results struct{ capnp.Struct }
+
+// Cron_rm_Results_TypeID is the unique identifier for the type Cron_rm_Results.
+const Cron_rm_Results_TypeID = 0xfede1f6adde01de7
+
+func NewCron_rm_Results(s *capnp.Segment) (Cron_rm_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_rm_Results{st}, err
+}
+
+func NewRootCron_rm_Results(s *capnp.Segment) (Cron_rm_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_rm_Results{st}, err
+}
+
+func ReadRootCron_rm_Results(msg *capnp.Message) (Cron_rm_Results, error) {
+	root, err := msg.Root()
+	return Cron_rm_Results{root.Struct()}, err
+}
+
+func (s Cron_rm_Results) String() string {
+	str, _ := text.Marshal(0xfede1f6adde01de7, s.Struct)
+	return str
+}
+
+// Cron_rm_Results_List is a list of Cron_rm_Results.
+type Cron_rm_Results_List struct{ capnp.List }
+
+// NewCron_rm_Results creates a new list of Cron_rm_Results.
+func NewCron_rm_Results_List(s *capnp.Segment, sz int32) (Cron_rm_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Cron_rm_Results_List{l}, err
+}
+
+func (s Cron_rm_Results_List) At(i int) Cron_rm_Results { return Cron_rm_Results{s.List.Struct(i)} }
+
+func (s Cron_rm_Results_List) Set(i int, v Cron_rm_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Cron_rm_Results_List) String() string {
+	str, _ := text.MarshalList(0xfede1f6adde01de7, s.List)
+	return str
+}
+
+// Cron_rm_Results_Future is a wrapper for a Cron_rm_Results promised by a client call.
+type Cron_rm_Results_Future struct{ *capnp.Future }
+
+func (p Cron_rm_Results_Future) Struct() (Cron_rm_Results, error) {
+	s, err := p.Future.Struct()
+	return Cron_rm_Results{s}, err
+}
+
+type Cron_ls_Params struct{ capnp.Struct }
+
+// Cron_ls_Params_TypeID is the unique identifier for the type Cron_ls_Params.
+const Cron_ls_Params_TypeID = 0x80295b4da1ab3db9
+
+func NewCron_ls_Params(s *capnp.Segment) (Cron_ls_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_ls_Params{st}, err
+}
+
+func NewRootCron_ls_Params(s *capnp.Segment) (Cron_ls_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Cron_ls_Params{st}, err
+}
+
+func ReadRootCron_ls_Params(msg *capnp.Message) (Cron_ls_Params, error) {
+	root, err := msg.Root()
+	return Cron_ls_Params{root.Struct()}, err
+}
+
+func (s Cron_ls_Params) String() string {
+	str, _ := text.Marshal(0x80295b4da1ab3db9, s.Struct)
+	return str
+}
+
+// Cron_ls_Params_List is a list of Cron_ls_Params.
+type Cron_ls_Params_List struct{ capnp.List }
+
+// NewCron_ls_Params creates a new list of Cron_ls_Params.
+func NewCron_ls_Params_List(s *capnp.Segment, sz int32) (Cron_ls_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Cron_ls_Params_List{l}, err
+}
+
+func (s Cron_ls_Params_List) At(i int) Cron_ls_Params { return Cron_ls_Params{s.List.Struct(i)} }
+
+func (s Cron_ls_Params_List) Set(i int, v Cron_ls_Params) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Cron_ls_Params_List) String() string {
+	str, _ := text.MarshalList(0x80295b4da1ab3db9, s.List)
+	return str
+}
+
+// Cron_ls_Params_Future is a wrapper for a Cron_ls_Params promised by a client call.
+type Cron_ls_Params_Future struct{ *capnp.Future }
+
+func (p Cron_ls_Params_Future) Struct() (Cron_ls_Params, error) {
+	s, err := p.Future.Struct()
+	return Cron_ls_Params{s}, err
+}
+
+type Cron_ls_Results struct{ capnp.Struct }
+
+// Cron_ls_Results_TypeID is the unique identifier for the type Cron_ls_Results.
+const Cron_ls_Results_TypeID = 0xe7ca54d991dd34ce
+
+func NewCron_ls_Results(s *capnp.Segment) (Cron_ls_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Cron_ls_Results{st}, err
+}
+
+func NewRootCron_ls_Results(s *capnp.Segment) (Cron_ls_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Cron_ls_Results{st}, err
+}
+
+func ReadRootCron_ls_Results(msg *capnp.Message) (Cron_ls_Results, error) {
+	root, err := msg.Root()
+	return Cron_ls_Results{root.Struct()}, err
+}
+
+func (s Cron_ls_Results) String() string {
+	str, _ := text.Marshal(0xe7ca54d991dd34ce, s.Struct)
+	return str
+}
+
+func (s Cron_ls_Results) Entries() (CronEntry_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return CronEntry_List{List: p.List()}, err
+}
+
+func (s Cron_ls_Results) HasEntries() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Cron_ls_Results) SetEntries(v CronEntry_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewEntries sets the entries field to a newly
+// allocated CronEntry_List, preferring placement in s's segment.
+func (s Cron_ls_Results) NewEntries(n int32) (CronEntry_List, error) {
+	l, err := NewCronEntry_List(s.Struct.Segment(), n)
+	if err != nil {
+		return CronEntry_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Cron_ls_Results_List is a list of Cron_ls_Results.
+type Cron_ls_Results_List struct{ capnp.List }
+
+// NewCron_ls_Results creates a new list of Cron_ls_Results.
+func NewCron_ls_Results_List(s *capnp.Segment, sz int32) (Cron_ls_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Cron_ls_Results_List{l}, err
+}
+
+func (s Cron_ls_Results_List) At(i int) Cron_ls_Results { return Cron_ls_Results{s.List.Struct(i)} }
+
+func (s Cron_ls_Results_List) Set(i int, v Cron_ls_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Cron_ls_Results_List) String() string {
+	str, _ := text.MarshalList(0xe7ca54d991dd34ce, s.List)
+	return str
+}
+
+// Cron_ls_Results_Future is a wrapper for a Cron_ls_Results promised by a client call.
+type Cron_ls_Results_Future struct{ *capnp.Future }
+
+func (p Cron_ls_Results_Future) Struct() (Cron_ls_Results, error) {
+	s, err := p.Future.Struct()
+	return Cron_ls_Results{s}, err
+}
+
+type CronEntry struct{ capnp.Struct }
+
+// CronEntry_TypeID is the unique identifier for the type CronEntry.
+const CronEntry_TypeID = 0xb2d1c7f25f323399
+
+func NewCronEntry(s *capnp.Segment) (CronEntry, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return CronEntry{st}, err
+}
+
+func NewRootCronEntry(s *capnp.Segment) (CronEntry, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return CronEntry{st}, err
+}
+
+func ReadRootCronEntry(msg *capnp.Message) (CronEntry, error) {
+	root, err := msg.Root()
+	return CronEntry{root.Struct()}, err
+}
+
+func (s CronEntry) String() string {
+	str, _ := text.Marshal(0xb2d1c7f25f323399, s.Struct)
+	return str
+}
+
+func (s CronEntry) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s CronEntry) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s CronEntry) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s CronEntry) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s CronEntry) Schedule() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s CronEntry) HasSchedule() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s CronEntry) ScheduleBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s CronEntry) SetSchedule(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s CronEntry) Job() (JobSpec, error) {
+	p, err := s.Struct.Ptr(2)
+	return JobSpec{Struct: p.Struct()}, err
+}
+
+func (s CronEntry) HasJob() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s CronEntry) SetJob(v JobSpec) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewJob sets the job field to a newly
+// allocated JobSpec struct, preferring placement in s's segment.
+func (s CronEntry) NewJob() (JobSpec, error) {
+	ss, err := NewJobSpec(s.Struct.Segment())
+	if err != nil {
+		return JobSpec{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s CronEntry) Missed() CronEntry_MissedRuns {
+	return CronEntry_MissedRuns(s.Struct.Uint16(0))
+}
+
+func (s CronEntry) SetMissed(v CronEntry_MissedRuns) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s CronEntry) LastRun() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s CronEntry) SetLastRun(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+// CronEntry_List is a list of CronEntry.
+type CronEntry_List struct{ capnp.List }
+
+// NewCronEntry creates a new list of CronEntry.
+func NewCronEntry_List(s *capnp.Segment, sz int32) (CronEntry_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
+	return CronEntry_List{l}, err
+}
+
+func (s CronEntry_List) At(i int) CronEntry { return CronEntry{s.List.Struct(i)} }
+
+func (s CronEntry_List) Set(i int, v CronEntry) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s CronEntry_List) String() string {
+	str, _ := text.MarshalList(0xb2d1c7f25f323399, s.List)
+	return str
+}
+
+// CronEntry_Future is a wrapper for a CronEntry promised by a client call.
+type CronEntry_Future struct{ *capnp.Future }
+
+func (p CronEntry_Future) Struct() (CronEntry, error) {
+	s, err := p.Future.Struct()
+	return CronEntry{s}, err
+}
+
+func (p CronEntry_Future) Job() JobSpec_Future {
+	return JobSpec_Future{Future: p.Future.Field(2, nil)}
+}
+
+type CronEntry_MissedRuns uint16
+
+// CronEntry_MissedRuns_TypeID is the unique identifier for the type CronEntry_MissedRuns.
+const CronEntry_MissedRuns_TypeID = 0xad83abda0de492f2
+
+// Values of CronEntry_MissedRuns.
+const (
+	CronEntry_MissedRuns_skip    CronEntry_MissedRuns = 0
+	CronEntry_MissedRuns_runOnce CronEntry_MissedRuns = 1
+	CronEntry_MissedRuns_runAll  CronEntry_MissedRuns = 2
+)
+
+// String returns the enum's constant name.
+func (c CronEntry_MissedRuns) String() string {
+	switch c {
+	case CronEntry_MissedRuns_skip:
+		return "skip"
+	case CronEntry_MissedRuns_runOnce:
+		return "runOnce"
+	case CronEntry_MissedRuns_runAll:
+		return "runAll"
+
+	default:
+		return ""
+	}
+}
+
+// CronEntry_MissedRunsFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func CronEntry_MissedRunsFromString(c string) CronEntry_MissedRuns {
+	switch c {
+	case "skip":
+		return CronEntry_MissedRuns_skip
+	case "runOnce":
+		return CronEntry_MissedRuns_runOnce
+	case "runAll":
+		return CronEntry_MissedRuns_runAll
+
+	default:
+		return 0
+	}
+}
+
+type CronEntry_MissedRuns_List struct{ capnp.List }
+
+func NewCronEntry_MissedRuns_List(s *capnp.Segment, sz int32) (CronEntry_MissedRuns_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return CronEntry_MissedRuns_List{l.List}, err
+}
+
+func (l CronEntry_MissedRuns_List) At(i int) CronEntry_MissedRuns {
+	ul := capnp.UInt16List{List: l.List}
+	return CronEntry_MissedRuns(ul.At(i))
+}
+
+func (l CronEntry_MissedRuns_List) Set(i int, v CronEntry_MissedRuns) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+const schema_9fc1afa23c48b6aa = "x\xda\xa4Z}t\x14U\x96\x7f\xb7\xaa\x9b&\x98\xa6" +
+	"ST\xe7\x13\x92N2a\x87\xf4J/Id\xd4\x88" +
+	"\xa7c0\x8e YR\x09,\x83\xe3W\xa5\xbbHJ" +
+	"\xba\xbb\xda\xaajI<\xcb\x89\xe88\xc8\xe0\xaa\xf81" +
+	"\xab\xac\xcc\x0c~\xed\x02\xb2\x08+\x1c>F\x06\x1c\x11" +
+	"p\xd5U\xf7\xe0.\xae\xcc\xea\xa0\xab8\xc3\xa8\xac:" +
+	"\xab\x82\xbd\xe7\xbe\xea\xaaz\xe9\xee\x803\xfe\xa5\xbcz" +
+	"\xfd\xde\xbb\xbf\xfb\xbb\xf7\xfe\xee{\x99\xb1\xf0\xbc\x0e\xae" +
+	"\xc5\x1b\xaa&\xa4\xaf\x09\xbc\xe3\xb2\xbb.\xdd\xb4\xbe\xfb" +
+	"\x87\xcd\xb7\x12A\x04B<>B\xc4L\xe9\x87\xc4\x93" +
+	"\xd5\x1a\x9b\xa7MZ\xf8\xef+\x88P\x09\x84xy\x1f" +
+	"!m\x8bK\x1b\x81\x80(\x97n!\x90\x1dz\xf3;" +
+	"U\xd7\\\xf7\x97\xb7\x13\xa1\x9c\xcb\xde\xbf\xeb\x9e9\xe5" +
+	"C/\xaf\"\x04\xc4/J\xdf\x14\xbd~\\\x09\xfc\xf3" +
+	"\x09d\xf74\xcc\xfc\xc3\x96\xab{\xee$\xd2$\xe0\xb2" +
+	"#\xd3o\x98v\xf9c\x91{\x89\x17pJ\xb9\xffu" +
+	"\xb1\xc1_I\x88\xd8\xec\x7f\x9f@\x16\xee\xea]t\xd1" +
+	"\x86\xa1;\x890\x11\xb2\x1bw\\9\xeb\xd1-\xfb\x7f" +
+	"N\xbc>\x9c\xeb\x9d\xb8M\xf4O\xc4\x83\x94L\xbc\x07" +
+	"\x08\xb8\xbbJ\x13\x81gf\xd3\x95\xf7\x076\x8a\x87\x03" +
+	"\x95\x84\xb4\xbd\x16X\x84\xb3\x135WW\xad\x0bm\xfe" +
+	"I\xfel/\xcen\x166\x8a-\xc2w\x09i\xeb\x12" +
+	"\x0e\xe2\xec.o\xe0\xcc\x81\xe3\x1bV\xe7\xec\xc7%\xdb" +
+	"$\x91\xda\xbfX\x8c\x12p\x0d\x11&2\xab\x11\x10\x87" +
+	"\xc5\xfb\xc4\x15\"\x9a\xb4J<(B\xd0GHvc" +
+	"\xe5\xea[J\xdan[C\x84rg\xb5\x13b\x0d\xae" +
+	"\xf6\x09]mIx\xd9/+6\xf6>@\x84\xaa\x9c" +
+	"\x1f\xda\x84\xe0$ \x9e\xec\x05e\x7f\xf5\xf5\xe1iK" +
+	"\x1f`\x0f\xf2\x85\x18\xc6\x9fB\x10\x7f:[[\xfb\xe3" +
+	"\xc8U\xe1\x07\x0b\x0e\xd2\x10\xdc-6\xe3\xf6\xe2\xd4\xe0" +
+	"Aq\x1d=\xc8\xa3\xdf\x99p`\xf0\xba\xf3\x1e\xb1\x0e" +
+	"B\xf7\xb9#X\x83\xfb\xdc\xad\xfe\xc7\x7f=\xbb\xf2\xf4" +
+	"\xa3D\x98\xc4e\xef\xf9\xde\x84\xf3\xafy*\xbc\x1b\x97" +
+	"I\x06_\x14\x87\xe92\x99\xe0\x85\x04\xb2\x97\x1ez\xfe" +
+	"\xdau\x9f]\xf2\x18\x11*\xec%\x86\xad\xa3\xfe\xe4\xf8" +
+	"\xb5\xd3\xa6^\xb4\xe5\xb1<\x84)\xab\xe4\xe0FQ\xa5" +
+	"\xab(\xc1-\x04>\xba\xe9\xed\x7f\xbe\xe3\xe2\xf2\xc7\x85" +
+	"I6\xed\xce\x04O\x11O\xf6\xfa[\xf7\xfdwO\xdd" +
+	"KO\x12a\x0a\x97}\xe5\xcc\x9e\x87\x87\xb2\x97\xec$" +
+	"\x04\xda\xde\x09N\x00\xf1\x13\xba\xc0\xc9 \xd2\xea\xa7+" +
+	"\x86\xde\xd8{\xd7\xabO\x16\x98\xed-\xdf(\xfa\xcbq" +
+	"bI\xf9Jq1\xfe_v\x82\xd0\xf8\xc2-/\xbd" +
+	"\xb0\x019\x08\xaeu\x16S.+\x7fN\x9cC\x7f\xd0" +
+	"U\xbe\x8c@\xb6y0\xfb\xd9\xe5\x93Wn$B5" +
+	"\x97\xbd\xf1!\xe9\x957~\xfa\xf1!\\y}\xf9)" +
+	"q3\x9d\xb8\xa1\x1c\x91\xe8\x9b\x99\xbd\xf7\xc7_\xfd\xf5" +
+	"&\"U\x80\x0d\xc5\xe6r\xea\xd5\xedt\xa5\xea+\xde" +
+	"\xeb\x1a\xb7n\xed&\x06\xaa\xea\x0a\x8a\xf6\xa9\xfb\xde\xf5" +
+	"\xbf\xb9\xe9\xf6\xcdD\xa8\xe2\xb2\x0f\xb7\xb5^\x7f\xea\xe0" +
+	"k\xdb\xd0N\xa8\xa8\x01Q\xa8\xc0M\xfc\x15h\xe7\xce" +
+	"\xc6\xe3'\xefY\x7f\xe4i+B\xad\x137T\x9c\"" +
+	" N\xad@\xf7\xc7FJ:\xae\xd8\xe3\xd9\xcaDp" +
+	"W\xc5\x97\xc4\x93=\x19\xed\xbe\xf0\xc9\x8f\xfebk\xee" +
+	"t^\x0e\xb7o\xae\x98\x84\xc7k\xa9\xc0`s\xf6\x95" +
+	"&\x02\xc7D\x04O\xa3\xad\xf29\xd1_\x89\xf1S]" +
+	"\x19\xc2\x88\xe0\xa6\x1f\xf6O\xf8\xdd\xa1\x7fa9<\xbd" +
+	"\x8a\xa3\xcbU\xe1I\xde\xe9h\xaf\xdb\x7fKx\x07\xcb" +
+	"T\xb9\x8a2U\xa5\x13\xfc\xbf;\xbe\xe0\xfa\xfe\xb5;" +
+	",\x92[\x13VU\xb5\xe3\x845t\x82\xe3\xf0\xd1\x07" +
+	"\xa2Fm\xad\xfa\xad\xf8l\x15\x86\xd5\x81*\xcc?\x8e" +
+	"\x13Gg\x0a\x8e\x92\xad\xfa>Q\xad\xfe.!\xe2\xf2" +
+	"j\xf4\xc3\xa2\x19\xcb\xfemU\xfc\xb1\xdd\xec\xc9NT" +
+	"\xb7\xd2\xf0\xab\xc6\x8d#\xb7\xebGO&\xffu7q" +
+	"\xf9(\xd4 \x88s\x8e\xee\xdf\xad\xad~t/\xf3\xe1" +
+	"\xb3j$\xea%\x0b\xd3\xfd\x0f\xff\xc3\x9e_Y\x1f," +
+	"\xb7\x1c\xad\xfe\x92\x80x\x8c\xae\xf8\xc9\x83s\xa4E\x8d" +
+	"\x93\xf7\xa1)\x90\x9fm\xceTo\x13\xbd5\x88\xad\xbf" +
+	"\x86\xe6\xa6\x1aO\xea\x87\x8f\x94\x9c~\x8eE&3\x99" +
+	"2i\xf9d\\\xee\xf7\x17\xbe\xf4u\xe5\xfa\xef\x1c`" +
+	"'l\x9eL\xa1\xdbN'\xbc\xd50\xfe\xbd\xf3\x8f-" +
+	"?\xc0\x04\xf6\x91\xc9\x94j\xf3\xd7\xcc\xda\xfe?\xdf[" +
+	"\xfd\x82kB\xdb\xb3\x939\xfc\xb2c\xf93S\xd7\xed" +
+	"K\x1fds\xfczk\xd7\x0d\x93\x11c\x87\xfcR9" +
+	"0\x09\xd4\x02\xf9\xb2)/\x8a\xddS\xd0!\x0b\xa7\xe0" +
+	"\xe4\xc6\xcaX\xeb\xd4Wj\x0e\xb3G<9\x85\x82\xfc" +
+	"\xd9\x14\xea\xfe\x0f\xe7-\x8c\xbc\xf0\xf3\xc3L4\x94\xd7" +
+	"\xd2#\x0e\xef\xb9oiW\xf7\xcf^\xb4\xbeX?=" +
+	"3\x85&[o-\xfe40\x1e*?)\xed\x7f\x9d" +
+	"\xe5\xde\xd4ZJ\xe5\xe9t\xc2\x97\xcb\xef\x1f\x8e\xee\xdc" +
+	"\xfbzA6\xe8\xae]+.\xac\xc5\xf3J\xb5\x07\xc5" +
+	"\xc3\xf8\x7f\xd9__\xfa\x7f\x17\xad\xee}\xe8\x08\x1bR" +
+	"[k?D,\xe9b\x8f\xcc\xfd\xf5\xee)\xff\x18\x7f" +
+	"\x83\x8d\xeb#\xd6n\xc7\xe8\x84[\xaa\xd7\xee<\xf0\xbf" +
+	"\xe6o\x0av;S\xbbQ\xf4\xd6!&\xfe\xba\x95\xe2" +
+	"\xb5u\xb8\xdb[\xd1=Z\xe4\xf3qo3fw\xd5" +
+	"Q\xfck\x8em\x9by\xbf\xff\xfawX\xc4\x9a\xeb(" +
+	"b-u\xb8\xcf\xdd\xbf\xfaCg\\\xf7\x1c/\x8a\xbf" +
+	"T\xf7\xa1\xb5\x83\xb8\xb8n\x0b\x81?^\xe9\xff\xb4-" +
+	"x\xf3\xbb,\x84u\x13(\x84!\x1a[\x17\x1c[s" +
+	"t\xc1\x8b\xef\xb3FO\x0da\x1ei\xa6\xdf\xb9]\xc6" +
+	"\xde\x07\xb7\xee8Q`\xd3\xe2\xd06Q\x0e\xe1z\xd7" +
+	"\x86\xbe\x0f\xe2\xd4z4\xaaw\xc5\xa6\x88p]\xf7I" +
+	"\"\xd4pn\x85'\xd0\xe6\xaf\xe7@\xac\xae\xa7\xb5\xbd" +
+	"\x1e\xf3WS\xd3\x8a\xbf\xcf\xf2{>b\xcco\xae\xa7" +
+	"^\xbf\xed\xe8\xf7\xd3?\xe8{\xea\x14:\xd5\x85\xd42" +
+	"N\xa8\x7fS\xac\xa5\xabT\xd7c\x04\xff\x8d\xf7\xe5\xe7" +
+	"'\xed\x9a\xff9\xae\xe2\xc6\x9657S\xff[q\x05" +
+	"\x9d\xbb\x9c\xce\xddk\xcc\x1c\xa8\xcf\\\xf79\x13\x0aG" +
+	"\xebi\x81\xfa\xe0\x19\xe5\xb6\x9b\x9e\xf8\xd9\x1f\xdd/\xe2" +
+	"\xfez\x0c\xf3\xaf\x8e~\xfcU\x0d\xbf\xe4\x8b\x02\xe3\x9f" +
+	"\xa8\x7fN\xdcL\xd7\xdeP\x7fPlh@\xdb\xef\xe6" +
+	"O\xef\xfc4|\xfa4K\xc6\x92\x06\xcaV\xa1\x01\xa1" +
+	"|\xbf\xf6\xedc7\x86~\xf35\x93\x92[\x1a0i" +
+	"\xec[9k_\x8d\xff\xc2,\x934\xda\xca\x1b\xa8\x93" +
+	"j\x1b\xa2dz6\xadk1\xc50\"\\LN\xa7" +
+	"\xd2\xed\xb3u-\x15I\x18M=r@\x97\x93\x86\xf3" +
+	"\x99\xb7>w\x0d)\xb1\x8c\xa9\xe9\x11#-/K5" +
+	"\xf5\xc8\xbaON\x1aR)\xef!\xc4\x03\x84\x08]\xed" +
+	"\x84H\x1d<H\xf38\x10\x00\x82\xc8fa\x0e\x0e^" +
+	"\xce\x83\xd4\xc3\x81\xc0qA\xe0\x08\x11\xbaq\xf0J\x1e" +
+	"\xa4\x05\x1cD\x93Z<\x93P\xa0\xcce\x1c\x01(#" +
+	"\x105\xcc\xb8\x961Ap\x8b/\x01\x10\xac\x0f\x8a\xae" +
+	"\x17~\xc87\xa8\xcf\x94\xcd\x8c\x11\xe93e\xdeTz" +
+	"\x00\xa4R\xba{m'\x9d_\xdeN\x08p\x82\xbf\x9d" +
+	"\x90\x11=\x93J\xa9\xa9\x81\xa82\xa4\x9aJ<\xbaD" +
+	"V\x13J<\x7f\xb9\xb9Z\x7f\xa4WI'\xd4\x18\xc8" +
+	"\x92\x07\x80\xa1%\xb4\x86p3E*s\xd0\x90\xc3\x84" +
+	"H\xd7\xf0 \x0dr`\x83\xa1\xb4\x12\"\xdd\xc0\x83\x94" +
+	"@0\xc0\x02C\x9dK\x884\xc8\x83dr \xf0\\" +
+	"\x10xB\x84\x9b\xae&DJ\xf3 \xfd-\x07\x81A" +
+	"\xcd0\xa1\x94pPJ d\xe0>\x10p7'\x00" +
+	"\x01\x02Y<\xfal-\xae\x10B\xc0C8\xf0 \x1e" +
+	"\x099\xa6$\x95\x14\xe1M\x03\xc6\x13\x0e\xc63 A" +
+	"\xce\xebZ(\x99\x94Sq\xc4g\x8as\xfa\xedx\xfa" +
+	"\xa7y\x90~\xc9\xf8r\x17\x0e>\xc3\x83\xf4*\xe3\xcb" +
+	"\x97\x1b\x09\x91\x0e\xf1 \xbd\x8b\xc7\xe7\xad\xe3\xbf\x83\x83" +
+	"o\xf1 }\xc0\x81\xe0\xf1\x04\xc1C\x88\xf0^'!" +
+	"\xd2\xdb<H\xbf\xe7@\xf0z\x83\xe0%D8\x81T" +
+	"x\x97\x07\xe9c\x0e\x84q\xe3\x820\x8e\x10\xe1$n" +
+	"\xf4\x01\x0f}\x1e\xe0 \x90\x96\xcdA\xdb\xfc\x80\xac\x0f" +
+	"\x180\x91@\x0f\x0ftl\"\x01\x9f\x92\xba9\x7f(" +
+	"\xae\xea\xf6OFt\xc50e\xdd\x842V\xe2Q\x86" +
+	"%\xd4\xa4j\x1aP\xe6jI\xebC &\xa7\x9d]" +
+	"\xca\xdc\xd4A\x00\x07\xf3\x11\xec3\x03\xc83J\x09\xb7" +
+	"Oq(Q\xe5\x80\xfa0\xba\xff\x01\x1e\xa4_0\xa0" +
+	"\xaeC\xa8\x1e\xe2Az\x9c\xe1\xc4z\xe4\xc4/x\x90" +
+	"\x9e\xe2\x00r\x98n@P\x1e\xe7Az\x9a\xc1t3" +
+	"N|\x8a\x07i'bZfa\xba\xbd7\xe7\xa7}" +
+	"\x9c\xcb\x17\xe7d\x16_|i5\x0e^\xc2\x81\xb78" +
+	"wF\xa1\x9e\xcdAh\xe0\x04\x9bG\x9a\x96\xbcJM" +
+	"$\x14\x02q\x00\xc2\x01\x14\"\xd3\xad\x050\xb6\xa5\xf1" +
+	"\xc0T\x17\xa1\xa4\xd3-\xf5\x82\xb7q\xa4GW\xb4\xb4" +
+	"\x92\xf2\xcd\x96\xd3R\xbd\x03\xd6kH\x8c\x97x\x90\xfe" +
+	"\x93\x01\xeb\x08b\xf0j\x8eB6\x03O4Z\x14\xea" +
+	"\x05\x86\x81g\x10\x98\xd3<\xf4U\x81\x0b\x97X\x0ea" +
+	"B\xfa\xca\x80\x87\xbe\xf3\x81\x03\xb0H(6C?!" +
+	"}\xd3p\xf8\x02\x9c>\x0e(\x0f\xc5\x16\xe8$\xa4\xef" +
+	"|\x1c\xbf\x08\xc7}\\\x90V\xb2\x99t|\x06\x8e\xcf" +
+	"\x02\x0e\xa2\xfdjJ\xd6\x87\xc1O8\xf0\x7fc\x8ef" +
+	"\xd3\x96\xdd\x14T\x87k\x0eL\x16\xd7\xf2\x98\xc8\xf4\x07" +
+	"t\x89\xa4\x92\xd4\xf4\xe1\x1e\x99\xf8\x06\x14'\xc2GL" +
+	"5\xa9`\xe6\xcc\xb9w$\x96\xce,P\x93\x8a\xe3\xee" +
+	"\xb1\x12\xbb2\xa4\xc4\x9az\x15#\xe3K\x98\x86\xe4q" +
+	"\\\xe1G\xd4\xc7\xf3 \x051\x1au-\x06\x82[\xc0" +
+	"\xf3R/\xd8\xb9\x12\xfai@8\xd5Z\x80\xce\x11+" +
+	"{\xcaR)\xef%\xc4\x91\x8a`\x17*Aj'\x9c" +
+	"\xd0\xe5\x03p\x940\xd8ZY\xb88L8a\xba\x0f" +
+	"8\xbb\x99cDq\x03~+\xf7E\x0d\x9a\xf0; " +
+	"`\x98Z\xba\x03\x02)9\xa9t@\x0f\x14\x98<[" +
+	"N\xf7)f$\xa1iK3i,e2\x9f\x1c\xd3" +
+	"b\\\xc5\x89\x84\xbc\x85\xfab\x83\x0aR\\\xb7\x0a\xa7" +
+	".'a\xec\xc2\xe9lG\xeb+\xbb]\x8d\xbb\x1d\xaf" +
+	"\xc6\x0b6\x03{\xa1\xa8\xb5\x12\xade\x14C[T\x83" +
+	"}\x83 Ha\x1bC\xfbR\x05\xecVI\xb8\xb85" +
+	"\x87\xa1\xd3\xe7\x83\xdd\xac\x08\x0d\xed\x14\xc3\x00\x92\xa0\x03" +
+	"B\xb4\xc8w@\xd4:sQ\x10\x17\xe9\xaa\xa9\xe8\x91" +
+	"e\xf8\x1fJ\x9b\x04o\x1a\xf9Es\x9e6\x10\xe93" +
+	"uEN\x12\x82\xc7\x1eOCVh\xa7\xbc)i'" +
+	"$W\xe6sE=\x7f\x8f\x9e\xdc?\x97\xc9\xaaY\x80" +
+	"o\x0e\x96yj\x00\xd3\xb8\x05\x8a#F\x1a\xcf)F" +
+	"<91\x12v\xc5\x88/\x96\xce\xc0y\x84\x83\xf3\x08" +
+	"D\xad\xe0\x82\x12\xc2A\x09&D5^XD\x19i" +
+	"\x804i\xea\x09\xc9\xc5\xb4S\xaf\x95?{\xb4\x84\x1a" +
+	"\x1b\x8et\xd3T\xcb\x08\x92VK\x90\xf4RA\x82\xd8" +
+	"\x84R\xca\xcd\x8a\x9e\xd5RW\xc8j\"\xa3\x13P\xa2" +
+	"rb\x99<\\`\xfb\"=\x80N\xa0\xc8RB\xd8" +
+	"\xbd\x0b\xd8w/B\x0b:}*\x12\xc2\x96\xaa`\xb7" +
+	"oB5~\xf3\xfbB\xd4\x87\x1d\x10\x8a%4#/" +
+	"b\x18?v\xa5L}\xd8\xf2\xa2\x83s3B\xda\xc4" +
+	"\x834\xc3\x959\xd3\x11\xd1i<H\x17p(\xd6\xd0" +
+	"\xf9\x10p\xaf|\xac\x02\x14\x88\xcb\xa6l\xa7\xcc\xfc\xdd" +
+	"\xba\xa9&\x8c\xcc\x96\xd3\xa1\xc8U\xaa%Nr\xcci" +
+	"u\x98\x132\xb5\xb4\x1a\x8b\xca\xa9\xd8\xa0vv\xe2\xd8" +
+	"\xe4d\xcf=\xd7=\xa3\x00\xf5\xd6\xc1[\xb0p\xce\xe0" +
+	"A\x9a\xc5\x15\x17Tg\xabyy\x1b'\xb4\x01\xa30" +
+	"*xWjS4#\xdd\xaaa(\xf1\xde\x0c\x9f2" +
+	"\x18B\x84-Bt:\x0a5`,U\xd3(S\xe7" +
+	"\xa7bJT\xcf\xa4.K$\x8a\xeaw9\x1e\xb7I" +
+	"\xc8&\x98V7\xc1\x84\x14\xdc\x18\xca\xd8\x8b \x14=" +
+	"c.\xd7\x1bE+\x0a\x8d\x18ei.6Y\x01\xdc" +
+	"\xee\x0a`'\x02\x95\xb0\xab\x80!W\xbfU\x9c\x18\xe7" +
+	"AJc\xfd\x06\xab~'\xdb]U\x1c]\xa2%\x12" +
+	"\xda2\x1b\xf1\x80)\xab\x09;\x12\xbf}\x97\xe0\xf8\x82" +
+	"\xd0\x82\xe5\xde\x95\xc1\xd5Y\xdb;\x84O\x19R\xd01" +
+	"m9Z1\xc4\x83\xf4#\xc6\xb4\x15\xc8\xa8[y\x90" +
+	"\xeeb\xb4\xc9*LC?\xe2A\xba\xd7\x15r\x7f\x87" +
+	"\xa6\xdd\xc9\x83\xf4\x00*\x13\xb0\x84\xdc\x1a\x14\xc7w\xf1" +
+	" =\x94_q\x8c\\\x89A\x1e\xe6\xc6|7j\xfd" +
+	"P\xe6v\xa699\x9b\xa4\xa7\x85\x80k\x83\x15j#" +
+	"\x09\xd90{3\xa9\xb1\x04\x80]\x0ds|5\x0dR" +
+	"\x8c:M\x1c\x84\xf0`F\xa1\x8e9[\x9f\xd8\xab\x18" +
+	"\x81\xcc\x9f\xaf'\x0a\xeb\xacUDmR\xb2\xcb6\xba" +
+	"\xcbR\x84\x04\xf7\xda\xbc\xb8\xeb\xdd\xb4\xecSc\xc3\xd4" +
+	"\xfd\xee\x950\x84\x03\x98\xa8\xd9\x9a\x12.VS:\xd9" +
+	"\x9a\x92\xd3\xef\xdd\xfd\x84H\xf3x\x90~\xc0A \xa9" +
+	"\xc5Q\x80;+\xe7\x9c\xd2/\xc7\x96jK\x968N" +
+	"I\xcaC\xf4<\xc4\xa7\x8f\xdd\xad\xcd\xd3`\x80\xcai" +
+	"\xe7\x8eY(ien\xd3\xbd\xed!\xca\xe5\xa8Us" +
+	"\xc7H\xd4\xce\xe1\xa7w\xe6\xb2\xe0\xe54S\xa3z\x82" +
+	"2\xf6}\x05y5\x82\x09CU\x18\x19\xeal\x9e\xd7" +
+	"\x10\x8d!xz\x95\x90\xf1-(\xc0\xd4X\xd4u\x85" +
+	"\xf9(\x7fB~\x11\xce/\xd2\xc5\xb8sN\xc1\xe7\x08" +
+	"\xdbP\x7f_Z\x89Q\xb2\xb8\xf7D\xd0\x1a\x9a'\xf7" +
+	"+\x09\xb6\xdb\x0b\x17\xeb\xf6:\xd9n/\x97$\xd6\xa3" +
+	"k\x1e\xe1A\xfa'\xa6\x81yb\xee\xd9\xda\xbdCN" +
+	"\xf3\"\x1c\xc0\xb1\xe7\xad\xae|\xd4\xd1Gb\x1a\xed\xec" +
+	"\xa1\xcc}\xe8\xb23\xc5\x18w.Y]\xb9)\xa3\x18" +
+	"V\xa3W\xd0\x16g\x0d%\xa1\xc4LM\x1f\xd5\xb18" +
+	" \xe4\xc8\xa0[\"\x7fT\xafx6\xd5\xec\x14\xe7\"" +
+	"\xdeh\xe2 p\xa3\xd6\x7f\xae\x94\xe3\xaegd\xfa\x93" +
+	"X\xf0\xbfUv\x18\xado\xa9&*\xac\xe4>\x96t" +
+	"\x188V\x094\x88=\xa1Xsew\x1a\x7f\x92N" +
+	"-ri\xe6\x8b%\x8b\xb9\xf5[\xd7BWw\x11\xab" +
+	"\x1a\xba\xafS\x10\x0e\xa0\x12\x1b#\x1d:'\x0f\x17\xcb" +
+	"\x86\xcc\xc9G14\xb0TMa\xbdrv\xb1Rc" +
+	"4\x96P\x95\x94\x09\x93<<\x01\x98\xf4\x0d\xaaA\x11" +
+	"\xc5\xf3M;8[\xc5,U\x13\x891\xf5\x9a=\xc9" +
+	"0\xe3j\xaah\x8b\xcc\x0a,:\xeb,`\x17\xeb\x9f" +
+	"l\xfd4\x86\x05E\xc5\xb2}\x83\xa7\xf3Z\xcam\x09" +
+	"\xed'<\xb0\xdf\xea\x04\xa9\xd1n\x09\xed\xb7\x08\xb0/" +
+	"\x8d\x85\x8bk\xec\x96\xd0~\x9c\x07\xfb\xee^h\xa8\xa1" +
+	"-\xa1O\x8e\xc7;\x80\xd7\x93\x1d\xc0'\x8c\xa2\xdd\x01" +
+	"\xd5\x8az\xb2Xk\xfbg\xf9\xa1\x08\x1a\xed\xee:Q" +
+	"C\x1dH\xc9\x09\xf7\x9a3\x0f\x0f9\x80z\x86\xf2\xd7" +
+	"\xbd\xf5\x87V\xab2\xe6\xfa$\xfb\x8d\x1c\xec\xfbu\xa1" +
+	"\xa5\xdd\xee\x93\xec\x8b{\xb0\x1f!\x85\xea\x1a\xda'9" +
+	"\xddp\x01\x0e\xbe\xd1F\xa8\xf1\xe2\xd9\xa0 Q\x9d\x83" +
+	"\xb8FZ\x89\x15\x0a\xbd1\xa2\xb6GW\x02ZZI" +
+	"\xe55\xc2s\x99\x88\xb4\xc3\xb4\xbb\xd7\xd5'\xb6\x0c_" +
+	"\x88\x13\x17\xf0 \xdd\xc0AvP3\xcc\x1e\xd9\x1cd" +
+	"Dgv\x00+C\x8fl\x12`/\x06\xe5\xf8\xfcT" +
+	"b\x18\xe7\x9d\xa3!R\xe3\x8e\xbc$\x7f\xc2\xddG\xde" +
+	"\xe3D1-\xd1\xe9V\x8bB\xad\xc2v9E.o" +
+	"{\xf4\x10\xfd7\x82\x16\xa4\xcc\xb0\xff\xe2\x00\xec\x07w" +
+	"aM\x98p\xc2\x1d\xc8\x0c\xfb\xad\x0e\xec\xd7Ea\x18" +
+	"\xbf%1~\xec\xa7'\xb0\xdf\x17\x05\x19\xbb\xeb\x85>" +
+	"\xe0\x9dW9\xb8\xd2\xff)\xa1\xeffs\x90Q\x97\xfa" +
+	"\xc0}9\x07\xfb\xfd^h\x09S&\x06\xb0w\xed\xc0" +
+	"$\x99Ht\xe4\xb2J\x07b\xd4\x01\x01\xec\xb9\x8a^" +
+	"\xca\xb8\x8f\x14r\x84\xde?\x93\xc2\x87\x0f\xb7\xad\x1cI" +
+	"+\xa9\xb8\x9a\x1a\xc8{\x009{\xfa\xcb\x15\xb1\x02\x0f" +
+	"Y]DW\xca\xe4\xf5\xe1\xbcK\x82p1\xed\xd9\xe8" +
+	"v\xe0\xa32\x03\xc6R\x91\xdc\xef\xaa8\xd4_\x91y" +
+	"\xb2\xaf_I\xe4\xed\xd3Xl\x9fVw\x1f\xdfRe" +
+	"\xd8yI\xb9YNd\xc6LG\xa3\x8a\x7f\xfe\xc5\x13" +
+	"\x97\xdf7E{\x8a*N'\xdc\x89\x9b\x9e\xedgX" +
+	"\xb0\x1f\xd9\x99[O\xfb\xefr\xc0~\xa1g\xd2\xb3\xfd" +
+	"\xe0\x0d\xf6\xdf5\xd87vQ+\x95\xe4\x92\xd2\xd9." +
+	"\xecF\xdfz\x16\xabb\xacF:\xab\x17\xec\x84o\x87" +
+	"c1\x1a\xe6dQ\xd1\xa0\xc7\\S\xca\x834\x8d\x1b" +
+	"-\x18\x9d\xb0e^yq\xf0\xff\x03\x00\x00\xff\xff5" +
+	"X\x04\xa5"
+
+func init() {
+	schemas.Register(schema_9fc1afa23c48b6aa,
+		0x80295b4da1ab3db9,
+		0x81d355122829226f,
+		0x832b5e5c1823da78,
+		0x88505aafee3521bb,
+		0x8878a93857528c01,
+		0x89cd7815498fb993,
+		0x8aad1f9d185a1a6c,
+		0x8ba9e3c5fd0f0545,
+		0x902ea34428602d7f,
+		0x918233097a8b17aa,
+		0x9452aa16bc772a66,
+		0x946b28c9fe2f1034,
+		0x952a4b2e869a6f43,
+		0x9c0b5e68c50a23a2,
+		0xa2fc87bddbd7698e,
+		0xa33bf59d5dc4c83d,
+		0xa3af3825285de38a,
+		0xa415390085aee071,
+		0xa6cc1e50dfc0805f,
+		0xa6d08cbed6788196,
+		0xa9c6cc7ac622110a,
+		0xaa871b44f5ff6829,
+		0xab4efb8690ff3553,
+		0xab9a9d0645e54619,
+		0xad83abda0de492f2,
+		0xb0d5a18fede322b8,
+		0xb104bb4640097f63,
+		0xb126efa6374d3fed,
+		0xb2d1c7f25f323399,
+		0xb3c8eb0a0dc92d02,
+		0xb62a7ac11e3a40e1,
+		0xb69a625f54e3eb0d,
+		0xb83bff7899bbfdce,
+		0xba2aac5c2c0a368f,
+		0xbaa36489cf773057,
+		0xbacb6dedd972832e,
+		0xbea28b6fbac1d949,
+		0xbfbb9b996270553b,
+		0xc01b2257514995f1,
+		0xc2fc099c5b6e041a,
+		0xc523a117fecc37ec,
+		0xc57ddd2ce50821dc,
+		0xc68b36e6b53c914f,
+		0xc770c09d25b47db6,
+		0xc8f096d6ce51986a,
+		0xc91ace2532631722,
+		0xc99fc62e554cea0d,
+		0xca9e4d456b92bb79,
+		0xd2620cf11701080f,
+		0xd2beb83f79937dfa,
+		0xd598528b38f83dc3,
+		0xd664a71cbac34a9c,
+		0xde74f3c5b89a197a,
+		0xe006f62e6fbb3fdc,
+		0xe15f0d9335b2dd1a,
+		0xe304726442eebf8e,
+		0xe476143300f40d48,
+		0xe7ca54d991dd34ce,
+		0xe9b6b195be73b902,
+		0xed4d5e112eab8152,
+		0xefbb03ff97812424,
+		0xf2ac53587047d982,
+		0xf64fb912c4cd0556,
+		0xf65e7520673573be,
+		0xf79ea5718265b4e8,
+		0xf966031afbf0d9fb,
+		0xfcfc2af4b8fc038e,
+		0xfede1f6adde01de7,
+		0xff370d1ac03c87c0)
+}
@@ -41,12 +41,28 @@ func (c Topic) Subscribe(ctx context.Context, params func(Topic_subscribe_Params
 		},
 	}
 	if params != nil {
-		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 1}
 		s.PlaceArgs = func(s capnp.Struct) error { return params(Topic_subscribe_Params{Struct: s}) }
 	}
 	ans, release := c.Client.SendCall(ctx, s)
 	return Topic_subscribe_Results_Future{Future: ans.Future()}, release
 }
+func (c Topic) Peers(ctx context.Context, params func(Topic_peers_Params) error) (Topic_peers_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x986ea9282f106bb0,
+			MethodID:      2,
+			InterfaceName: "pubsub.capnp:Topic",
+			MethodName:    "peers",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Topic_peers_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Topic_peers_Results_Future{Future: ans.Future()}, release
+}
 
 func (c Topic) AddRef() Topic {
 	return Topic{
@@ -63,6 +79,8 @@ type Topic_Server interface {
 	Publish(context.Context, Topic_publish) error
 
 	Subscribe(context.Context, Topic_subscribe) error
+
+	Peers(context.Context, Topic_peers) error
 }
 
 // Topic_NewServer creates a new Server from an implementation of Topic_Server.
@@ -81,7 +99,7 @@ func Topic_ServerToClient(s Topic_Server, policy *server.Policy) Topic {
 // This can be used to create a more complicated Server.
 func Topic_Methods(methods []server.Method, s Topic_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 2)
+		methods = make([]server.Method, 0, 3)
 	}
 
 	methods = append(methods, server.Method{
@@ -108,6 +126,18 @@ func Topic_Methods(methods []server.Method, s Topic_Server) []server.Method {
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x986ea9282f106bb0,
+			MethodID:      2,
+			InterfaceName: "pubsub.capnp:Topic",
+			MethodName:    "peers",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Peers(ctx, Topic_peers{call})
+		},
+	})
+
 	return methods
 }
 
@@ -145,6 +175,23 @@ func (c Topic_subscribe) AllocResults() (Topic_subscribe_Results, error) {
 	return Topic_subscribe_Results{Struct: r}, err
 }
 
+// Topic_peers holds the state for a server call to Topic.peers.
+// See server.Call for documentation.
+type Topic_peers struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Topic_peers) Args() Topic_peers_Params {
+	return Topic_peers_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Topic_peers) AllocResults() (Topic_peers_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Topic_peers_Results{Struct: r}, err
+}
+
 type Topic_Handler struct{ Client *capnp.Client }
 
 // Topic_Handler_TypeID is the unique identifier for the type Topic_Handler.
@@ -258,17 +305,28 @@ func (s Topic_Handler_handle_Params) String() string {
 	return str
 }
 
-func (s Topic_Handler_handle_Params) Msg() ([]byte, error) {
+func (s Topic_Handler_handle_Params) Msgs() (capnp.DataList, error) {
 	p, err := s.Struct.Ptr(0)
-	return []byte(p.Data()), err
+	return capnp.DataList{List: p.List()}, err
 }
 
-func (s Topic_Handler_handle_Params) HasMsg() bool {
+func (s Topic_Handler_handle_Params) HasMsgs() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Topic_Handler_handle_Params) SetMsg(v []byte) error {
-	return s.Struct.SetData(0, v)
+func (s Topic_Handler_handle_Params) SetMsgs(v capnp.DataList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewMsgs sets the msgs field to a newly
+// allocated capnp.DataList, preferring placement in s's segment.
+func (s Topic_Handler_handle_Params) NewMsgs(n int32) (capnp.DataList, error) {
+	l, err := capnp.NewDataList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.DataList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
 }
 
 // Topic_Handler_handle_Params_List is a list of Topic_Handler_handle_Params.
@@ -485,12 +543,12 @@ type Topic_subscribe_Params struct{ capnp.Struct }
 const Topic_subscribe_Params_TypeID = 0xc772c6756fef5ba8
 
 func NewTopic_subscribe_Params(s *capnp.Segment) (Topic_subscribe_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
 	return Topic_subscribe_Params{st}, err
 }
 
 func NewRootTopic_subscribe_Params(s *capnp.Segment) (Topic_subscribe_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
 	return Topic_subscribe_Params{st}, err
 }
 
@@ -522,12 +580,36 @@ func (s Topic_subscribe_Params) SetHandler(v Topic_Handler) error {
 	return s.Struct.SetPtr(0, in.ToPtr())
 }
 
+func (s Topic_subscribe_Params) MaxInflight() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Topic_subscribe_Params) SetMaxInflight(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Topic_subscribe_Params) BatchSize() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Topic_subscribe_Params) SetBatchSize(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Topic_subscribe_Params) BatchLatency() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s Topic_subscribe_Params) SetBatchLatency(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
 // Topic_subscribe_Params_List is a list of Topic_subscribe_Params.
 type Topic_subscribe_Params_List struct{ capnp.List }
 
 // NewTopic_subscribe_Params creates a new list of Topic_subscribe_Params.
 func NewTopic_subscribe_Params_List(s *capnp.Segment, sz int32) (Topic_subscribe_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
 	return Topic_subscribe_Params_List{l}, err
 }
 
@@ -611,6 +693,140 @@ func (p Topic_subscribe_Results_Future) Struct() (Topic_subscribe_Results, error
 	return Topic_subscribe_Results{s}, err
 }
 
+type Topic_peers_Params struct{ capnp.Struct }
+
+// Topic_peers_Params_TypeID is the unique identifier for the type Topic_peers_Params.
+const Topic_peers_Params_TypeID = 0xf1fc6ff9f4d43e07
+
+func NewTopic_peers_Params(s *capnp.Segment) (Topic_peers_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Topic_peers_Params{st}, err
+}
+
+func NewRootTopic_peers_Params(s *capnp.Segment) (Topic_peers_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Topic_peers_Params{st}, err
+}
+
+func ReadRootTopic_peers_Params(msg *capnp.Message) (Topic_peers_Params, error) {
+	root, err := msg.Root()
+	return Topic_peers_Params{root.Struct()}, err
+}
+
+func (s Topic_peers_Params) String() string {
+	str, _ := text.Marshal(0xf1fc6ff9f4d43e07, s.Struct)
+	return str
+}
+
+// Topic_peers_Params_List is a list of Topic_peers_Params.
+type Topic_peers_Params_List struct{ capnp.List }
+
+// NewTopic_peers_Params creates a new list of Topic_peers_Params.
+func NewTopic_peers_Params_List(s *capnp.Segment, sz int32) (Topic_peers_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Topic_peers_Params_List{l}, err
+}
+
+func (s Topic_peers_Params_List) At(i int) Topic_peers_Params {
+	return Topic_peers_Params{s.List.Struct(i)}
+}
+
+func (s Topic_peers_Params_List) Set(i int, v Topic_peers_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Topic_peers_Params_List) String() string {
+	str, _ := text.MarshalList(0xf1fc6ff9f4d43e07, s.List)
+	return str
+}
+
+// Topic_peers_Params_Future is a wrapper for a Topic_peers_Params promised by a client call.
+type Topic_peers_Params_Future struct{ *capnp.Future }
+
+func (p Topic_peers_Params_Future) Struct() (Topic_peers_Params, error) {
+	s, err := p.Future.Struct()
+	return Topic_peers_Params{s}, err
+}
+
+type Topic_peers_Results struct{ capnp.Struct }
+
+// Topic_peers_Results_TypeID is the unique identifier for the type Topic_peers_Results.
+const Topic_peers_Results_TypeID = 0xd5765aab1c56263f
+
+func NewTopic_peers_Results(s *capnp.Segment) (Topic_peers_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Topic_peers_Results{st}, err
+}
+
+func NewRootTopic_peers_Results(s *capnp.Segment) (Topic_peers_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Topic_peers_Results{st}, err
+}
+
+func ReadRootTopic_peers_Results(msg *capnp.Message) (Topic_peers_Results, error) {
+	root, err := msg.Root()
+	return Topic_peers_Results{root.Struct()}, err
+}
+
+func (s Topic_peers_Results) String() string {
+	str, _ := text.Marshal(0xd5765aab1c56263f, s.Struct)
+	return str
+}
+
+func (s Topic_peers_Results) Peers() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Topic_peers_Results) HasPeers() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Topic_peers_Results) SetPeers(v capnp.TextList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewPeers sets the peers field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Topic_peers_Results) NewPeers(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Topic_peers_Results_List is a list of Topic_peers_Results.
+type Topic_peers_Results_List struct{ capnp.List }
+
+// NewTopic_peers_Results creates a new list of Topic_peers_Results.
+func NewTopic_peers_Results_List(s *capnp.Segment, sz int32) (Topic_peers_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Topic_peers_Results_List{l}, err
+}
+
+func (s Topic_peers_Results_List) At(i int) Topic_peers_Results {
+	return Topic_peers_Results{s.List.Struct(i)}
+}
+
+func (s Topic_peers_Results_List) Set(i int, v Topic_peers_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Topic_peers_Results_List) String() string {
+	str, _ := text.MarshalList(0xd5765aab1c56263f, s.List)
+	return str
+}
+
+// Topic_peers_Results_Future is a wrapper for a Topic_peers_Results promised by a client call.
+type Topic_peers_Results_Future struct{ *capnp.Future }
+
+func (p Topic_peers_Results_Future) Struct() (Topic_peers_Results, error) {
+	s, err := p.Future.Struct()
+	return Topic_peers_Results{s}, err
+}
+
 type PubSub struct{ Client *capnp.Client }
 
 // PubSub_TypeID is the unique identifier for the type PubSub.
@@ -632,6 +848,38 @@ func (c PubSub) Join(ctx context.Context, params func(PubSub_join_Params) error)
 	ans, release := c.Client.SendCall(ctx, s)
 	return PubSub_join_Results_Future{Future: ans.Future()}, release
 }
+func (c PubSub) Ls(ctx context.Context, params func(PubSub_ls_Params) error) (PubSub_ls_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf1cc149f1c06e50e,
+			MethodID:      1,
+			InterfaceName: "pubsub.capnp:PubSub",
+			MethodName:    "ls",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(PubSub_ls_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return PubSub_ls_Results_Future{Future: ans.Future()}, release
+}
+func (c PubSub) Peers(ctx context.Context, params func(PubSub_peers_Params) error) (PubSub_peers_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xf1cc149f1c06e50e,
+			MethodID:      2,
+			InterfaceName: "pubsub.capnp:PubSub",
+			MethodName:    "peers",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(PubSub_peers_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return PubSub_peers_Results_Future{Future: ans.Future()}, release
+}
 
 func (c PubSub) AddRef() PubSub {
 	return PubSub{
@@ -646,6 +894,10 @@ func (c PubSub) Release() {
 // A PubSub_Server is a PubSub with a local implementation.
 type PubSub_Server interface {
 	Join(context.Context, PubSub_join) error
+
+	Ls(context.Context, PubSub_ls) error
+
+	Peers(context.Context, PubSub_peers) error
 }
 
 // PubSub_NewServer creates a new Server from an implementation of PubSub_Server.
@@ -664,7 +916,7 @@ func PubSub_ServerToClient(s PubSub_Server, policy *server.Policy) PubSub {
 // This can be used to create a more complicated Server.
 func PubSub_Methods(methods []server.Method, s PubSub_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 1)
+		methods = make([]server.Method, 0, 3)
 	}
 
 	methods = append(methods, server.Method{
@@ -679,6 +931,30 @@ func PubSub_Methods(methods []server.Method, s PubSub_Server) []server.Method {
 		},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf1cc149f1c06e50e,
+			MethodID:      1,
+			InterfaceName: "pubsub.capnp:PubSub",
+			MethodName:    "ls",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Ls(ctx, PubSub_ls{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf1cc149f1c06e50e,
+			MethodID:      2,
+			InterfaceName: "pubsub.capnp:PubSub",
+			MethodName:    "peers",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Peers(ctx, PubSub_peers{call})
+		},
+	})
+
 	return methods
 }
 
@@ -699,6 +975,119 @@ func (c PubSub_join) AllocResults() (PubSub_join_Results, error) {
 	return PubSub_join_Results{Struct: r}, err
 }
 
+// PubSub_ls holds the state for a server call to PubSub.ls.
+// See server.Call for documentation.
+type PubSub_ls struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c PubSub_ls) Args() PubSub_ls_Params {
+	return PubSub_ls_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c PubSub_ls) AllocResults() (PubSub_ls_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_ls_Results{Struct: r}, err
+}
+
+// PubSub_peers holds the state for a server call to PubSub.peers.
+// See server.Call for documentation.
+type PubSub_peers struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c PubSub_peers) Args() PubSub_peers_Params {
+	return PubSub_peers_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c PubSub_peers) AllocResults() (PubSub_peers_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_peers_Results{Struct: r}, err
+}
+
+type PubSub_TopicInfo struct{ capnp.Struct }
+
+// PubSub_TopicInfo_TypeID is the unique identifier for the type PubSub_TopicInfo.
+const PubSub_TopicInfo_TypeID = 0xb9c8e1127528c407
+
+func NewPubSub_TopicInfo(s *capnp.Segment) (PubSub_TopicInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return PubSub_TopicInfo{st}, err
+}
+
+func NewRootPubSub_TopicInfo(s *capnp.Segment) (PubSub_TopicInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return PubSub_TopicInfo{st}, err
+}
+
+func ReadRootPubSub_TopicInfo(msg *capnp.Message) (PubSub_TopicInfo, error) {
+	root, err := msg.Root()
+	return PubSub_TopicInfo{root.Struct()}, err
+}
+
+func (s PubSub_TopicInfo) String() string {
+	str, _ := text.Marshal(0xb9c8e1127528c407, s.Struct)
+	return str
+}
+
+func (s PubSub_TopicInfo) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s PubSub_TopicInfo) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s PubSub_TopicInfo) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s PubSub_TopicInfo) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s PubSub_TopicInfo) Subscribers() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s PubSub_TopicInfo) SetSubscribers(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// PubSub_TopicInfo_List is a list of PubSub_TopicInfo.
+type PubSub_TopicInfo_List struct{ capnp.List }
+
+// NewPubSub_TopicInfo creates a new list of PubSub_TopicInfo.
+func NewPubSub_TopicInfo_List(s *capnp.Segment, sz int32) (PubSub_TopicInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return PubSub_TopicInfo_List{l}, err
+}
+
+func (s PubSub_TopicInfo_List) At(i int) PubSub_TopicInfo { return PubSub_TopicInfo{s.List.Struct(i)} }
+
+func (s PubSub_TopicInfo_List) Set(i int, v PubSub_TopicInfo) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s PubSub_TopicInfo_List) String() string {
+	str, _ := text.MarshalList(0xb9c8e1127528c407, s.List)
+	return str
+}
+
+// PubSub_TopicInfo_Future is a wrapper for a PubSub_TopicInfo promised by a client call.
+type PubSub_TopicInfo_Future struct{ *capnp.Future }
+
+func (p PubSub_TopicInfo_Future) Struct() (PubSub_TopicInfo, error) {
+	s, err := p.Future.Struct()
+	return PubSub_TopicInfo{s}, err
+}
+
 type PubSub_join_Params struct{ capnp.Struct }
 
 // PubSub_join_Params_TypeID is the unique identifier for the type PubSub_join_Params.
@@ -849,45 +1238,478 @@ func (p PubSub_join_Results_Future) Topic() Topic {
 	return Topic{Client: p.Future.Field(0, nil).Client()}
 }
 
-const schema_f9d8a0180405d9ed = "x\xda\x94\x93Oh\xd4\\\x14\xc5\xef}/iJ\xe9" +
-	"|\xed\xfb^\xad\x7fK\xa1T\xd1\x82S[A\xa1(" +
-	"\x1d*R+\x0a\xc9\xd4\"\xa5\xb8H\xc6`\xa3\x93i" +
-	"H\x9a\xc5 2\x08\x05\xc7\x85+7Z\x94\x8a.\xd4" +
-	"\x9d\x1b\x17.\\\xb8\x19)v\xa1\xe0\xa2\xea\xda\x95\x14" +
-	"iAPf\x11I2\x99d\xa62\xe2*\x8b\x1c\xce" +
-	"=\xf7w\xcf;\xa2b\x86\x8c\x88\xc3\"\x80rBl" +
-	"\xf3F\xd7\xfaW\x97\x8fYK\xc08\x02\x08\x12\xc0\xd1" +
-	"\x1bd\x08A\xf0N-\xbd+\x96\xefv\x97\xc3?\"" +
-	"\xfa\xbft\xf2?\x02r\x93\x8c\x03z[_:\x9el" +
-	"N\xad\xdf\x06\xb6\xb7.\xb8C\xce\xfa\x82\xfb\x81\xe0\xc5" +
-	"\xb5\xee\xe1\x83\xcf\x0b\xf7\x80\xa5\xa8\xb7\xf1I\x14v=" +
-	"Z\xff\x05\x80\xfc\x15Y\xe6o\xc8N\x00\xbeJn\xf1" +
-	">*\x01x\xe7^\x16\xe7*\xee\xf1\x87\x89 \"\xdd" +
-	"\xe3\x07)\xf7]\xafT\xe5\xfc\x0a\xb0\x9e\xfa\x9c\x0d\xd2" +
-	"\xe1\xcf\xf9\x11\xccy6\xf7}\xc1\xad\xd8o\x93Iw" +
-	"\xd0\x01_\xd0G}A\xb5\xb2\xd5{`\xf2\xc1\x07`" +
-	"\x9c\xc6\xa9\x00\xf9I\xfa\x99O\xf9\xe3\xf9i:\xc9\xcd" +
-	" \xc8\x7f_\xdb\xf6\xad\xf4\xacmnK=C\x1f\xf3" +
-	"K\x81x\x96N\xf2\x9b\x81X{}\xf1\xdb!\xfe\xf1" +
-	"g\xc8 Hm\xd0\xac\x9f\xfa\xe9\xf9\"y\xdf\x9b\xa9" +
-	"&S\xcfP\xe2\x87\x9a\xa5\xe3p\xd8\xb3\\\xcdq\xb5" +
-	"t\x8e\xaaV\xc1\x1a\xbb\xb0`\x19\xb9\xb4\xe3jN\xce" +
-	"64}0\xab;]n~\xd1\xf9\xa3\xccr\xb5\xbc" +
-	"\xe1\xcc\x0f\xca\xaa\xad\x9a\xe8(\x02\x15\x00\x04\x04`\xa9" +
-	"\x01\x00\xa5\x9d\xa2\xd2CP2\x9d+\x98\x02\x82)\xc0" +
-	"\xba\x8d\x90\xb09\xa3\x16.\xe7u;=\x1f|C7" +
-	"\x07\xe0_\xec0\xb2\xa3FN\x110\x89\x1a'J\xb5" +
-	"\x01J;\x15\x01\xea\x85\xc2\xe8\xd4ld\x02\x08\xdb/" +
-	"a|B\x8c\xfa\xc8vg\x810&\x95j\xbbf\xd0" +
-	"\x8b\xe0\x00\xea\x19\x94\x11[\xa2\xc9\xea\x8e\x9b\xa7\xdb\xf8" +
-	"\xc9\xae6\xedj\xe9\xab\x0bF!\x94,6-<\x1a" +
-	"/\xdc\xbf\xe8;\"K6\x06\x19\xe0_.'\xab\xb6" +
-	"\xa4\x9a\x0dG\x99\x88MK!l\x1bY\x8c\xaa\xc9\x96" +
-	"4\x9f\x88\xea\xb6\x8c\xa8\x08\x01\xc5\xe8\xd5aT=\xc6" +
-	"\xc6\x800Q\x1a\x0f\x9d\x1b\xd1`\xb4\xb54\xedj\xb1" +
-	"ITN\x8c\xde\x16cC\x81I\x97O\xa6\xd1\xa2E" +
-	"cB\x82\xd8\x0ar\xadTI\x1aC1\x8d\xae\x82j" +
-	"\xea\xd8\x09\x04;\x01\x7f\x07\x00\x00\xff\xff\xfa\x01K\xa1"
+type PubSub_ls_Params struct{ capnp.Struct }
+
+// PubSub_ls_Params_TypeID is the unique identifier for the type PubSub_ls_Params.
+const PubSub_ls_Params_TypeID = 0xd90126e2405801b3
+
+func NewPubSub_ls_Params(s *capnp.Segment) (PubSub_ls_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return PubSub_ls_Params{st}, err
+}
+
+func NewRootPubSub_ls_Params(s *capnp.Segment) (PubSub_ls_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return PubSub_ls_Params{st}, err
+}
+
+func ReadRootPubSub_ls_Params(msg *capnp.Message) (PubSub_ls_Params, error) {
+	root, err := msg.Root()
+	return PubSub_ls_Params{root.Struct()}, err
+}
+
+func (s PubSub_ls_Params) String() string {
+	str, _ := text.Marshal(0xd90126e2405801b3, s.Struct)
+	return str
+}
+
+// PubSub_ls_Params_List is a list of PubSub_ls_Params.
+type PubSub_ls_Params_List struct{ capnp.List }
+
+// NewPubSub_ls_Params creates a new list of PubSub_ls_Params.
+func NewPubSub_ls_Params_List(s *capnp.Segment, sz int32) (PubSub_ls_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return PubSub_ls_Params_List{l}, err
+}
+
+func (s PubSub_ls_Params_List) At(i int) PubSub_ls_Params { return PubSub_ls_Params{s.List.Struct(i)} }
+
+func (s PubSub_ls_Params_List) Set(i int, v PubSub_ls_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s PubSub_ls_Params_List) String() string {
+	str, _ := text.MarshalList(0xd90126e2405801b3, s.List)
+	return str
+}
+
+// PubSub_ls_Params_Future is a wrapper for a PubSub_ls_Params promised by a client call.
+type PubSub_ls_Params_Future struct{ *capnp.Future }
+
+func (p PubSub_ls_Params_Future) Struct() (PubSub_ls_Params, error) {
+	s, err := p.Future.Struct()
+	return PubSub_ls_Params{s}, err
+}
+
+type PubSub_ls_Results struct{ capnp.Struct }
+
+// PubSub_ls_Results_TypeID is the unique identifier for the type PubSub_ls_Results.
+const PubSub_ls_Results_TypeID = 0xcec60b27d5a94b89
+
+func NewPubSub_ls_Results(s *capnp.Segment) (PubSub_ls_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_ls_Results{st}, err
+}
+
+func NewRootPubSub_ls_Results(s *capnp.Segment) (PubSub_ls_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_ls_Results{st}, err
+}
+
+func ReadRootPubSub_ls_Results(msg *capnp.Message) (PubSub_ls_Results, error) {
+	root, err := msg.Root()
+	return PubSub_ls_Results{root.Struct()}, err
+}
+
+func (s PubSub_ls_Results) String() string {
+	str, _ := text.Marshal(0xcec60b27d5a94b89, s.Struct)
+	return str
+}
+
+func (s PubSub_ls_Results) Topics() (PubSub_TopicInfo_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return PubSub_TopicInfo_List{List: p.List()}, err
+}
+
+func (s PubSub_ls_Results) HasTopics() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s PubSub_ls_Results) SetTopics(v PubSub_TopicInfo_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewTopics sets the topics field to a newly
+// allocated PubSub_TopicInfo_List, preferring placement in s's segment.
+func (s PubSub_ls_Results) NewTopics(n int32) (PubSub_TopicInfo_List, error) {
+	l, err := NewPubSub_TopicInfo_List(s.Struct.Segment(), n)
+	if err != nil {
+		return PubSub_TopicInfo_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// PubSub_ls_Results_List is a list of PubSub_ls_Results.
+type PubSub_ls_Results_List struct{ capnp.List }
+
+// NewPubSub_ls_Results creates a new list of PubSub_ls_Results.
+func NewPubSub_ls_Results_List(s *capnp.Segment, sz int32) (PubSub_ls_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return PubSub_ls_Results_List{l}, err
+}
+
+func (s PubSub_ls_Results_List) At(i int) PubSub_ls_Results {
+	return PubSub_ls_Results{s.List.Struct(i)}
+}
+
+func (s PubSub_ls_Results_List) Set(i int, v PubSub_ls_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s PubSub_ls_Results_List) String() string {
+	str, _ := text.MarshalList(0xcec60b27d5a94b89, s.List)
+	return str
+}
+
+// PubSub_ls_Results_Future is a wrapper for a PubSub_ls_Results promised by a client call.
+type PubSub_ls_Results_Future struct{ *capnp.Future }
+
+func (p PubSub_ls_Results_Future) Struct() (PubSub_ls_Results, error) {
+	s, err := p.Future.Struct()
+	return PubSub_ls_Results{s}, err
+}
+
+type PubSub_peers_Params struct{ capnp.Struct }
+
+// PubSub_peers_Params_TypeID is the unique identifier for the type PubSub_peers_Params.
+const PubSub_peers_Params_TypeID = 0xa5f365fefa8999e0
+
+func NewPubSub_peers_Params(s *capnp.Segment) (PubSub_peers_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_peers_Params{st}, err
+}
+
+func NewRootPubSub_peers_Params(s *capnp.Segment) (PubSub_peers_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_peers_Params{st}, err
+}
+
+func ReadRootPubSub_peers_Params(msg *capnp.Message) (PubSub_peers_Params, error) {
+	root, err := msg.Root()
+	return PubSub_peers_Params{root.Struct()}, err
+}
+
+func (s PubSub_peers_Params) String() string {
+	str, _ := text.Marshal(0xa5f365fefa8999e0, s.Struct)
+	return str
+}
+
+func (s PubSub_peers_Params) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s PubSub_peers_Params) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s PubSub_peers_Params) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s PubSub_peers_Params) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// PubSub_peers_Params_List is a list of PubSub_peers_Params.
+type PubSub_peers_Params_List struct{ capnp.List }
+
+// NewPubSub_peers_Params creates a new list of PubSub_peers_Params.
+func NewPubSub_peers_Params_List(s *capnp.Segment, sz int32) (PubSub_peers_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return PubSub_peers_Params_List{l}, err
+}
+
+func (s PubSub_peers_Params_List) At(i int) PubSub_peers_Params {
+	return PubSub_peers_Params{s.List.Struct(i)}
+}
+
+func (s PubSub_peers_Params_List) Set(i int, v PubSub_peers_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s PubSub_peers_Params_List) String() string {
+	str, _ := text.MarshalList(0xa5f365fefa8999e0, s.List)
+	return str
+}
+
+// PubSub_peers_Params_Future is a wrapper for a PubSub_peers_Params promised by a client call.
+type PubSub_peers_Params_Future struct{ *capnp.Future }
+
+func (p PubSub_peers_Params_Future) Struct() (PubSub_peers_Params, error) {
+	s, err := p.Future.Struct()
+	return PubSub_peers_Params{s}, err
+}
+
+type PubSub_peers_Results struct{ capnp.Struct }
+
+// PubSub_peers_Results_TypeID is the unique identifier for the type PubSub_peers_Results.
+const PubSub_peers_Results_TypeID = 0x98e173200b4a7c33
+
+func NewPubSub_peers_Results(s *capnp.Segment) (PubSub_peers_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_peers_Results{st}, err
+}
+
+func NewRootPubSub_peers_Results(s *capnp.Segment) (PubSub_peers_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return PubSub_peers_Results{st}, err
+}
+
+func ReadRootPubSub_peers_Results(msg *capnp.Message) (PubSub_peers_Results, error) {
+	root, err := msg.Root()
+	return PubSub_peers_Results{root.Struct()}, err
+}
+
+func (s PubSub_peers_Results) String() string {
+	str, _ := text.Marshal(0x98e173200b4a7c33, s.Struct)
+	return str
+}
+
+func (s PubSub_peers_Results) Peers() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s PubSub_peers_Results) HasPeers() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s PubSub_peers_Results) SetPeers(v capnp.TextList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewPeers sets the peers field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s PubSub_peers_Results) NewPeers(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// PubSub_peers_Results_List is a list of PubSub_peers_Results.
+type PubSub_peers_Results_List struct{ capnp.List }
+
+// NewPubSub_peers_Results creates a new list of PubSub_peers_Results.
+func NewPubSub_peers_Results_List(s *capnp.Segment, sz int32) (PubSub_peers_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return PubSub_peers_Results_List{l}, err
+}
+
+func (s PubSub_peers_Results_List) At(i int) PubSub_peers_Results {
+	return PubSub_peers_Results{s.List.Struct(i)}
+}
+
+func (s PubSub_peers_Results_List) Set(i int, v PubSub_peers_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s PubSub_peers_Results_List) String() string {
+	str, _ := text.MarshalList(0x98e173200b4a7c33, s.List)
+	return str
+}
+
+// PubSub_peers_Results_Future is a wrapper for a PubSub_peers_Results promised by a client call.
+type PubSub_peers_Results_Future struct{ *capnp.Future }
+
+func (p PubSub_peers_Results_Future) Struct() (PubSub_peers_Results, error) {
+	s, err := p.Future.Struct()
+	return PubSub_peers_Results{s}, err
+}
+
+type Envelope struct{ capnp.Struct }
+
+// Envelope_TypeID is the unique identifier for the type Envelope.
+const Envelope_TypeID = 0xc4a4c674b4062922
+
+func NewEnvelope(s *capnp.Segment) (Envelope, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Envelope{st}, err
+}
+
+func NewRootEnvelope(s *capnp.Segment) (Envelope, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Envelope{st}, err
+}
+
+func ReadRootEnvelope(msg *capnp.Message) (Envelope, error) {
+	root, err := msg.Root()
+	return Envelope{root.Struct()}, err
+}
+
+func (s Envelope) String() string {
+	str, _ := text.Marshal(0xc4a4c674b4062922, s.Struct)
+	return str
+}
+
+func (s Envelope) Payload() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Envelope) HasPayload() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Envelope) SetPayload(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s Envelope) Nonce() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s Envelope) HasNonce() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Envelope) SetNonce(v []byte) error {
+	return s.Struct.SetData(1, v)
+}
+
+func (s Envelope) KeyId() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Envelope) SetKeyId(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Envelope) Signer() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return []byte(p.Data()), err
+}
+
+func (s Envelope) HasSigner() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Envelope) SetSigner(v []byte) error {
+	return s.Struct.SetData(2, v)
+}
+
+func (s Envelope) Signature() ([]byte, error) {
+	p, err := s.Struct.Ptr(3)
+	return []byte(p.Data()), err
+}
+
+func (s Envelope) HasSignature() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Envelope) SetSignature(v []byte) error {
+	return s.Struct.SetData(3, v)
+}
+
+// Envelope_List is a list of Envelope.
+type Envelope_List struct{ capnp.List }
+
+// NewEnvelope creates a new list of Envelope.
+func NewEnvelope_List(s *capnp.Segment, sz int32) (Envelope_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return Envelope_List{l}, err
+}
+
+func (s Envelope_List) At(i int) Envelope { return Envelope{s.List.Struct(i)} }
+
+func (s Envelope_List) Set(i int, v Envelope) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s Envelope_List) String() string {
+	str, _ := text.MarshalList(0xc4a4c674b4062922, s.List)
+	return str
+}
+
+// Envelope_Future is a wrapper for a Envelope promised by a client call.
+type Envelope_Future struct{ *capnp.Future }
+
+func (p Envelope_Future) Struct() (Envelope, error) {
+	s, err := p.Future.Struct()
+	return Envelope{s}, err
+}
+
+const schema_f9d8a0180405d9ed = "x\xda\x8cV]l\x14\xd5\x17?\xe7\xde\x99\xde\x0d\xb0" +
+	"\xb4\x97Y\xf8\xffA\xcc\x0a)H\x89T(\xf1\x83>" +
+	"\xb8\x1b\x0c\xc1\"&{\x17\xa3Fx\x99\xd9\x0e\xed\xc2" +
+	"\xee\xecfg\x07Y\x85\x14\x93\x9a\xb4\x09$&\xc6\x04" +
+	"\x89\x04\xa3\x12%\xbe\xf8\xc5\x83\x0f<\x98\xc0\"\x11\x8d" +
+	"&\x90P\x0d\x89\x984>hCZ}\xb0\xd48\xe6" +
+	"\xce\xec\xccN\xb7\x1f\xfa\xb43s\xcf\xfd\x9d\xf3;\xe7" +
+	"\xfc\xce\xd9\xad\xbf\x914\xd9\xa6\xdee\x00\xe2\x80\xda\xe6" +
+	"\xf6\\O^;\xf3hy\x18\xb8\x86\x00\x0a\x03\xd8~" +
+	"\x9bnFP\xdc'\x87\xbf\xae\x8d\xbc\xd11\xe2\x9f\xa8" +
+	"(\x8f\xae\xd0\x15\x08\xa8}CS\x80\xee\xd4\x8fK\xde" +
+	"\x9b\xec\xbb5\x0a\xfc\xbe\xd0`\x82\xee\x91\x06\xd3\x9e\xc1" +
+	"\xc7\x87;\x1e\xdet\xc1:\x0d<N\xdd\x891U\xf9" +
+	"\xff;\xb7\xa6\x01P\xebR\xceh\xdb\x94\xff\x01h;" +
+	"\x94\xab\xdaE\xe9\xd5\xdd~l\xcf\xd2\x07\xec;\xa7\x81" +
+	"'B\xb4\xb3\x8a\xe7\xee\xbc\"\xd1\xf6^\xac\xed\xaf;" +
+	"\x8f\x9d\x8dDzSY##\x1d\xb9\xff\x95\xfaL\xa6" +
+	"p.z\xf5\x92\xb2D^\xbd\xe2]\xfd\xe9\xad\xd1{" +
+	"\x7f\x9b\xbf\x9f\x8f\x1a\x8c\xfb\x06\x13\x9e\x01\xbb\xbc\xc9Y" +
+	"q\xe7\xab/@$\x10\xdd\xe5\xe3mk\xcf%\xaeO" +
+	"\xfa\x96Z\\\x9d\xd2V\xab\xf2i\xa5\xfa\x12\xa0\xbb\xbe" +
+	"\xab\xed\xf3j\xfd\xfd\xcb \xe2\x88M^\xaa\x0cIs" +
+	"\xd4O\xb5\xe3\xd2x{MM\"\xa0\xfb\xe1\xfe\xbb%" +
+	"\xa7^\xb9\x0aBC\x128?\xd5\xb6^:\x7f\xb3\xed" +
+	"\x17@w\xf4\xe9\x0b7\x1f\\Z\xff6\x12\x9d\xe6\xb0" +
+	"{\x80Z\x8d\xc9\xe0f\xeaS\xab6\xee~\xfb{\xe0" +
+	"\x1am\xe6\x14P\xbb\xc0~\xd0.2i\xfe\x09\xdb\xad" +
+	"\x8d\xc9'7\xb5\xf1\xb9\xb5\x1f\xbdx\xe4f\xb4j_" +
+	"2\x8f\xea5\x0f\xed3|!\xfd\xf3F\x1c\xf3\xbdy" +
+	"1O\xb3)P\x9a\xac[\xabu\x9b\xbd\xab\x8d3Y" +
+	"\xadIvU\xcb\xc7\xa4\x1b\xf6\xc4\x8d?\xa6K\x7fM" +
+	"F\x8a!bD\x16\xc3\xb8\xf4\xfc\xaf]\xda\x8d?\xfd" +
+	"\xae\xf0N\x1e\x89e\xe5\xc9\x07\xcf\xd4\xc8w\xab\xd23" +
+	"\xd1*\xac\x93\x97P\xdb\x10K\xc1\x16\xb7\xec\x18\xb6c" +
+	"t\xe7\xa8^\xb6\xca\xbd\xcf\x96\xca\xf9\\\xb7\xed\x18v" +
+	"\xae\x927\xcc\xce\xaci\xb7;\x85\xaa=\xafY\xd91" +
+	"\x0ay{\xb03\xa3W\xf4\"\xdaB\xa1\x0a\x80\x82\x00" +
+	"<\xbe\x1e@\xc4(\x8a\x04AV\xb4\x070\x0e\x04\xe3" +
+	"\x80!\x8c\x12\x81yJ\xb7\xfa\x0bf\xa5{\xd0\xfb\xf5" +
+	"\xd1l\x80(\xdc\xe6\x06\\'\xc1\xf6\xa2=`\xe3r" +
+	"\xc0\x0cE\x0fvy\x04\x16\x03X\x9a\xcf\x09\x05\xa3e" +
+	"\xc4\x9dC\x0dGb\x19U\x01B\xa9a\xd0\xe3\\\xec" +
+	"\x04\xc2w1l\xf6\x0f\x06J\xe5;\xb2@\xf86\x86" +
+	"$\xac\x02\x06U\xe7\x1bz\x80\xf0\xd5l\xa8\x91\x8f4" +
+	"\xbaA\x02\x01\xcd4&\xcb\xa6Y\xb1\xd3\x98AlI" +
+	"c\xc61\xf69F\xb7w.S\xed\x14\xaa\xb3\xd3\xd8" +
+	"\xd3\xe4\xed\xa3\x04\xc4\x97\xb5\x10\x9f\xaf,\x1e \x9dS" +
+	"\xbb\x86\xd3C\xa5\xbc\xd5\xf0\xd9\x92\xec\x9ef\xed\x92U" +
+	"\x89\x88<\xaa\x00\xe4\xb0(\x8f\xc5\xea\x97 \xd8n\xe9" +
+	"E\xd3\x8b\x7fY\x04\x87Dq$\x8dd\xae\xcf:X" +
+	"\xca \x8aX\x08\xd3%a:)\x8a\xad\x04\x11\x13(" +
+	"\xbfm1\x00\xc4C\x14\xc5\xe3\xad\xd0a\x0d\x98\xcc[" +
+	"\x0c\x08\xc6\xe6t\xca.\xebH\xd2,\x94\xca\xa6t\x94" +
+	"\x08\x1d\x1d\xdf\x09 \x8eR\x14\xc3\x04y\xe0\xe9U\x99" +
+	"\x97c\x14\xc5\x08A$\x099W\xf8k\xf2\xdb\x09\x8a" +
+	"\xe2$ANI\x02)\x00\x1f\xed\x05\x10\xc3\x14\xc5\xeb" +
+	"\x04\xb9B\x13\xa8\x00\xf0SY\x00q\x92\xa28Mp" +
+	"\xa8\xac\xd7\x0a%\xbd?PE\xd2*Y93|;" +
+	"l\xd6\xfa\xfa\x83\x80Sv~\xc02+\xa1\x80\xe4\xab" +
+	"^u*\x80\xe6\x1cQ\xcd/\xe1\x8c^az\xd1\x16" +
+	"\x1d!=]\xd2;@Q\x0c6\xf3h\xca<\xf6S" +
+	"\x14e\x82\x9c\xa0O\xaf(\x83.P\x14G%=\xf4" +
+	"\xe99\x87\x00D\x95\xa28Ap\xc8\xd7l\x05yS" +
+	"i\x8d\x0e)\xeaG\xfb\xac\x83\x85<\xb0\x81\xc1j\x98" +
+	"~C\xaf\xe6\x06\xf7\xe5_\x96\xe1\xcf\xfa\xb6W\xafB" +
+	"\xbbi\xe5j\xa8\x02Au\xa1\xd6(\xd8\x9d\xd9\x94\xdf" +
+	"\xb4\xd1\xfe\xeam\xea$\xe5\xb5l(\x94\x8e\xe6\x9a\x01" +
+	"\x9c%\x19\xd2:\x82\xa8Y\x91m\xa0x\xd3!\xd8\xb3" +
+	"\x18\x8cV\xce{\x81p\x95\xa5|\xca\xf3\xc9\xb9!\xbf" +
+	"\x88\x9a\x17P\xd6\xbf\xca\xb9\x95s&\xe9\x89\xaa\xa5{" +
+	"3\x8e\xc1\xf69\x867\xe8B\x96\x1c\xb3\xae\x17H\x9f" +
+	"u\x10\xb0\xd4\x18v\xc1\x1a\xc0`ms\xb19\x18v" +
+	"\xc1r\xc2`'\xf2\x1dk\x80\xf0-r\xd8\x05K\x1c" +
+	"\x83\x7f\x0a|\x9d\x1cv+Y\xbb\x9c\x1fi\xa4\x05{" +
+	"\xfe\x01\x17\x9b\x93\x91\xc6\\\x08\x0c\x16^\x00\xc1 \\" +
+	"dn5\xb0\xfe\xcb\x88\xf9'\x00\x00\xff\xff\xcc'\x82" +
+	"m"
 
 func init() {
 	schemas.Register(schema_f9d8a0180405d9ed,
@@ -895,11 +1717,19 @@ func init() {
 		0x8810938879cb8443,
 		0x89d849f1a30adbf2,
 		0x986ea9282f106bb0,
+		0x98e173200b4a7c33,
 		0x9d3775c65b79b54c,
 		0x9f6c50fbc67b1d88,
+		0xa5f365fefa8999e0,
+		0xb9c8e1127528c407,
+		0xc4a4c674b4062922,
 		0xc772c6756fef5ba8,
+		0xcec60b27d5a94b89,
 		0xd19c472616f2c6fb,
+		0xd5765aab1c56263f,
+		0xd90126e2405801b3,
 		0xf1cc149f1c06e50e,
+		0xf1fc6ff9f4d43e07,
 		0xf8d41329eb57bd62,
 		0xfb4016d002794da7)
 }
@@ -11,6 +11,12 @@ var subcommands = []*cli.Command{
 	Join(),
 	Publish(),
 	Subscribe(),
+	Topics(),
+	Topic(),
+	Run(),
+	Job(),
+	Cron(),
+	Logs(),
 }
 
 func Command() *cli.Command {
@@ -43,6 +49,22 @@ func Command() *cli.Command {
 				Value:   time.Second * 15,
 				EnvVars: []string{"WW_CLIENT_TIMEOUT"},
 			},
+			&cli.PathFlag{
+				Name:        "identity",
+				Usage:       "load the client's private key from `FILE`, creating it if needed",
+				DefaultText: "<data>/client.key, or random",
+				EnvVars:     []string{"WW_CLIENT_IDENTITY"},
+			},
+			&cli.PathFlag{
+				Name:    "swarm-key",
+				Usage:   "join the private network whose pre-shared key is in `FILE`",
+				EnvVars: []string{"WW_SWARM_KEY"},
+			},
+			&cli.BoolFlag{
+				Name:    "reconnect",
+				Usage:   "re-dial the cluster if the connection is lost",
+				EnvVars: []string{"WW_RECONNECT"},
+			},
 		},
 		Subcommands: subcommands,
 
@@ -0,0 +1,221 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"github.com/wetware/ww/pkg/cap/cron"
+	"github.com/wetware/ww/pkg/client"
+)
+
+// ww client cron add --name backup --schedule "0 3 * * *" -- ./backup.sh
+func Cron() *cli.Command {
+	return &cli.Command{
+		Name:  "cron",
+		Usage: "manage periodic jobs",
+		Description: `Cron entries are only available on hosts that were started
+with --enable-exec.  Each entry is fired by the host to which it was
+added, and is removed when that host leaves the cluster.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "host",
+				Usage: "use the cron table of the host with peer `ID`",
+			},
+		},
+		Subcommands: []*cli.Command{
+			{
+				Name:   "ls",
+				Usage:  "list cron entries",
+				Action: cronLs(),
+			},
+			{
+				Name:      "add",
+				Usage:     "add a cron entry",
+				ArgsUsage: "-- <cmd> [args...]",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:     "name",
+						Usage:    "entry `NAME`",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "schedule",
+						Usage:    "cron `EXPR`, evaluated in UTC, e.g. \"*/5 * * * *\" or @daily",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "missed",
+						Usage: "missed-run `POLICY` (skip, run-once, run-all)",
+						Value: "skip",
+					},
+				}, jobFlags...),
+				Action: cronAdd(),
+			},
+			{
+				Name:      "rm",
+				Usage:     "remove a cron entry",
+				ArgsUsage: "<name>",
+				Action:    cronRm(),
+			},
+		},
+	}
+}
+
+func cronLs() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		hosts, err := cronHosts(c)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(c.App.Writer, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "HOST\tNAME\tSCHEDULE\tMISSED\tLAST RUN")
+
+		for _, h := range hosts {
+			entries, err := lsCron(c, h)
+			if err != nil {
+				return fmt.Errorf("%s: %w", h.ID(), err)
+			}
+
+			for _, e := range entries {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					h.ID(),
+					e.Name,
+					e.Schedule,
+					e.Missed,
+					e.LastRun.UTC().Format(time.RFC3339))
+			}
+		}
+
+		return w.Flush()
+	}
+}
+
+func cronAdd() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		spec, err := jobSpec(c)
+		if err != nil {
+			return err
+		}
+		spec.Name = "" // assigned each time the entry is fired
+
+		missed, err := missedRuns(c)
+		if err != nil {
+			return err
+		}
+
+		h, err := selectHost(c)
+		if err != nil {
+			return err
+		}
+
+		t, release, err := h.Cron(c.Context)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return t.Add(c.Context, cron.Entry{
+			Name:     c.String("name"),
+			Schedule: c.String("schedule"),
+			Job:      spec,
+			Missed:   missed,
+		})
+	}
+}
+
+// cronRm removes the named entry from every host on which it exists,
+// unless --host is set.
+func cronRm() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		if c.Args().Len() != 1 {
+			return errors.New("must provide an entry name")
+		}
+		name := c.Args().First()
+
+		hosts, err := cronHosts(c)
+		if err != nil {
+			return err
+		}
+
+		var removed bool
+		for _, h := range hosts {
+			entries, err := lsCron(c, h)
+			if err != nil {
+				return fmt.Errorf("%s: %w", h.ID(), err)
+			}
+
+			for _, e := range entries {
+				if e.Name != name {
+					continue
+				}
+
+				t, release, err := h.Cron(c.Context)
+				if err != nil {
+					return err
+				}
+
+				err = t.Rm(c.Context, name)
+				release()
+
+				if err != nil {
+					return fmt.Errorf("%s: %w", h.ID(), err)
+				}
+
+				removed = true
+			}
+		}
+
+		if !removed {
+			return fmt.Errorf("%w: %s", cron.ErrNotFound, name)
+		}
+
+		return nil
+	}
+}
+
+// cronHosts returns the host specified by --host, or every host in the
+// cluster.
+func cronHosts(c *cli.Context) ([]client.Host, error) {
+	if c.IsSet("host") {
+		h, err := selectHost(c)
+		return []client.Host{h}, err
+	}
+
+	var (
+		hosts []client.Host
+		it    = node.Ls(c.Context)
+	)
+
+	for it.Next() {
+		hosts = append(hosts, it.Anchor().(client.Host))
+	}
+
+	return hosts, it.Err()
+}
+
+func lsCron(c *cli.Context, h client.Host) ([]cron.Entry, error) {
+	t, release, err := h.Cron(c.Context)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return t.Ls(c.Context)
+}
+
+func missedRuns(c *cli.Context) (cron.MissedRuns, error) {
+	switch c.String("missed") {
+	case "skip":
+		return cron.Skip, nil
+	case "run-once":
+		return cron.RunOnce, nil
+	case "run-all":
+		return cron.RunAll, nil
+	}
+
+	return 0, fmt.Errorf("invalid missed-run policy: %s", c.String("missed"))
+}
@@ -0,0 +1,258 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/urfave/cli/v2"
+	"github.com/wetware/ww/pkg/cap/proc"
+	"github.com/wetware/ww/pkg/cap/sched"
+)
+
+// ww client job submit --replicas 3 --label zone=us-east -- ./worker
+func Job() *cli.Command {
+	return &cli.Command{
+		Name:  "job",
+		Usage: "schedule jobs on the cluster",
+		Description: `Jobs are placed on hosts that were started with --enable-exec.
+
+Each host schedules the jobs submitted to it independently.  Jobs
+submitted to different hosts may together exceed a host's advertised
+capacity, and a job is lost if the host to which it was submitted
+exits.  Submit related jobs to the same host with --host, and use
+--label selectors to restrict jobs to exec-enabled hosts.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "host",
+				Usage: "use the scheduler of the host with peer `ID` (default: random)",
+			},
+		},
+		Subcommands: []*cli.Command{
+			{
+				Name:      "submit",
+				Usage:     "submit a job to the scheduler",
+				ArgsUsage: "-- <cmd> [args...]",
+				Flags: append([]cli.Flag{
+					&cli.StringFlag{
+						Name:  "name",
+						Usage: "job `NAME` (default: assigned by the scheduler)",
+					},
+				}, jobFlags...),
+				Action: submit(),
+			},
+			{
+				Name:   "ls",
+				Usage:  "list jobs",
+				Action: jobs(),
+			},
+			{
+				Name:      "status",
+				Usage:     "show the replicas of a job",
+				ArgsUsage: "<name>",
+				Action:    status(),
+			},
+			{
+				Name:      "stop",
+				Usage:     "stop a job and kill its replicas",
+				ArgsUsage: "<name>",
+				Action:    stop(),
+			},
+		},
+	}
+}
+
+// jobFlags describe a job spec.  They are shared by commands that
+// submit jobs.
+var jobFlags = []cli.Flag{
+	&cli.UintFlag{
+		Name:    "replicas",
+		Aliases: []string{"n"},
+		Usage:   "run `N` replicas of the job",
+		Value:   1,
+	},
+	&cli.StringSliceFlag{
+		Name:  "label",
+		Usage: "place replicas on hosts labelled `KEY=VALUE`",
+	},
+	&cli.StringFlag{
+		Name:  "dir",
+		Usage: "remote working `DIR`",
+	},
+	&cli.StringSliceFlag{
+		Name:    "env",
+		Aliases: []string{"e"},
+		Usage:   "set remote environment variable `KEY=VALUE`",
+	},
+	&cli.Float64Flag{
+		Name:  "cpu",
+		Usage: "reserve `N` CPUs for each replica",
+	},
+	&cli.StringFlag{
+		Name:  "memory",
+		Usage: "reserve `SIZE` bytes of memory for each replica, e.g. 512MiB",
+	},
+	&cli.UintFlag{
+		Name:  "pids",
+		Usage: "limit each replica to `N` tasks",
+	},
+}
+
+func submit() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		spec, err := jobSpec(c)
+		if err != nil {
+			return err
+		}
+
+		s, release, err := scheduler(c)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		j, release := s.Submit(c.Context, spec)
+		defer release()
+
+		name, err := j.Name(c.Context)
+		if err == nil {
+			fmt.Fprintln(c.App.Writer, name)
+		}
+
+		return err
+	}
+}
+
+// jobSpec returns the job described by the command line.  See jobFlags.
+func jobSpec(c *cli.Context) (sched.JobSpec, error) {
+	if !c.Args().Present() {
+		return sched.JobSpec{}, errors.New("must provide a command to run")
+	}
+
+	selector, err := selector(c)
+	if err != nil {
+		return sched.JobSpec{}, err
+	}
+
+	requests, err := limits(c)
+	if err != nil {
+		return sched.JobSpec{}, err
+	}
+
+	return sched.JobSpec{
+		Name: c.String("name"),
+		Command: &proc.Command{
+			Path: c.Args().First(),
+			Args: c.Args().Tail(),
+			Env:  c.StringSlice("env"),
+			Dir:  c.String("dir"),
+		},
+		Requests: requests,
+		Selector: selector,
+		Replicas: int(c.Uint("replicas")),
+	}, nil
+}
+
+func jobs() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		s, release, err := scheduler(c)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		names, err := s.Ls(c.Context)
+		for _, name := range names {
+			fmt.Fprintln(c.App.Writer, name)
+		}
+
+		return err
+	}
+}
+
+func status() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		j, release, err := lookupJob(c)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		rs, err := j.Status(c.Context)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(c.App.Writer, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "REPLICA\tSTATE\tHOST\tPLACEMENTS\tEXIT")
+		for i, r := range rs {
+			exit := "-"
+			if r.State == sched.Exited {
+				exit = fmt.Sprint(r.ExitCode)
+			}
+
+			fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\n", i, r.State, r.Host, r.Placements, exit)
+		}
+
+		return w.Flush()
+	}
+}
+
+func stop() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		j, release, err := lookupJob(c)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return j.Stop(c.Context)
+	}
+}
+
+func scheduler(c *cli.Context) (sched.Scheduler, capnp.ReleaseFunc, error) {
+	h, err := selectHost(c)
+	if err != nil {
+		return sched.Scheduler{}, nil, err
+	}
+
+	return h.Scheduler(c.Context)
+}
+
+func lookupJob(c *cli.Context) (sched.Job, capnp.ReleaseFunc, error) {
+	if c.Args().Len() != 1 {
+		return sched.Job{}, nil, errors.New("must provide a job name")
+	}
+
+	s, release, err := scheduler(c)
+	if err != nil {
+		return sched.Job{}, nil, err
+	}
+
+	j, done := s.Lookup(c.Context, c.Args().First())
+	return j, func() {
+		done()
+		release()
+	}, nil
+}
+
+func selector(c *cli.Context) (map[string]string, error) {
+	labels := c.StringSlice("label")
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	sel := make(map[string]string, len(labels))
+	for _, label := range labels {
+		k, v, ok := strings.Cut(label, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid label: %s", label)
+		}
+
+		sel[k] = v
+	}
+
+	return sel, nil
+}
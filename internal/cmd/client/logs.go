@@ -0,0 +1,81 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/urfave/cli/v2"
+	"github.com/wetware/ww/pkg/client"
+)
+
+// ww client logs -f <peer>/<proc>
+func Logs() *cli.Command {
+	return &cli.Command{
+		Name:      "logs",
+		Usage:     "print the output of a process",
+		ArgsUsage: "<peer>/<proc>",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:    "follow",
+				Aliases: []string{"f"},
+				Usage:   "stream output until the process exits",
+			},
+			&cli.UintFlag{
+				Name:    "tail",
+				Aliases: []string{"n"},
+				Usage:   "print the last `N` lines (0 = all)",
+			},
+		},
+		Action: logs(),
+	}
+}
+
+func logs() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		if c.Args().Len() != 1 {
+			return errors.New("must provide a process path")
+		}
+
+		id, proc, err := procPath(c.Args().First())
+		if err != nil {
+			return err
+		}
+
+		h := node.Walk(c.Context, []string{id.String()}).(client.Host)
+
+		p, release, err := h.Process(c.Context, proc)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return p.Logs(c.Context,
+			c.Bool("follow"),
+			int(c.Uint("tail")),
+			c.App.Writer,
+			c.App.ErrWriter)
+	}
+}
+
+// procPath parses a process path, which is either <peer>/<proc>, or
+// the full anchor path /<peer>/proc/<proc>.
+func procPath(s string) (peer.ID, string, error) {
+	parts := strings.Split(strings.TrimPrefix(path.Clean("/"+s), "/"), "/")
+	if len(parts) == 3 && parts[1] == "proc" {
+		parts = []string{parts[0], parts[2]}
+	}
+
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid process path: %s", s)
+	}
+
+	id, err := peer.Decode(parts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid host: %w", err)
+	}
+
+	return id, parts[1], nil
+}
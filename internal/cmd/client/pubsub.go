@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/urfave/cli/v2"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
 	"github.com/wetware/ww/pkg/client"
 )
 
@@ -38,6 +39,31 @@ func Subscribe() *cli.Command {
 				Usage:    "pubsub topic",
 				Required: true,
 			},
+			&cli.IntFlag{
+				Name:  "buffer",
+				Usage: "number of messages to buffer locally",
+				Value: 32,
+			},
+			&cli.UintFlag{
+				Name:        "inflight",
+				Usage:       "max number of unacknowledged messages",
+				DefaultText: "host default",
+			},
+			&cli.StringFlag{
+				Name:  "policy",
+				Usage: "overflow `policy`: block, drop-oldest or drop-newest",
+				Value: "block",
+			},
+			&cli.UintFlag{
+				Name:  "batch",
+				Usage: "max number of messages per delivery",
+				Value: 1,
+			},
+			&cli.DurationFlag{
+				Name:        "batch-latency",
+				Usage:       "max time a partial batch is held back",
+				DefaultText: "host default",
+			},
 		},
 		Action: subscribe(),
 	}
@@ -69,10 +95,15 @@ func subscribe() cli.ActionFunc {
 			msg []byte
 		)
 
+		opts, err := subOpts(c)
+		if err != nil {
+			return err
+		}
+
 		t := node.Join(c.Context, c.String("topic"))
 		defer t.Release()
 
-		sub, err = t.Subscribe(c.Context)
+		sub, err = t.Subscribe(c.Context, opts...)
 		if err != nil {
 			return
 		}
@@ -91,6 +122,38 @@ func subscribe() cli.ActionFunc {
 			err = nil
 		}
 
+		if n := sub.Dropped(); n > 0 {
+			fmt.Fprintf(c.App.ErrWriter, "dropped %d messages\n", n)
+		}
+
 		return err
 	}
 }
+
+func subOpts(c *cli.Context) ([]client.SubOpt, error) {
+	policy, err := overflowPolicy(c.String("policy"))
+	if err != nil {
+		return nil, err
+	}
+
+	return []client.SubOpt{
+		client.WithBufferSize(c.Int("buffer")),
+		client.WithMaxInflight(uint32(c.Uint("inflight"))),
+		client.WithOverflowPolicy(policy),
+		client.WithBatching(uint32(c.Uint("batch")), c.Duration("batch-latency")),
+	}, nil
+}
+
+func overflowPolicy(s string) (pscap.Policy, error) {
+	for _, p := range []pscap.Policy{
+		pscap.Block,
+		pscap.DropOldest,
+		pscap.DropNewest,
+	} {
+		if s == p.String() {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid overflow policy '%s'", s)
+}
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"os/signal"
+	"path"
+	"syscall"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/urfave/cli/v2"
+	"github.com/wetware/ww/pkg/cap/proc"
+	"github.com/wetware/ww/pkg/client"
+)
+
+// ww client run --host <peer> -- ls -la /tmp
+func Run() *cli.Command {
+	return &cli.Command{
+		Name:  "run",
+		Usage: "run a command on a cluster host",
+		Description: `Runs a command on the host with the supplied peer ID.  The host must
+have been started with --enable-exec.`,
+		ArgsUsage: "-- <cmd> [args...]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "host",
+				Usage: "run on the host with peer `ID`",
+			},
+			&cli.StringFlag{
+				Name:  "selector",
+				Usage: "run on a random host whose peer ID matches `GLOB`",
+				Value: "*",
+			},
+			&cli.StringFlag{
+				Name:  "dir",
+				Usage: "remote working `DIR`",
+			},
+			&cli.StringSliceFlag{
+				Name:    "env",
+				Aliases: []string{"e"},
+				Usage:   "set remote environment variable `KEY=VALUE`",
+			},
+			&cli.BoolFlag{
+				Name:    "no-stdin",
+				Aliases: []string{"n"},
+				Usage:   "do not forward stdin to the remote process",
+			},
+			&cli.StringFlag{
+				Name:  "restart",
+				Usage: "restart `POLICY` (never, on-failure, always)",
+				Value: "never",
+			},
+			&cli.DurationFlag{
+				Name:  "backoff",
+				Usage: "initial delay before restarting a failed process",
+				Value: time.Second,
+			},
+			&cli.UintFlag{
+				Name:  "max-restarts",
+				Usage: "restart the process at most `N` times (0 = unlimited)",
+			},
+			&cli.Float64Flag{
+				Name:  "cpu",
+				Usage: "limit the process to `N` CPUs",
+			},
+			&cli.StringFlag{
+				Name:  "memory",
+				Usage: "limit the process to `SIZE` bytes of memory, e.g. 512MiB",
+			},
+			&cli.UintFlag{
+				Name:  "pids",
+				Usage: "limit the process to `N` tasks",
+			},
+		},
+		Action: run(),
+	}
+}
+
+func run() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		if !c.Args().Present() {
+			return errors.New("must provide a command to run")
+		}
+
+		restart, err := restartPolicy(c)
+		if err != nil {
+			return err
+		}
+
+		limits, err := limits(c)
+		if err != nil {
+			return err
+		}
+
+		h, err := selectHost(c)
+		if err != nil {
+			return err
+		}
+
+		p, release, err := h.Exec(c.Context, proc.Command{
+			Path:    c.Args().First(),
+			Args:    c.Args().Tail(),
+			Env:     c.StringSlice("env"),
+			Dir:     c.String("dir"),
+			Restart: restart,
+			Limits:  limits,
+		}, c.App.Writer, c.App.ErrWriter)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		if !c.Bool("no-stdin") {
+			go forwardStdin(c.Context, c.App.Reader, p)
+		}
+
+		stop := forwardSignals(c.Context, p)
+		defer stop()
+
+		status, err := p.WaitStatus(c.Context)
+		if err != nil {
+			return err
+		}
+
+		if status.OOMKilled {
+			fmt.Fprintln(c.App.ErrWriter, "process killed: out of memory")
+		}
+
+		if status.Code != 0 {
+			return cli.Exit("", status.Code)
+		}
+
+		return nil
+	}
+}
+
+func restartPolicy(c *cli.Context) (proc.RestartPolicy, error) {
+	policy := proc.RestartPolicy{
+		Backoff:     c.Duration("backoff"),
+		MaxRestarts: uint32(c.Uint("max-restarts")),
+	}
+
+	switch c.String("restart") {
+	case "never":
+		policy.Mode = proc.Never
+	case "on-failure":
+		policy.Mode = proc.OnFailure
+	case "always":
+		policy.Mode = proc.Always
+	default:
+		return policy, fmt.Errorf("invalid restart policy: %s", c.String("restart"))
+	}
+
+	return policy, nil
+}
+
+func limits(c *cli.Context) (proc.Limits, error) {
+	limits := proc.Limits{
+		CPU:  c.Float64("cpu"),
+		PIDs: uint32(c.Uint("pids")),
+	}
+
+	if c.IsSet("memory") {
+		mem, err := humanize.ParseBytes(c.String("memory"))
+		if err != nil {
+			return limits, fmt.Errorf("invalid memory limit: %w", err)
+		}
+
+		limits.Memory = mem
+	}
+
+	return limits, nil
+}
+
+func selectHost(c *cli.Context) (client.Host, error) {
+	if c.IsSet("host") {
+		id, err := peer.Decode(c.String("host"))
+		if err != nil {
+			return client.Host{}, fmt.Errorf("invalid host: %w", err)
+		}
+
+		return node.Walk(c.Context, []string{id.String()}).(client.Host), nil
+	}
+
+	var (
+		hosts []client.Host
+		glob  = c.String("selector")
+		it    = node.Ls(c.Context)
+	)
+
+	if glob == "" {
+		glob = "*"
+	}
+
+	for it.Next() {
+		h := it.Anchor().(client.Host)
+
+		ok, err := path.Match(glob, h.ID().String())
+		if err != nil {
+			return client.Host{}, fmt.Errorf("invalid selector: %w", err)
+		}
+
+		if ok {
+			hosts = append(hosts, h)
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		return client.Host{}, err
+	}
+
+	if len(hosts) == 0 {
+		return client.Host{}, errors.New("no matching hosts")
+	}
+
+	rand.Seed(time.Now().UnixNano())
+	return hosts[rand.Intn(len(hosts))], nil
+}
+
+func forwardStdin(ctx context.Context, r io.Reader, p proc.Process) {
+	stdin := p.Stdin(ctx)
+	defer stdin.Close()
+
+	io.Copy(stdin, r)
+}
+
+func forwardSignals(ctx context.Context, p proc.Process) (stop func()) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGHUP,
+		syscall.SIGQUIT)
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		for {
+			select {
+			case sig := <-sigs:
+				_ = p.Kill(ctx, sig.(syscall.Signal))
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(sigs)
+		cancel()
+	}
+}
@@ -3,17 +3,19 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/host"
-	libp2pquic "github.com/libp2p/go-libp2p-quic-transport"
 	"github.com/lthibault/log"
 	"github.com/urfave/cli/v2"
 	"github.com/wetware/casm/pkg/boot"
 	bootutil "github.com/wetware/ww/internal/util/boot"
+	identityutil "github.com/wetware/ww/internal/util/identity"
 	logutil "github.com/wetware/ww/internal/util/log"
+	transportutil "github.com/wetware/ww/internal/util/transport"
 	"github.com/wetware/ww/pkg/client"
 	"github.com/wetware/ww/pkg/vat"
 	"go.uber.org/fx"
@@ -55,10 +57,20 @@ func logger(c *cli.Context) log.Logger {
 }
 
 func localhost(c *cli.Context, lx fx.Lifecycle) (host.Host, error) {
+	identity, err := identityutil.Option(c, "client.key")
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := transports(c)
+	if err != nil {
+		return nil, err
+	}
+
 	h, err := libp2p.New(
-		libp2p.NoTransports,
-		libp2p.NoListenAddrs,
-		libp2p.Transport(libp2pquic.NewTransport))
+		identity,
+		transport,
+		libp2p.NoListenAddrs)
 	if err == nil {
 		lx.Append(closer(h))
 	}
@@ -66,12 +78,35 @@ func localhost(c *cli.Context, lx fx.Lifecycle) (host.Host, error) {
 	return h, err
 }
 
-func dialer(c *cli.Context, h host.Host, lx fx.Lifecycle) (d client.Dialer, err error) {
+// transports returns the transports needed to reach the static
+// bootstrap addresses.  Peers found via discovery may be reachable
+// over any transport, so all of them are enabled in that case.
+func transports(c *cli.Context) (libp2p.Option, error) {
+	if !c.IsSet("addr") {
+		return transportutil.All(c).Option(c)
+	}
+
+	ts, err := transportutil.Parse(c.StringSlice("addr"))
+	if err != nil {
+		return nil, fmt.Errorf("addr: %w", err)
+	}
+
+	return ts.Option(c)
+}
+
+func dialer(c *cli.Context, h host.Host, log log.Logger, lx fx.Lifecycle) (d client.Dialer, err error) {
 	d.Vat = vat.Network{
 		NS:   c.String("ns"),
 		Host: h,
 	}
 
+	if c.Bool("reconnect") {
+		d.Reconnect = &client.ReconnectPolicy{
+			Timeout: c.Duration("timeout"),
+			OnEvent: reconnectLogger(log),
+		}
+	}
+
 	if c.IsSet("addr") {
 		d.Boot, err = boot.NewStaticAddrStrings(c.StringSlice("addr")...)
 		return
@@ -101,6 +136,21 @@ func dial(d client.Dialer, lx fx.Lifecycle) {
 	})
 }
 
+func reconnectLogger(log log.Logger) func(client.ReconnectEvent) {
+	return func(ev client.ReconnectEvent) {
+		switch ev.Type {
+		case client.Disconnected:
+			log.WithField("peer", ev.Peer).Warn(ev.Type)
+		case client.ReconnectFailed:
+			log.WithError(ev.Err).WithField("attempt", ev.Attempt).Debug(ev.Type)
+		case client.Reconnected:
+			log.WithField("peer", ev.Peer).Info(ev.Type)
+		case client.GaveUp:
+			log.WithField("attempts", ev.Attempt).Error(ev.Type)
+		}
+	}
+}
+
 // discoveryFields reports the bootstrap multiaddr(s).
 func discoveryFields(c *cli.Context) log.F {
 	if c.String("discover") != "" {
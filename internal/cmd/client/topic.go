@@ -0,0 +1,83 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+func Topics() *cli.Command {
+	return &cli.Command{
+		Name:   "topics",
+		Usage:  "list pubsub topics joined by the host",
+		Action: topics(),
+	}
+}
+
+func Topic() *cli.Command {
+	return &cli.Command{
+		Name:  "topic",
+		Usage: "inspect pubsub topics",
+		Subcommands: []*cli.Command{
+			{
+				Name:      "info",
+				Usage:     "print subscribers and peers for a topic joined by the host",
+				ArgsUsage: "<name>",
+				Action:    topicInfo(),
+			},
+		},
+	}
+}
+
+func topics() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		ts, err := node.Topics(c.Context)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range ts {
+			fmt.Fprintf(c.App.Writer, "%s\t%d\n", t.Name, t.Subscribers)
+		}
+
+		return nil
+	}
+}
+
+func topicInfo() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		name := c.Args().First()
+		if name == "" {
+			return errors.New("missing topic name")
+		}
+
+		// Fails if the host has not joined the topic.
+		peers, err := node.TopicPeers(c.Context, name)
+		if err != nil {
+			return err
+		}
+
+		ts, err := node.Topics(c.Context)
+		if err != nil {
+			return err
+		}
+
+		var subs int
+		for _, t := range ts {
+			if t.Name == name {
+				subs = t.Subscribers
+				break
+			}
+		}
+
+		fmt.Fprintf(c.App.Writer, "topic:       %s\n", name)
+		fmt.Fprintf(c.App.Writer, "subscribers: %d\n", subs)
+		fmt.Fprintf(c.App.Writer, "peers:       %d\n", len(peers))
+		for _, id := range peers {
+			fmt.Fprintf(c.App.Writer, "  %s\n", id)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package keygen
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/urfave/cli/v2"
+
+	identityutil "github.com/wetware/ww/internal/util/identity"
+)
+
+var subcommands = []*cli.Command{
+	PSK(),
+	Identity(),
+}
+
+func Command() *cli.Command {
+	return &cli.Command{
+		Name:        "keygen",
+		Usage:       "generate keys",
+		Subcommands: subcommands,
+	}
+}
+
+func PSK() *cli.Command {
+	return &cli.Command{
+		Name:  "psk",
+		Usage: "generate a pre-shared key for a private network",
+		Description: `Generates a swarm key, which is passed to 'ww start' and 'ww client'
+via --swarm-key.  Only hosts and clients that share the key can
+connect to each other.`,
+		Flags: []cli.Flag{
+			&cli.PathFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "write key to `FILE` (default: stdout)",
+			},
+		},
+		Action: psk(),
+	}
+}
+
+func psk() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return err
+		}
+
+		return output(c, func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "/key/swarm/psk/1.0.0/\n/base16/\n%s\n",
+				hex.EncodeToString(key))
+			return err
+		})
+	}
+}
+
+func Identity() *cli.Command {
+	return &cli.Command{
+		Name:  "identity",
+		Usage: "generate a private key for a host or client",
+		Description: `Generates a private key and stores it in FILE, which is passed to
+'ww start' or 'ww client' via --identity.  The peer ID derived from
+the key is printed to stdout.`,
+		Flags: []cli.Flag{
+			&cli.PathFlag{
+				Name:     "output",
+				Aliases:  []string{"o"},
+				Usage:    "write key to `FILE`",
+				Required: true,
+			},
+		},
+		Action: identity(),
+	}
+}
+
+func identity() cli.ActionFunc {
+	return func(c *cli.Context) error {
+		key, err := identityutil.Generate()
+		if err != nil {
+			return err
+		}
+
+		if err = identityutil.Store(c.Path("output"), key); err != nil {
+			return err
+		}
+
+		id, err := peer.IDFromPrivateKey(key)
+		if err == nil {
+			_, err = fmt.Fprintln(c.App.Writer, id)
+		}
+
+		return err
+	}
+}
+
+// output calls write with the file specified by the 'output' flag,
+// which is created with owner-only permissions, or with stdout.
+func output(c *cli.Context, write func(io.Writer) error) error {
+	if !c.IsSet("output") {
+		return write(c.App.Writer)
+	}
+
+	f, err := os.OpenFile(c.Path("output"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err = write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -1,8 +1,12 @@
 package start
 
 import (
+	goruntime "runtime"
+
 	"github.com/urfave/cli/v2"
 	"github.com/wetware/ww/internal/runtime"
+	"github.com/wetware/ww/pkg/cap/proc"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
 )
 
 var flags = []cli.Flag{
@@ -15,12 +19,32 @@ var flags = []cli.Flag{
 	&cli.StringSliceFlag{
 		Name:    "listen",
 		Aliases: []string{"a"},
-		Usage:   "host listen address",
+		Usage:   "host listen `ADDR`, using QUIC, TCP or WebSocket",
 		Value: cli.NewStringSlice(
 			"/ip4/0.0.0.0/udp/0/quic",
 			"/ip6/::0/udp/0/quic"),
 		EnvVars: []string{"WW_LISTEN"},
 	},
+	&cli.BoolFlag{
+		Name:    "autonat",
+		Usage:   "help other hosts determine their reachability, and map ports on the local NAT",
+		EnvVars: []string{"WW_AUTONAT"},
+	},
+	&cli.BoolFlag{
+		Name:    "hole-punching",
+		Usage:   "upgrade relayed connections to direct ones by hole punching",
+		EnvVars: []string{"WW_HOLE_PUNCHING"},
+	},
+	&cli.BoolFlag{
+		Name:    "relay-service",
+		Usage:   "act as a circuit relay for hosts behind a NAT",
+		EnvVars: []string{"WW_RELAY_SERVICE"},
+	},
+	&cli.StringSliceFlag{
+		Name:    "relay",
+		Usage:   "reserve a slot on the relay at `ADDR` when behind a NAT",
+		EnvVars: []string{"WW_RELAY"},
+	},
 	&cli.StringSliceFlag{
 		Name:    "join",
 		Usage:   "join cluster through current member address",
@@ -33,6 +57,76 @@ var flags = []cli.Flag{
 		Value:   "/ip4/228.8.8.8/udp/8822/multicast/lo0",
 		EnvVars: []string{"WW_DISCOVER"},
 	},
+	&cli.PathFlag{
+		Name:        "identity",
+		Usage:       "load the host's private key from `FILE`, creating it if needed",
+		DefaultText: "<data>/identity.key, or random",
+		EnvVars:     []string{"WW_IDENTITY"},
+	},
+	&cli.PathFlag{
+		Name:    "swarm-key",
+		Usage:   "join the private network whose pre-shared key is in `FILE`",
+		EnvVars: []string{"WW_SWARM_KEY"},
+	},
+	&cli.StringFlag{
+		Name:    "authorized-keys",
+		Usage:   "only admit the peer IDs listed in `FILE`, including other hosts",
+		EnvVars: []string{"WW_AUTHORIZED_KEYS"},
+	},
+	&cli.BoolFlag{
+		Name:    "enable-exec",
+		Usage:   "allow peers to run processes on the host (requires --authorized-keys)",
+		EnvVars: []string{"WW_ENABLE_EXEC"},
+	},
+	&cli.StringFlag{
+		Name:    "reserved-prefix",
+		Usage:   "topic `PREFIX` that clients are not permitted to join",
+		Value:   pscap.DefaultReservedPrefix,
+		EnvVars: []string{"WW_RESERVED_PREFIX"},
+	},
+	&cli.DurationFlag{
+		Name:    "proc-retention",
+		Usage:   "keep exited processes in the anchor tree for `DURATION` (0 = remove, <0 = forever)",
+		Value:   proc.DefaultRetention,
+		EnvVars: []string{"WW_PROC_RETENTION"},
+	},
+	&cli.StringFlag{
+		Name:    "proc-log-size",
+		Usage:   "retain `SIZE` bytes of output per process, e.g. 1MiB (0 = none)",
+		Value:   "1MiB",
+		EnvVars: []string{"WW_PROC_LOG_SIZE"},
+	},
+	&cli.BoolFlag{
+		Name:    "persist-logs",
+		Usage:   "keep the output of exited processes in the datastore",
+		EnvVars: []string{"WW_PERSIST_LOGS"},
+	},
+	&cli.StringFlag{
+		Name:    "cgroup",
+		Usage:   "empty cgroup v2 `DIR` in which to enforce process limits",
+		EnvVars: []string{"WW_CGROUP"},
+	},
+	&cli.StringSliceFlag{
+		Name:    "preopen-root",
+		Usage:   "allow WASI modules to access host directories beneath `DIR`",
+		EnvVars: []string{"WW_PREOPEN_ROOT"},
+	},
+	&cli.StringSliceFlag{
+		Name:    "label",
+		Usage:   "label the host with `KEY=VALUE`, for use in job selectors",
+		EnvVars: []string{"WW_LABEL"},
+	},
+	&cli.Float64Flag{
+		Name:    "capacity-cpu",
+		Usage:   "CPUs available to scheduled jobs (0 = unlimited)",
+		Value:   float64(goruntime.NumCPU()),
+		EnvVars: []string{"WW_CAPACITY_CPU"},
+	},
+	&cli.StringFlag{
+		Name:    "capacity-memory",
+		Usage:   "memory available to scheduled jobs, e.g. 4GiB (default: unlimited)",
+		EnvVars: []string{"WW_CAPACITY_MEMORY"},
+	},
 }
 
 // Command constructor
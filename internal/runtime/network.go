@@ -18,7 +18,7 @@ import (
 	dht "github.com/libp2p/go-libp2p-kad-dht"
 	"github.com/libp2p/go-libp2p-kad-dht/dual"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
-	libp2pquic "github.com/libp2p/go-libp2p-quic-transport"
+	"github.com/libp2p/go-libp2p/p2p/host/autorelay"
 	routedhost "github.com/libp2p/go-libp2p/p2p/host/routed"
 
 	"github.com/lthibault/log"
@@ -29,7 +29,10 @@ import (
 	"github.com/wetware/casm/pkg/pex"
 	protoutil "github.com/wetware/casm/pkg/util/proto"
 	bootutil "github.com/wetware/ww/internal/util/boot"
+	identityutil "github.com/wetware/ww/internal/util/identity"
+	pnetutil "github.com/wetware/ww/internal/util/pnet"
 	statsdutil "github.com/wetware/ww/internal/util/statsd"
+	transportutil "github.com/wetware/ww/internal/util/transport"
 	ww "github.com/wetware/ww/pkg"
 	"github.com/wetware/ww/pkg/vat"
 )
@@ -58,14 +61,93 @@ func routing(config routingConfig) (*dual.DHT, error) {
 	return config.NewDHT(h)
 }
 
+// ListenAddrs returns the host's listen addresses.  Private networks
+// listen on TCP by default, since QUIC does not support them.
 func (config routingConfig) ListenAddrs() []string {
+	if pnetutil.Enabled(config.CLI) && !config.CLI.IsSet("listen") {
+		return pnetutil.ListenAddrs
+	}
+
 	return config.CLI.StringSlice("listen")
 }
 
+// Transport enables the transports needed by the listen addresses.
+func (config routingConfig) Transport() (libp2p.Option, error) {
+	ts, err := transportutil.Parse(config.ListenAddrs())
+	if err != nil {
+		return nil, fmt.Errorf("listen: %w", err)
+	}
+
+	return ts.Option(config.CLI)
+}
+
+// NAT returns the options for NAT traversal.  Hosts behind a NAT
+// reserve slots on the relays specified by --relay, and advertise the
+// resulting relay addresses.
+func (config routingConfig) NAT() (libp2p.Option, error) {
+	var opts []libp2p.Option
+
+	if config.CLI.Bool("autonat") {
+		opts = append(opts,
+			libp2p.EnableNATService(),
+			libp2p.NATPortMap())
+	}
+
+	if config.CLI.Bool("hole-punching") {
+		opts = append(opts, libp2p.EnableHolePunching())
+	}
+
+	if config.CLI.Bool("relay-service") {
+		opts = append(opts, libp2p.EnableRelayService())
+	}
+
+	if config.CLI.IsSet("relay") {
+		relays, err := config.Relays()
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, libp2p.EnableAutoRelay(
+			autorelay.WithStaticRelays(relays)))
+	}
+
+	return libp2p.ChainOptions(opts...), nil
+}
+
+func (config routingConfig) Relays() ([]peer.AddrInfo, error) {
+	relays := make([]peer.AddrInfo, 0, len(config.CLI.StringSlice("relay")))
+	for _, s := range config.CLI.StringSlice("relay") {
+		info, err := peer.AddrInfoFromString(s)
+		if err != nil {
+			return nil, fmt.Errorf("relay: %w", err)
+		}
+
+		relays = append(relays, *info)
+	}
+
+	return relays, nil
+}
+
 func (config routingConfig) NewHost() (h host.Host, err error) {
+	identity, err := identityutil.Option(config.CLI, "identity.key")
+	if err != nil {
+		return nil, err
+	}
+
+	transport, err := config.Transport()
+	if err != nil {
+		return nil, err
+	}
+
+	nat, err := config.NAT()
+	if err != nil {
+		return nil, err
+	}
+
 	h, err = libp2p.New(
-		libp2p.NoTransports,
-		libp2p.Transport(libp2pquic.NewTransport),
+		identity,
+		transport,
+		nat,
 		libp2p.ListenAddrStrings(config.ListenAddrs()...),
 		libp2p.BandwidthReporter(config.Metrics))
 	if err == nil {
@@ -122,17 +204,35 @@ type vatConfig struct {
 	Lifecycle fx.Lifecycle
 }
 
-func vatnet(config vatConfig) vat.Network {
+func vatnet(config vatConfig) (vat.Network, error) {
+	admit, err := config.Admission()
 	return vat.Network{
-		NS:   config.Namespace(),
-		Host: routedhost.Wrap(config.Host(), config.DHT),
-	}
+		NS:    config.Namespace(),
+		Host:  routedhost.Wrap(config.Host(), config.DHT),
+		Admit: admit,
+	}, err
 }
 
 func (vat vatConfig) Namespace() string {
 	return vat.CLI.String("ns")
 }
 
+// Admission returns the allowlist specified by --authorized-keys, if
+// any.  The local host is always admitted.
+func (config vatConfig) Admission() (vat.AdmitFunc, error) {
+	if !config.CLI.IsSet("authorized-keys") {
+		return nil, nil
+	}
+
+	a, err := vat.LoadAllowlist(config.CLI.String("authorized-keys"))
+	if err != nil {
+		return nil, fmt.Errorf("authorized keys: %w", err)
+	}
+
+	a[config.Host().ID()] = struct{}{}
+	return a.Admit, nil
+}
+
 func (vat vatConfig) Host() host.Host {
 	return vat.DHT.WAN.Host()
 }
@@ -273,12 +373,9 @@ func (config overlayConfig) bootMatcher() func(string) bool {
 }
 
 func (config overlayConfig) Proto() protocol.ID {
-	// FIXME: For security, the cluster topic should not be present
-	//        in the root pubsub capability server.
-
-	//        The cluster topic should instead be provided as an
-	//        entirely separate capability, negoaiated outside of
-	//        the PubSub cap.
+	// NOTE:  the cluster topic is reserved by the PubSub capability,
+	//        so clients cannot publish to it.  Cluster membership is
+	//        exported separately, via the View capability.
 
 	// /casm/<casm-version>/ww/<version>/<ns>/meshsub/1.1.0
 	return protoutil.Join(
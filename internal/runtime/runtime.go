@@ -5,17 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
+	ds "github.com/ipfs/go-datastore"
+
+	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-kad-dht/dual"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/lthibault/log"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/thejerf/suture/v4"
 	"github.com/urfave/cli/v2"
 	"github.com/wetware/casm/pkg/cluster"
+	"github.com/wetware/casm/pkg/cluster/pulse"
 	"github.com/wetware/casm/pkg/pex"
 	serviceutil "github.com/wetware/ww/internal/util/service"
+	"github.com/wetware/ww/pkg/cap/proc"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
+	"github.com/wetware/ww/pkg/cap/sched"
 	"github.com/wetware/ww/pkg/server"
 	"github.com/wetware/ww/pkg/vat"
 	"go.uber.org/fx"
@@ -154,6 +165,7 @@ type serverConfig struct {
 	PubSub *pubsub.PubSub
 	PeX    *pex.PeerExchange
 	DHT    *dual.DHT
+	Store  ds.Batching
 
 	Lifecycle fx.Lifecycle
 }
@@ -170,9 +182,12 @@ func (config serverConfig) MergeStrategy() mergeFromPeX {
 	}
 }
 
-func (config serverConfig) ClusterOpts() []cluster.Option {
+func (config serverConfig) ClusterOpts(info sched.HostInfo) []cluster.Option {
 	return []cluster.Option{
-		cluster.WithMeta(nil)}
+		cluster.WithMeta(hostMeta{
+			info: info,
+			host: config.Vat.Host,
+		})}
 }
 
 func (config serverConfig) SetCloser(c io.Closer) {
@@ -180,10 +195,30 @@ func (config serverConfig) SetCloser(c io.Closer) {
 }
 
 func node(c *cli.Context, config serverConfig) (*server.Node, error) {
+	// The executor, scheduler and cron services run arbitrary code
+	// on behalf of any peer that is admitted by the host.
+	if c.Bool("enable-exec") && !c.IsSet("authorized-keys") {
+		return nil, errors.New("--enable-exec requires --authorized-keys")
+	}
+
+	info, err := hostInfo(c)
+	if err != nil {
+		return nil, err
+	}
+
+	procOpts, err := procOptions(c, config.Store)
+	if err != nil {
+		return nil, err
+	}
+
 	n, err := server.New(c.Context, config.Vat, config.PubSub,
 		server.WithLogger(config.Logger()),
 		server.WithMerge(config.MergeStrategy()),
-		server.WithClusterConfig(config.ClusterOpts()...))
+		server.WithClusterConfig(config.ClusterOpts(info)...),
+		server.WithPubSubConfig(
+			pscap.WithReservedPrefix(c.String("reserved-prefix"))),
+		server.WithExec(c.Bool("enable-exec")),
+		server.WithProcConfig(procOpts...))
 
 	if err == nil {
 		config.SetCloser(n)
@@ -192,6 +227,83 @@ func node(c *cli.Context, config serverConfig) (*server.Node, error) {
 	return n, err
 }
 
+// ShimArg is passed as the first argument to the ww binary when it is
+// re-executed to hold a process until it has been added to its cgroup.
+// See proc.Shim.
+const ShimArg = "__shim"
+
+func procOptions(c *cli.Context, store ds.Datastore) ([]proc.Option, error) {
+	size, err := humanize.ParseBytes(c.String("proc-log-size"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid log size: %w", err)
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []proc.Option{
+		proc.WithRetention(c.Duration("proc-retention")),
+		proc.WithEventHook(serviceutil.NewEventHook(c)),
+		proc.WithCgroup(c.String("cgroup")),
+		proc.WithShim(self, ShimArg),
+		proc.WithPreopenRoots(c.StringSlice("preopen-root")...),
+		proc.WithLogSize(int(size)),
+	}
+
+	if c.Bool("persist-logs") {
+		opts = append(opts, proc.WithLogStore(store))
+	}
+
+	return opts, nil
+}
+
+// hostInfo is published in the host's heartbeats, and is used by the
+// scheduler to place jobs.
+func hostInfo(c *cli.Context) (info sched.HostInfo, err error) {
+	info.CPU = c.Float64("capacity-cpu")
+
+	if c.IsSet("capacity-memory") {
+		if info.Memory, err = humanize.ParseBytes(c.String("capacity-memory")); err != nil {
+			return info, fmt.Errorf("invalid memory capacity: %w", err)
+		}
+	}
+
+	for _, label := range c.StringSlice("label") {
+		k, v, ok := strings.Cut(label, "=")
+		if !ok || k == "" {
+			return info, fmt.Errorf("invalid label: %s", label)
+		}
+
+		if info.Labels == nil {
+			info.Labels = make(map[string]string)
+		}
+		info.Labels[k] = v
+	}
+
+	return info, nil
+}
+
+// hostMeta publishes the host info in each heartbeat, along with the
+// host's relay addresses, which change as relay reservations are
+// obtained and lost.
+type hostMeta struct {
+	info sched.HostInfo
+	host host.Host
+}
+
+func (m hostMeta) Prepare(hb pulse.Heartbeat) {
+	info := m.info
+	for _, addr := range m.host.Addrs() {
+		if _, err := addr.ValueForProtocol(ma.P_CIRCUIT); err == nil {
+			info.RelayAddrs = append(info.RelayAddrs, addr)
+		}
+	}
+
+	info.Prepare(hb)
+}
+
 type mergeFromPeX struct {
 	ns  string
 	pex *pex.PeerExchange
@@ -0,0 +1,105 @@
+// Package identityutil persists the private keys from which libp2p
+// peer IDs are derived.
+package identityutil
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/urfave/cli/v2"
+)
+
+// Path returns the location of the identity file.  This is the value
+// of the 'identity' flag, if set.  Otherwise, if the 'data' flag is
+// set, it is the file with the supplied name in the data directory.
+// If neither flag is set, Path returns the empty string.
+func Path(c *cli.Context, name string) string {
+	if c.IsSet("identity") {
+		return c.Path("identity")
+	}
+
+	if c.IsSet("data") {
+		return filepath.Join(c.Path("data"), name)
+	}
+
+	return ""
+}
+
+// Option returns a libp2p option that sets the host's identity to
+// the key stored at Path, which is created if it does not exist.  If
+// Path is empty, a random identity is used.
+func Option(c *cli.Context, name string) (libp2p.Option, error) {
+	path := Path(c, name)
+	if path == "" {
+		return libp2p.RandomIdentity, nil
+	}
+
+	key, err := LoadOrCreate(path)
+	if err != nil {
+		return nil, fmt.Errorf("identity: %w", err)
+	}
+
+	return libp2p.Identity(key), nil
+}
+
+// LoadOrCreate loads the private key stored at path.  If the file
+// does not exist, a new key is generated and stored there.
+func LoadOrCreate(path string) (crypto.PrivKey, error) {
+	key, err := Load(path)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return key, err
+	}
+
+	if key, err = Generate(); err == nil {
+		err = Store(path, key)
+	}
+
+	return key, err
+}
+
+// Load the private key stored at path.
+func Load(path string) (crypto.PrivKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.UnmarshalPrivateKey(b)
+}
+
+// Store the private key at path, which must not exist.  The file is
+// readable only by its owner.
+func Store(path string, key crypto.PrivKey) error {
+	b, err := crypto.MarshalPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Generate a new Ed25519 private key.
+func Generate() (crypto.PrivKey, error) {
+	key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	return key, err
+}
@@ -0,0 +1,116 @@
+package identityutil_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	identityutil "github.com/wetware/ww/internal/util/identity"
+)
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"Unset", nil, ""},
+		{"Data", []string{"-data", "/var/lib/ww"}, "/var/lib/ww/host.key"},
+		{"Identity", []string{"-identity", "/etc/ww/id.key"}, "/etc/ww/id.key"},
+		{"Both", []string{"-data", "/var/lib/ww", "-identity", "/etc/ww/id.key"}, "/etc/ww/id.key"},
+	} {
+		c := newContext(t, tt.args...)
+		assert.Equal(t, tt.want, identityutil.Path(c, "host.key"), tt.name)
+	}
+}
+
+func TestLoadOrCreate(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Create", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "data", "host.key")
+
+		key, err := identityutil.LoadOrCreate(path)
+		require.NoError(t, err, "should create key")
+
+		info, err := os.Stat(path)
+		require.NoError(t, err, "should store key")
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm(),
+			"key should be readable only by its owner")
+
+		loaded, err := identityutil.LoadOrCreate(path)
+		require.NoError(t, err, "should load key")
+		assert.True(t, key.Equals(loaded), "should load stored key")
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "host.key")
+
+		key, err := identityutil.Generate()
+		require.NoError(t, err, "should generate key")
+		require.NoError(t, identityutil.Store(path, key), "should store key")
+		assert.Error(t, identityutil.Store(path, key),
+			"should not overwrite existing key")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "host.key")
+		require.NoError(t, os.WriteFile(path, []byte("not a key"), 0600))
+
+		_, err := identityutil.LoadOrCreate(path)
+		assert.Error(t, err, "should fail to load invalid key")
+
+		b, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "not a key", string(b), "should not replace invalid key")
+	})
+}
+
+func TestOption(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.key")
+	require.NoError(t, os.WriteFile(invalid, []byte("not a key"), 0600))
+
+	for _, tt := range []struct {
+		name string
+		args []string
+		fail bool
+	}{
+		{"Random", nil, false},
+		{"Create", []string{"-identity", filepath.Join(dir, "host.key")}, false},
+		{"Invalid", []string{"-identity", invalid}, true},
+	} {
+		opt, err := identityutil.Option(newContext(t, tt.args...), "host.key")
+		if tt.fail {
+			assert.ErrorContains(t, err, "identity", tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+			assert.NotNil(t, opt, tt.name)
+		}
+	}
+}
+
+func newContext(t *testing.T, args ...string) *cli.Context {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("identity", "", "")
+	fs.String("data", "", "")
+	require.NoError(t, fs.Parse(args), "should parse flags")
+
+	return cli.NewContext(cli.NewApp(), fs, nil)
+}
@@ -0,0 +1,44 @@
+// Package pnetutil configures libp2p private networks, whose members
+// share a secret swarm key.
+package pnetutil
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/libp2p/go-libp2p-core/pnet"
+	"github.com/urfave/cli/v2"
+)
+
+// ListenAddrs are the default listen addresses of private network
+// hosts.  QUIC does not support private networks, so TCP is used.
+var ListenAddrs = []string{
+	"/ip4/0.0.0.0/tcp/0",
+	"/ip6/::0/tcp/0",
+}
+
+// Enabled reports whether a swarm key was specified.
+func Enabled(c *cli.Context) bool {
+	return c.IsSet("swarm-key")
+}
+
+// Load the swarm key from the file specified by the 'swarm-key' flag.
+// It returns nil if the flag is not set.
+func Load(c *cli.Context) (pnet.PSK, error) {
+	if !Enabled(c) {
+		return nil, nil
+	}
+
+	f, err := os.Open(c.String("swarm-key"))
+	if err != nil {
+		return nil, fmt.Errorf("swarm key: %w", err)
+	}
+	defer f.Close()
+
+	psk, err := pnet.DecodeV1PSK(f)
+	if err != nil {
+		return nil, fmt.Errorf("swarm key: %w", err)
+	}
+
+	return psk, nil
+}
@@ -0,0 +1,64 @@
+package pnetutil_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	pnetutil "github.com/wetware/ww/internal/util/pnet"
+)
+
+const swarmKey = "/key/swarm/psk/1.0.0/\n/base16/\n" +
+	"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef\n"
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "swarm.key")
+	require.NoError(t, os.WriteFile(valid, []byte(swarmKey), 0600))
+	invalid := filepath.Join(dir, "invalid.key")
+	require.NoError(t, os.WriteFile(invalid, []byte("not a key"), 0600))
+
+	for _, tt := range []struct {
+		name    string
+		args    []string
+		enabled bool
+		fail    bool
+	}{
+		{"Unset", nil, false, false},
+		{"Valid", []string{"-swarm-key", valid}, true, false},
+		{"Missing", []string{"-swarm-key", filepath.Join(dir, "missing")}, true, true},
+		{"Invalid", []string{"-swarm-key", invalid}, true, true},
+	} {
+		c := newContext(t, tt.args...)
+		assert.Equal(t, tt.enabled, pnetutil.Enabled(c), tt.name)
+
+		psk, err := pnetutil.Load(c)
+		switch {
+		case tt.fail:
+			assert.ErrorContains(t, err, "swarm key", tt.name)
+
+		case tt.enabled:
+			require.NoError(t, err, tt.name)
+			assert.Len(t, psk, 32, tt.name)
+
+		default:
+			require.NoError(t, err, tt.name)
+			assert.Nil(t, psk, tt.name)
+		}
+	}
+}
+
+func newContext(t *testing.T, args ...string) *cli.Context {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("swarm-key", "", "")
+	require.NoError(t, fs.Parse(args), "should parse flags")
+
+	return cli.NewContext(cli.NewApp(), fs, nil)
+}
@@ -0,0 +1,107 @@
+// Package transportutil selects the libp2p transports needed to reach
+// a set of multiaddrs.
+package transportutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p"
+	libp2pquic "github.com/libp2p/go-libp2p-quic-transport"
+	tcp "github.com/libp2p/go-tcp-transport"
+	websocket "github.com/libp2p/go-ws-transport"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/urfave/cli/v2"
+
+	pnetutil "github.com/wetware/ww/internal/util/pnet"
+)
+
+// ErrQUICPrivateNetwork is returned when QUIC addresses are supplied
+// along with a swarm key.
+var ErrQUICPrivateNetwork = errors.New("QUIC does not support private networks")
+
+// Transports is a set of libp2p transports.
+type Transports struct {
+	QUIC, TCP, WebSocket bool
+}
+
+// All transports.  Private networks exclude QUIC.
+func All(c *cli.Context) Transports {
+	return Transports{
+		QUIC:      !pnetutil.Enabled(c),
+		TCP:       true,
+		WebSocket: true,
+	}
+}
+
+// Parse returns the transports needed for the supplied multiaddrs.
+func Parse(addrs []string) (ts Transports, err error) {
+	for _, s := range addrs {
+		var maddr ma.Multiaddr
+		if maddr, err = ma.NewMultiaddr(s); err != nil {
+			return
+		}
+
+		switch {
+		case has(maddr, ma.P_QUIC):
+			ts.QUIC = true
+
+		case has(maddr, ma.P_WS), has(maddr, ma.P_WSS):
+			ts.WebSocket = true
+
+		case has(maddr, ma.P_TCP):
+			ts.TCP = true
+
+		default:
+			return ts, fmt.Errorf("%s: unsupported transport", maddr)
+		}
+	}
+
+	return
+}
+
+// Option returns a libp2p option that enables the transports.  TCP
+// and WebSocket connections are secured with Noise or TLS, and are
+// multiplexed with yamux.  QUIC provides both of these natively.  If
+// a swarm key was specified, the host joins the private network.
+func (ts Transports) Option(c *cli.Context) (libp2p.Option, error) {
+	psk, err := pnetutil.Load(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if psk != nil && ts.QUIC {
+		return nil, ErrQUICPrivateNetwork
+	}
+
+	opts := []libp2p.Option{libp2p.NoTransports}
+
+	if ts.QUIC {
+		opts = append(opts, libp2p.Transport(libp2pquic.NewTransport))
+	}
+
+	if ts.TCP {
+		opts = append(opts, libp2p.Transport(tcp.NewTCPTransport))
+	}
+
+	if ts.WebSocket {
+		opts = append(opts, libp2p.Transport(websocket.New))
+	}
+
+	if ts.TCP || ts.WebSocket {
+		opts = append(opts,
+			libp2p.DefaultSecurity,
+			libp2p.DefaultMuxers)
+	}
+
+	if psk != nil {
+		opts = append(opts, libp2p.PrivateNetwork(psk))
+	}
+
+	return libp2p.ChainOptions(opts...), nil
+}
+
+func has(maddr ma.Multiaddr, code int) bool {
+	_, err := maddr.ValueForProtocol(code)
+	return err == nil
+}
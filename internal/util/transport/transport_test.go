@@ -0,0 +1,102 @@
+package transportutil_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+
+	transportutil "github.com/wetware/ww/internal/util/transport"
+)
+
+const swarmKey = "/key/swarm/psk/1.0.0/\n/base16/\n" +
+	"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef\n"
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name  string
+		addrs []string
+		want  transportutil.Transports
+		fail  bool
+	}{
+		{"Empty", nil, transportutil.Transports{}, false},
+		{"TCP", []string{"/ip4/127.0.0.1/tcp/2020"}, transportutil.Transports{TCP: true}, false},
+		{"QUIC", []string{"/ip4/127.0.0.1/udp/2020/quic"}, transportutil.Transports{QUIC: true}, false},
+		{"WebSocket", []string{"/ip4/127.0.0.1/tcp/2020/ws"}, transportutil.Transports{WebSocket: true}, false},
+		{"Mixed", []string{
+			"/ip4/127.0.0.1/tcp/2020",
+			"/ip6/::1/udp/2020/quic",
+		}, transportutil.Transports{QUIC: true, TCP: true}, false},
+		{"Unsupported", []string{"/ip4/127.0.0.1/udp/2020"}, transportutil.Transports{}, true},
+		{"Invalid", []string{"not a multiaddr"}, transportutil.Transports{}, true},
+	} {
+		ts, err := transportutil.Parse(tt.addrs)
+		if tt.fail {
+			assert.Error(t, err, tt.name)
+		} else {
+			require.NoError(t, err, tt.name)
+			assert.Equal(t, tt.want, ts, tt.name)
+		}
+	}
+}
+
+func TestOption(t *testing.T) {
+	t.Parallel()
+
+	key := filepath.Join(t.TempDir(), "swarm.key")
+	require.NoError(t, os.WriteFile(key, []byte(swarmKey), 0600))
+
+	for _, tt := range []struct {
+		name string
+		ts   transportutil.Transports
+		args []string
+		err  error
+		fail bool
+	}{
+		{"Public", transportutil.Transports{QUIC: true, TCP: true, WebSocket: true}, nil, nil, false},
+		{"Private", transportutil.Transports{TCP: true, WebSocket: true}, []string{"-swarm-key", key}, nil, false},
+		{"PrivateQUIC", transportutil.Transports{QUIC: true, TCP: true}, []string{"-swarm-key", key},
+			transportutil.ErrQUICPrivateNetwork, true},
+		{"MissingKey", transportutil.Transports{TCP: true}, []string{"-swarm-key", key + ".missing"}, nil, true},
+	} {
+		opt, err := tt.ts.Option(newContext(t, tt.args...))
+		switch {
+		case tt.err != nil:
+			assert.ErrorIs(t, err, tt.err, tt.name)
+
+		case tt.fail:
+			assert.Error(t, err, tt.name)
+
+		default:
+			require.NoError(t, err, tt.name)
+			assert.NotNil(t, opt, tt.name)
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	key := filepath.Join(t.TempDir(), "swarm.key")
+	require.NoError(t, os.WriteFile(key, []byte(swarmKey), 0600))
+
+	assert.Equal(t, transportutil.Transports{QUIC: true, TCP: true, WebSocket: true},
+		transportutil.All(newContext(t)), "should enable all transports")
+	assert.Equal(t, transportutil.Transports{TCP: true, WebSocket: true},
+		transportutil.All(newContext(t, "-swarm-key", key)),
+		"private networks should exclude QUIC")
+}
+
+func newContext(t *testing.T, args ...string) *cli.Context {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("swarm-key", "", "")
+	require.NoError(t, fs.Parse(args), "should parse flags")
+
+	return cli.NewContext(cli.NewApp(), fs, nil)
+}
@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"capnproto.org/go/capnp/v3"
@@ -12,20 +13,27 @@ import (
 )
 
 var AnchorCapability = vat.BasicCap{
-	"anchor/packed",
-	"anchor"}
+	Name:     "anchor",
+	Versions: []string{"0.1.0"}}
+
+// ErrReadOnly is returned when a remote vat attempts to modify an
+// anchor that is managed by the host.  See HostServer.MountReadOnly.
+var ErrReadOnly = errors.New("anchor is read-only")
 
 /*----------------------------*
 |                             |
 |    Client Implementations   |
 |                             |
 *-----------------------------*/
+// Dialer returns a connection to a host's anchor capability, which
+// is no longer needed by the caller once release is called.
 type Dialer interface {
-	Dial(context.Context, peer.AddrInfo) (*rpc.Conn, error)
+	Dial(context.Context, peer.AddrInfo) (conn *rpc.Conn, release capnp.ReleaseFunc, err error)
 }
 
+// Host is the root anchor of a host.  If Client is nil, a connection
+// to the host is dialed for the duration of each call.
 type Host struct {
-	once   sync.Once
 	Client *capnp.Client
 	Info   peer.AddrInfo
 }
@@ -50,7 +58,10 @@ func (h *Host) Join(ctx context.Context, d Dialer, peers []peer.AddrInfo) error
 		return err
 	}
 
-	f, resolve := h.resolve(ctx, d).Join(ctx, params)
+	c, done := h.resolve(ctx, d)
+	defer done()
+
+	f, resolve := c.Join(ctx, params)
 	defer resolve()
 
 	_, err := f.Struct()
@@ -58,26 +69,41 @@ func (h *Host) Join(ctx context.Context, d Dialer, peers []peer.AddrInfo) error
 }
 
 func (h *Host) Ls(ctx context.Context, d Dialer) (*RegisterMap, capnp.ReleaseFunc) {
-	return listChildren(ctx, cluster.Anchor(h.resolve(ctx, d)))
+	c, done := h.resolve(ctx, d)
+	rs, release := listChildren(ctx, cluster.Anchor(c))
+	return rs, func() {
+		release()
+		done()
+	}
 }
 
 // Walk to the register located at path.  Panics if len(path) == 0.
 func (h *Host) Walk(ctx context.Context, d Dialer, path []string) (Register, capnp.ReleaseFunc) {
-	return walkPath(ctx, cluster.Anchor(h.resolve(ctx, d)), path)
+	c, done := h.resolve(ctx, d)
+	r, release := walkPath(ctx, cluster.Anchor(c), path)
+	return r, func() {
+		release()
+		done()
+	}
 }
 
-func (h *Host) resolve(ctx context.Context, d Dialer) cluster.Host {
-	h.once.Do(func() {
-		if h.Client == nil {
-			if conn, err := d.Dial(ctx, h.Info); err != nil {
-				h.Client = capnp.ErrorClient(err)
-			} else {
-				h.Client = conn.Bootstrap(ctx) // TODO:  wrap Client & call conn.Close() on Shutdown() hook?
-			}
-		}
-	})
+// resolve the host's anchor.  The connection to the host is released
+// when done is called.
+func (h *Host) resolve(ctx context.Context, d Dialer) (c cluster.Host, done capnp.ReleaseFunc) {
+	if h.Client != nil {
+		return cluster.Host{Client: h.Client}, func() {}
+	}
+
+	conn, release, err := d.Dial(ctx, h.Info)
+	if err != nil {
+		return cluster.Host{Client: capnp.ErrorClient(err)}, func() {}
+	}
 
-	return cluster.Host{Client: h.Client}
+	c = cluster.Host{Client: conn.Bootstrap(ctx)}
+	return c, func() {
+		c.Release()
+		release()
+	}
 }
 
 type RegisterMap struct {
@@ -123,6 +149,37 @@ func (r Register) Walk(ctx context.Context, path []string) (Register, capnp.Rele
 	return walkPath(ctx, cluster.Anchor(r), path)
 }
 
+// Get the data stored in the register.  The returned slice is valid
+// until the release function is called.
+func (r Register) Get(ctx context.Context) ([]byte, capnp.ReleaseFunc) {
+	f, release := cluster.Container(r).Get(ctx, nil)
+
+	res, err := f.Struct()
+	if err != nil {
+		release()
+		return nil, func() {}
+	}
+
+	data, err := res.Data()
+	if err != nil {
+		release()
+		return nil, func() {}
+	}
+
+	return data, release
+}
+
+// Set the data stored in the register.
+func (r Register) Set(ctx context.Context, data []byte) error {
+	f, release := cluster.Container(r).Set(ctx, func(ps cluster.Container_set_Params) error {
+		return ps.SetData(data)
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
 func (r Register) AddRef() Register {
 	return Register(cluster.Anchor(r) /*.AddRef()*/)
 }
@@ -210,8 +267,9 @@ func NewHost(m MergeStrategy) HostServer {
 	s := HostServer{
 		cluster: m,
 		node: node{
-			cs: make(map[string]node),
-			mu: new(sync.RWMutex),
+			cs:  make(map[string]node),
+			mu:  new(sync.RWMutex),
+			val: new(value),
 		},
 	}
 
@@ -245,6 +303,57 @@ func (s HostServer) Walk(ctx context.Context, call cluster.Anchor_walk) error {
 	return s.node.Walk(ctx, call)
 }
 
+// Mount returns a host-local handle to the anchor at path, creating
+// it if necessary.  The anchor is guaranteed to exist until Remove is
+// called on the returned handle.  Panics if len(path) == 0.
+func (s HostServer) Mount(path ...string) LocalAnchor {
+	if len(path) == 0 {
+		panic("zero-length path")
+	}
+
+	n := s.node
+	for _, name := range path {
+		n = n.child(name)
+	}
+
+	return LocalAnchor{n: n.AddRef()}
+}
+
+// MountReadOnly is like Mount, except that remote vats cannot modify
+// the anchor.  They can neither set its data nor create anchors beneath
+// it.  The same applies to each anchor along the path, so that remote
+// vats cannot create siblings that masquerade as host-managed anchors.
+// The host can still modify the anchor through the returned handle.
+func (s HostServer) MountReadOnly(path ...string) LocalAnchor {
+	if len(path) == 0 {
+		panic("zero-length path")
+	}
+
+	n := s.node
+	for _, name := range path {
+		n = n.child(name)
+		n.val.SetReadOnly()
+	}
+
+	return LocalAnchor{n: n.AddRef()}
+}
+
+// LocalAnchor allows the host to manipulate the data stored in
+// one of its anchors, without going through the RPC layer.
+type LocalAnchor struct{ n *node }
+
+// Get returns a copy of the anchor's data.
+func (a LocalAnchor) Get() []byte { return a.n.val.Load() }
+
+// Set the anchor's data.  Remote vats observe the new value
+// through the anchor's Container interface.
+func (a LocalAnchor) Set(data []byte) { a.n.val.Store(data) }
+
+// Remove the anchor from its parent.  Remote vats that hold a
+// reference to the anchor may continue to use it, but it is no
+// longer reachable through Ls or Walk.
+func (a LocalAnchor) Remove() { a.n.remove() }
+
 // node is theserver implemenation for host-local Anchors.
 type node struct {
 	Name   string
@@ -253,7 +362,7 @@ type node struct {
 	mu     *sync.RWMutex
 	parent *node
 	cs     map[string]node
-	// value  interface{}
+	val    *value
 }
 
 func (n node) Shutdown() {
@@ -262,7 +371,28 @@ func (n node) Shutdown() {
 	n.parent.mu.Lock()
 	defer n.parent.mu.Unlock()
 
-	delete(n.parent.cs, n.Name)
+	// The node may have been removed and replaced by another
+	// node with the same name.
+	if c, ok := n.parent.cs[n.Name]; ok && c.val == n.val {
+		delete(n.parent.cs, n.Name)
+	}
+}
+
+// remove the node from its parent, and release the reference held
+// by the parent's child map.  The caller's reference is also released.
+func (n node) remove() {
+	n.parent.mu.Lock()
+	c, ok := n.parent.cs[n.Name]
+	if ok = ok && c.val == n.val; ok {
+		delete(n.parent.cs, n.Name)
+	}
+	n.parent.mu.Unlock()
+
+	if ok {
+		c.Release()
+	}
+
+	n.Release()
 }
 
 func (n node) Release() { n.Anchor.Release() }
@@ -274,6 +404,7 @@ func (n node) AddRef() *node {
 		parent: n.parent,
 		cs:     n.cs,
 		mu:     n.mu,
+		val:    n.val,
 	}
 }
 
@@ -297,9 +428,12 @@ func (n node) Ls(_ context.Context, call cluster.Anchor_ls) error {
 			return err
 		}
 
-		if err = cs.At(i).SetAnchor(n.Anchor); err != nil {
+		// The results take ownership of the client.
+		if err = cs.At(i).SetAnchor(n.Anchor.AddRef()); err != nil {
 			return err
 		}
+
+		i++
 	}
 
 	return nil
@@ -324,6 +458,28 @@ func (n node) Walk(ctx context.Context, call cluster.Anchor_walk) error {
 	return res.SetAnchor(c.Anchor)
 }
 
+func (n node) Get(_ context.Context, call cluster.Container_get) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetData(n.val.Load())
+}
+
+func (n node) Set(_ context.Context, call cluster.Container_set) error {
+	if n.val.ReadOnly() {
+		return ErrReadOnly
+	}
+
+	data, err := call.Args().Data()
+	if err == nil {
+		n.val.Store(data)
+	}
+
+	return err
+}
+
 func (n node) walk(path capnp.TextList) (*node, error) {
 	for i := 0; i < path.Len(); i++ {
 		name, err := path.At(i)
@@ -331,6 +487,17 @@ func (n node) walk(path capnp.TextList) (*node, error) {
 			return nil, err
 		}
 
+		// Remote vats cannot create anchors beneath read-only
+		// anchors, but can walk to existing ones.
+		if n.val.ReadOnly() {
+			var ok bool
+			if n, ok = n.lookup(name); !ok {
+				return nil, ErrReadOnly
+			}
+
+			continue
+		}
+
 		n = n.child(name)
 	}
 
@@ -339,6 +506,14 @@ func (n node) walk(path capnp.TextList) (*node, error) {
 	return n.AddRef(), nil
 }
 
+func (n node) lookup(name string) (node, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+
+	c, ok := n.cs[name]
+	return c, ok
+}
+
 func (n node) child(name string) node {
 	n.mu.Lock()
 	defer n.mu.Unlock()
@@ -353,10 +528,47 @@ func (n node) child(name string) node {
 		parent: n.AddRef(),
 		cs:     make(map[string]node),
 		mu:     new(sync.RWMutex),
+		val:    new(value),
 	}
 
-	c.Anchor = cluster.Anchor_ServerToClient(c, &defaultPolicy)
+	c.Anchor = cluster.Anchor(cluster.Container_ServerToClient(c, &defaultPolicy))
 	n.cs[name] = c
 
 	return c // ref = 1
 }
+
+// value is the data stored in a Container.  It is shared by all
+// copies of a node.
+type value struct {
+	mu       sync.RWMutex
+	data     []byte
+	readOnly bool // for remote vats
+}
+
+func (v *value) ReadOnly() bool {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return v.readOnly
+}
+
+func (v *value) SetReadOnly() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.readOnly = true
+}
+
+func (v *value) Load() []byte {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	return append([]byte(nil), v.data...)
+}
+
+func (v *value) Store(data []byte) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.data = append(v.data[:0], data...)
+}
@@ -79,6 +79,40 @@ func TestAnchor(t *testing.T) {
 		assert.Equal(t, []string{"bravo"}, ss)
 	})
 
+	t.Run("Container", func(t *testing.T) {
+		r, release := h.Walk(ctx, nil, []string{"charlie"})
+		require.NotZero(t, r, "should return register")
+		defer release()
+
+		err := r.Set(ctx, []byte("hello"))
+		require.NoError(t, err, "should set data")
+
+		data, release := r.Get(ctx)
+		defer release()
+		assert.Equal(t, "hello", string(data), "should get data")
+	})
+
+	t.Run("Mount", func(t *testing.T) {
+		a := s.Mount("delta", "echo")
+		a.Set([]byte("hello"))
+
+		r, release := h.Walk(ctx, nil, []string{"delta", "echo"})
+		defer release()
+
+		data, release := r.Get(ctx)
+		defer release()
+		assert.Equal(t, "hello", string(data), "should observe local data")
+
+		a.Remove()
+
+		r, release = h.Walk(ctx, nil, []string{"delta"})
+		defer release()
+
+		rs, release := r.Ls(ctx)
+		defer release()
+		assert.False(t, rs.More(), "should remove anchor")
+	})
+
 	t.Run("Release", func(t *testing.T) {
 		runtime.GC()
 
@@ -114,6 +148,76 @@ func TestAnchor(t *testing.T) {
 	})
 }
 
+func TestAnchor_ls(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := cluster.Host{Client: cluster.NewHost(nil).Client()}
+
+	for _, name := range []string{"alpha", "bravo", "charlie"} {
+		r, release := h.Walk(ctx, nil, []string{name})
+		require.NotZero(t, r, "should return register")
+		defer release()
+	}
+
+	rs, release := h.Ls(ctx, nil)
+	defer release()
+
+	ss, err := toSlice(rs)
+	require.NoError(t, err, "should iterate without error")
+	assert.ElementsMatch(t, []string{"alpha", "bravo", "charlie"}, ss,
+		"should list each child exactly once")
+}
+
+func TestAnchor_readOnly(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := cluster.NewHost(nil)
+	h := cluster.Host{Client: s.Client()}
+
+	a := s.MountReadOnly("proc", "1")
+	a.Set([]byte("running"))
+
+	r, release := h.Walk(ctx, nil, []string{"proc", "1"})
+	defer release()
+
+	data, release := r.Get(ctx)
+	defer release()
+	assert.Equal(t, "running", string(data), "should read host-managed anchor")
+
+	err := r.Set(ctx, []byte("exited"))
+	assert.ErrorContains(t, err, cluster.ErrReadOnly.Error(),
+		"should not overwrite host-managed anchor")
+	assert.Equal(t, "running", string(a.Get()), "data should be unchanged")
+
+	for _, path := range [][]string{
+		{"proc"},
+		{"proc", "2"},
+		{"proc", "1", "child"},
+	} {
+		r, release := h.Walk(ctx, nil, path)
+		defer release()
+
+		err := r.Set(ctx, []byte("forged"))
+		assert.ErrorContains(t, err, cluster.ErrReadOnly.Error(),
+			"should not modify or create %v", path)
+	}
+
+	rs, release := r.Ls(ctx)
+	defer release()
+	assert.False(t, rs.More(), "should not have created children")
+
+	// Other anchors remain writable.
+	r, release = h.Walk(ctx, nil, []string{"scratch"})
+	defer release()
+	assert.NoError(t, r.Set(ctx, []byte("hello")), "should set data")
+}
+
 func toSlice(rs *cluster.RegisterMap) ([]string, error) {
 	var ss []string
 	for rs.Next() {
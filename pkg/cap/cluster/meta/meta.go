@@ -0,0 +1,162 @@
+// Package meta encodes the metadata that hosts publish in their
+// heartbeats.  Metadata is a URL query string, carried in the text
+// field of the heartbeat's meta union.
+package meta
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/wetware/casm/pkg/cluster/pulse"
+	"github.com/wetware/casm/pkg/cluster/routing"
+)
+
+// Query keys.
+const (
+	keyCPU      = "cpu"
+	keyMemory   = "memory"
+	keyRelay    = "relay"
+	labelPrefix = "label."
+)
+
+// HostInfo is published in each host's heartbeat.  It implements
+// pulse.Preparer, and can be passed to cluster.WithMeta.
+type HostInfo struct {
+	Labels map[string]string
+
+	// Capacity available to scheduled jobs.  Zero values are unlimited.
+	CPU    float64
+	Memory uint64
+
+	// RelayAddrs at which the host can be reached through a relay,
+	// if it is behind a NAT.
+	RelayAddrs []ma.Multiaddr
+}
+
+// Prepare the heartbeat by setting its metadata to the text encoding
+// of the host info.
+func (h HostInfo) Prepare(hb pulse.Heartbeat) {
+	b, _ := h.MarshalText() // never fails
+	_ = hb.Meta().SetText(string(b))
+}
+
+// MarshalText encodes the host info as a URL query string.
+func (h HostInfo) MarshalText() ([]byte, error) {
+	vs := url.Values{}
+	for k, v := range h.Labels {
+		vs.Set(labelPrefix+k, v)
+	}
+
+	if h.CPU > 0 {
+		vs.Set(keyCPU, strconv.FormatFloat(h.CPU, 'g', -1, 64))
+	}
+
+	if h.Memory > 0 {
+		vs.Set(keyMemory, strconv.FormatUint(h.Memory, 10))
+	}
+
+	for _, addr := range h.RelayAddrs {
+		vs.Add(keyRelay, addr.String())
+	}
+
+	return []byte(vs.Encode()), nil
+}
+
+// UnmarshalText decodes a URL query string.  Invalid relay addresses
+// are ignored; see RelayAddrs.
+func (h *HostInfo) UnmarshalText(b []byte) error {
+	vs, err := url.ParseQuery(string(b))
+	if err != nil {
+		return err
+	}
+
+	*h = HostInfo{RelayAddrs: relayAddrs(vs)}
+	for k := range vs {
+		switch v := vs.Get(k); {
+		case k == keyCPU:
+			if h.CPU, err = strconv.ParseFloat(v, 64); err != nil {
+				return fmt.Errorf("cpu: %w", err)
+			}
+
+		case k == keyMemory:
+			if h.Memory, err = strconv.ParseUint(v, 10, 64); err != nil {
+				return fmt.Errorf("memory: %w", err)
+			}
+
+		case strings.HasPrefix(k, labelPrefix):
+			if h.Labels == nil {
+				h.Labels = make(map[string]string)
+			}
+			h.Labels[strings.TrimPrefix(k, labelPrefix)] = v
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the host has all of the labels in the
+// selector.
+func (h HostInfo) Matches(selector map[string]string) bool {
+	for k, v := range selector {
+		if l, ok := h.Labels[k]; !ok || l != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Load the host info published in a routing record.  Records without
+// text metadata, e.g. from hosts that predate it, have an empty
+// HostInfo.
+func Load(rec routing.Record) (HostInfo, error) {
+	var info HostInfo
+
+	text, err := Text(rec)
+	if err == nil {
+		err = info.UnmarshalText([]byte(text))
+	}
+
+	return info, err
+}
+
+// Text returns the text metadata of a routing record, or the empty
+// string if it has none.  Records obtained from the local routing
+// table carry the heartbeat's metadata, whereas records obtained from
+// a cluster View carry the text directly.
+func Text(rec routing.Record) (string, error) {
+	switch r := rec.(type) {
+	case interface{ Meta() pulse.Meta }:
+		if r.Meta().Which() != pulse.MetaType_Text {
+			return "", nil
+		}
+
+		return r.Meta().Text()
+
+	case interface{ MetaText() string }:
+		return r.MetaText(), nil
+	}
+
+	return "", nil
+}
+
+// RelayAddrs returns the relay addresses in a host's text metadata,
+// without decoding the rest of it.  Decoding is best-effort:  invalid
+// metadata and addresses are ignored.
+func RelayAddrs(text string) []ma.Multiaddr {
+	vs, _ := url.ParseQuery(text)
+	return relayAddrs(vs)
+}
+
+func relayAddrs(vs url.Values) (addrs []ma.Multiaddr) {
+	for _, s := range vs[keyRelay] {
+		if addr, err := ma.NewMultiaddr(s); err == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return
+}
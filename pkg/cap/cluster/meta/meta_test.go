@@ -0,0 +1,88 @@
+package meta_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wetware/casm/pkg/cluster/pulse"
+
+	"github.com/wetware/ww/pkg/cap/cluster/meta"
+)
+
+const relay = "/ip4/10.0.0.1/tcp/2020/p2p/QmcEPrat8ShnCph8WjkREzt5CPXF2RwhYxYBALDcLC1iV6/p2p-circuit"
+
+func TestHostInfo(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	want := meta.HostInfo{
+		Labels:     map[string]string{"zone": "us-east"},
+		CPU:        2,
+		Memory:     1 << 20,
+		RelayAddrs: []ma.Multiaddr{ma.StringCast(relay)},
+	}
+
+	t.Run("Heartbeat", func(t *testing.T) {
+		t.Parallel()
+
+		hb, err := pulse.NewHeartbeat(capnp.SingleSegment(nil))
+		require.NoError(t, err)
+		want.Prepare(hb)
+
+		got, err := meta.Load(heartbeat{hb})
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "should decode heartbeat metadata")
+	})
+
+	t.Run("View", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := want.MarshalText()
+		require.NoError(t, err)
+
+		got, err := meta.Load(viewRecord(text))
+		require.NoError(t, err)
+		assert.Equal(t, want, got, "should decode text metadata")
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := meta.Load(viewRecord(""))
+		require.NoError(t, err)
+		assert.Zero(t, got, "should decode empty metadata")
+	})
+
+	t.Run("RelayAddrs", func(t *testing.T) {
+		t.Parallel()
+
+		text := "cpu=invalid&relay=" + url.QueryEscape(relay) + "&relay=invalid"
+
+		addrs := meta.RelayAddrs(text)
+		require.Len(t, addrs, 1, "should ignore invalid addresses")
+		assert.Equal(t, relay, addrs[0].String())
+
+		var info meta.HostInfo
+		assert.Error(t, info.UnmarshalText([]byte(text)),
+			"should reject invalid capacity")
+	})
+}
+
+type heartbeat struct{ pulse.Heartbeat }
+
+func (heartbeat) Peer() peer.ID      { return "" }
+func (heartbeat) TTL() time.Duration { return time.Second }
+func (heartbeat) Seq() uint64        { return 0 }
+
+type viewRecord string
+
+func (viewRecord) Peer() peer.ID      { return "" }
+func (viewRecord) TTL() time.Duration { return time.Second }
+func (viewRecord) Seq() uint64        { return 0 }
+func (r viewRecord) MetaText() string { return string(r) }
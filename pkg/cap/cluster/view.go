@@ -7,16 +7,18 @@ import (
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/server"
 	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/wetware/casm/pkg/cluster/routing"
 	api "github.com/wetware/ww/internal/api/cluster"
+	"github.com/wetware/ww/pkg/cap/cluster/meta"
 	"github.com/wetware/ww/pkg/vat"
 	"golang.org/x/sync/semaphore"
 )
 
 var (
 	ViewCapability = vat.BasicCap{
-		"view/packed",
-		"view"}
+		Name:     "view",
+		Versions: []string{"0.1.0"}}
 )
 
 const (
@@ -86,6 +88,13 @@ func (f ViewServer) Lookup(_ context.Context, call api.View_lookup) error {
 		rec.SetPeer(string(capRec.Peer()))
 		rec.SetTtl(int64(capRec.TTL()))
 		rec.SetSeq(capRec.Seq())
+
+		text := metaText(capRec)
+		if err = rec.SetMeta(text); err != nil {
+			return err
+		}
+
+		return setRelayAddrs(rec, meta.RelayAddrs(text))
 	}
 	return nil
 }
@@ -123,6 +132,12 @@ func (v View) Lookup(ctx context.Context, peerID peer.ID) (routing.Record, error
 	return recordFromCapnp(r)
 }
 
+func (v View) AddRef() View {
+	return View(api.View(v).AddRef())
+}
+
+func (v View) Release() { v.Client.Release() }
+
 type Record api.View_Record
 
 func (rec Record) TTL() time.Duration {
@@ -221,9 +236,11 @@ func loadBatch(args api.View_Handler_handle_Params) ([]record, error) {
 }
 
 type record struct {
-	id  peer.ID
-	ttl time.Duration
-	seq uint64
+	id    peer.ID
+	ttl   time.Duration
+	seq   uint64
+	meta  string
+	relay []ma.Multiaddr
 }
 
 func recordFromCapnp(r api.View_Record) (rec record, err error) {
@@ -234,13 +251,75 @@ func recordFromCapnp(r api.View_Record) (rec record, err error) {
 		rec.id, err = peer.IDFromString(s)
 	}
 
+	if err == nil {
+		rec.meta, err = r.Meta()
+	}
+
+	if err == nil && r.HasRelayAddrs() {
+		rec.relay, err = loadRelayAddrs(r)
+	}
+
 	return
 }
 
+func loadRelayAddrs(r api.View_Record) ([]ma.Multiaddr, error) {
+	l, err := r.RelayAddrs()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]ma.Multiaddr, l.Len())
+	for i := range addrs {
+		s, err := l.At(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if addrs[i], err = ma.NewMultiaddr(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return addrs, nil
+}
+
+func setRelayAddrs(rec api.View_Record, addrs []ma.Multiaddr) error {
+	if len(addrs) == 0 {
+		return nil
+	}
+
+	l, err := rec.NewRelayAddrs(int32(len(addrs)))
+	if err != nil {
+		return err
+	}
+
+	for i, addr := range addrs {
+		if err = l.Set(i, addr.String()); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
 func (r record) Peer() peer.ID      { return r.id }
 func (r record) TTL() time.Duration { return r.ttl }
 func (r record) Seq() uint64        { return r.seq }
 
+// MetaText returns the text metadata from the host's heartbeat.
+func (r record) MetaText() string { return r.meta }
+
+// RelayAddrs at which the host can be reached through a relay, if it
+// is behind a NAT.
+func (r record) RelayAddrs() []ma.Multiaddr { return r.relay }
+
+// metaText returns the text metadata from the heartbeat of a local
+// routing record, if any.
+func metaText(r routing.Record) string {
+	text, _ := meta.Text(r) // best-effort
+	return text
+}
+
 type batcher struct {
 	lim   *limiter
 	h     api.View_Handler
@@ -350,10 +429,13 @@ func (b *batch) Full() bool { return len(b.rs) == cap(b.rs) }
 func (b *batch) Len() int32 { return int32(len(b.rs)) }
 
 func (b *batch) Add(r routing.Record, dl time.Time) bool {
+	text := metaText(r)
 	b.rs = append(b.rs, batchRecord{
 		ID:       r.Peer(),
 		Seq:      r.Seq(),
 		Deadline: dl.Truncate(time.Millisecond),
+		Meta:     text,
+		Relay:    meta.RelayAddrs(text),
 	})
 
 	return b.Full()
@@ -398,11 +480,21 @@ type batchRecord struct {
 	ID       peer.ID
 	Seq      uint64
 	Deadline time.Time
+	Meta     string
+	Relay    []ma.Multiaddr
 }
 
 func (r batchRecord) SetParam(t time.Time, rec api.View_Record) error {
 	rec.SetSeq(r.Seq)
 	rec.SetTtl(r.Deadline.Sub(t).Microseconds())
+	if err := rec.SetMeta(r.Meta); err != nil {
+		return err
+	}
+
+	if err := setRelayAddrs(rec, r.Relay); err != nil {
+		return err
+	}
+
 	return rec.SetPeer(string(r.ID))
 }
 
@@ -4,13 +4,17 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"net/url"
 	"testing"
 	"time"
 
+	"capnproto.org/go/capnp/v3"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wetware/casm/pkg/cluster/pulse"
 	"github.com/wetware/casm/pkg/cluster/routing"
 	"github.com/wetware/ww/pkg/cap/cluster"
 )
@@ -61,6 +65,72 @@ func TestIter(t *testing.T) {
 		assert.NoError(t, it.Err, "should be exhausted")
 	})
 
+	t.Run("Meta", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rt := routingTable{
+			{
+				id:   newID(),
+				ttl:  time.Second * 10,
+				dl:   time.Now().Add(time.Second * 10),
+				meta: "cpu=2",
+			},
+		}
+
+		c := (&cluster.ViewServer{View: rt}).NewClient(nil)
+
+		it, release := c.Iter(ctx)
+		defer release()
+
+		require.True(t, it.Next(ctx), "should advance iterator")
+		require.NoError(t, it.Err, "should succeed")
+
+		rec, ok := it.Record().(interface{ MetaText() string })
+		require.True(t, ok, "should expose metadata")
+		assert.Equal(t, "cpu=2", rec.MetaText(), "should carry heartbeat metadata")
+	})
+
+	t.Run("RelayAddrs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		const relay = "/ip4/10.0.0.1/tcp/2020/p2p/QmcEPrat8ShnCph8WjkREzt5CPXF2RwhYxYBALDcLC1iV6/p2p-circuit"
+
+		rt := routingTable{
+			{
+				id:   newID(),
+				ttl:  time.Second * 10,
+				dl:   time.Now().Add(time.Second * 10),
+				meta: "cpu=2&relay=" + url.QueryEscape(relay) + "&relay=invalid",
+			},
+		}
+
+		c := (&cluster.ViewServer{View: rt}).NewClient(nil)
+
+		it, release := c.Iter(ctx)
+		defer release()
+
+		require.True(t, it.Next(ctx), "should advance iterator")
+		require.NoError(t, it.Err, "should succeed")
+
+		type relayer interface{ RelayAddrs() []ma.Multiaddr }
+
+		rec, ok := it.Record().(relayer)
+		require.True(t, ok, "should expose relay addresses")
+		require.Len(t, rec.RelayAddrs(), 1, "should ignore invalid addresses")
+		assert.Equal(t, relay, rec.RelayAddrs()[0].String())
+
+		r, err := c.Lookup(ctx, rt[0].id)
+		require.NoError(t, err, "should look up record")
+		require.Len(t, r.(relayer).RelayAddrs(), 1, "lookup should carry relay addresses")
+		assert.Equal(t, relay, r.(relayer).RelayAddrs()[0].String())
+	})
+
 	t.Run("Batch", func(t *testing.T) {
 		t.Parallel()
 
@@ -209,16 +279,32 @@ func (it iter) Deadline() time.Time {
 func (it iter) Finish() {}
 
 type record struct {
-	id  peer.ID
-	ttl time.Duration
-	seq uint64
-	dl  time.Time
+	id   peer.ID
+	ttl  time.Duration
+	seq  uint64
+	dl   time.Time
+	meta string
 }
 
 func (r record) Peer() peer.ID      { return peer.ID(r.id) }
 func (r record) TTL() time.Duration { return r.ttl }
 func (r record) Seq() uint64        { return r.seq }
 
+func (r record) Meta() pulse.Meta {
+	hb, err := pulse.NewHeartbeat(capnp.SingleSegment(nil))
+	if err != nil {
+		panic(err)
+	}
+
+	if r.meta != "" {
+		if err = hb.Meta().SetText(r.meta); err != nil {
+			panic(err)
+		}
+	}
+
+	return hb.Meta()
+}
+
 type routingTable []record
 
 func (v routingTable) Iter() routing.Iterator {
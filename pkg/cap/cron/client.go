@@ -0,0 +1,132 @@
+package cron
+
+import (
+	"context"
+	"time"
+
+	api "github.com/wetware/ww/internal/api/process"
+	"github.com/wetware/ww/pkg/cap/sched"
+)
+
+// MissedRuns determines what happens to runs that were missed, e.g.
+// because the host was suspended or stalled when they were due.
+type MissedRuns uint16
+
+const (
+	// Skip missed runs.
+	Skip MissedRuns = MissedRuns(api.CronEntry_MissedRuns_skip)
+
+	// RunOnce fires a single run in place of any number of missed runs.
+	RunOnce MissedRuns = MissedRuns(api.CronEntry_MissedRuns_runOnce)
+
+	// RunAll fires every missed run.
+	RunAll MissedRuns = MissedRuns(api.CronEntry_MissedRuns_runAll)
+)
+
+func (m MissedRuns) String() string { return api.CronEntry_MissedRuns(m).String() }
+
+// Entry is a job that is fired on a schedule.
+type Entry struct {
+	Name     string
+	Schedule string // cron expression; see Parse
+	Job      sched.JobSpec
+	Missed   MissedRuns
+
+	// LastRun is the time at which the entry was last fired, or at
+	// which it was added.  It is ignored by Add.
+	LastRun time.Time
+}
+
+func (e Entry) bind(entry api.CronEntry) error {
+	if err := entry.SetName(e.Name); err != nil {
+		return err
+	}
+
+	if err := entry.SetSchedule(e.Schedule); err != nil {
+		return err
+	}
+
+	spec, err := entry.NewJob()
+	if err != nil {
+		return err
+	}
+
+	entry.SetMissed(api.CronEntry_MissedRuns(e.Missed))
+	return e.Job.Bind(spec)
+}
+
+// load the entry, except for its job.
+func (e *Entry) load(entry api.CronEntry) (err error) {
+	if e.Name, err = entry.Name(); err != nil {
+		return
+	}
+
+	if e.Schedule, err = entry.Schedule(); err != nil {
+		return
+	}
+
+	e.Missed = MissedRuns(entry.Missed())
+	e.LastRun = time.Unix(0, entry.LastRun())
+	return
+}
+
+type Cron api.Cron
+
+// Add an entry to the host's cron table.
+func (c Cron) Add(ctx context.Context, e Entry) error {
+	f, release := api.Cron(c).Add(ctx, func(ps api.Cron_add_Params) error {
+		entry, err := ps.NewEntry()
+		if err == nil {
+			err = e.bind(entry)
+		}
+
+		return err
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Rm removes the named entry from the host's cron table.
+func (c Cron) Rm(ctx context.Context, name string) error {
+	f, release := api.Cron(c).Rm(ctx, func(ps api.Cron_rm_Params) error {
+		return ps.SetName(name)
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Ls returns the entries in the host's cron table, sorted by name.
+// The entries' jobs are not loaded.
+func (c Cron) Ls(ctx context.Context) ([]Entry, error) {
+	f, release := api.Cron(c).Ls(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	es, err := res.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, es.Len())
+	for i := range entries {
+		if err = entries[i].load(es.At(i)); err != nil {
+			break
+		}
+	}
+
+	return entries, err
+}
+
+func (c Cron) AddRef() Cron {
+	return Cron(api.Cron(c).AddRef())
+}
+
+func (c Cron) Release() { c.Client.Release() }
@@ -0,0 +1,474 @@
+// Package cron provides a capability for running jobs periodically.
+package cron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/server"
+	"github.com/lthibault/log"
+
+	api "github.com/wetware/ww/internal/api/process"
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/sched"
+	"github.com/wetware/ww/pkg/vat"
+)
+
+var Capability = vat.BasicCap{
+	Name:     "cron",
+	Versions: []string{"0.1.0"}}
+
+// Dir is the anchor beneath which each host stores its entries.
+const Dir = "cron"
+
+var (
+	// ErrClosed is returned when adding an entry to a closed server.
+	ErrClosed = errors.New("closed")
+
+	// ErrExists is returned when adding an entry whose name is in use.
+	ErrExists = errors.New("entry exists")
+
+	// ErrNotFound is returned when removing a nonexistent entry.
+	ErrNotFound = errors.New("entry not found")
+)
+
+var defaultPolicy = server.Policy{
+	MaxConcurrentCalls: 64,
+}
+
+// Mounter is the host's anchor tree.  It is typically provided by
+// cluster.HostServer.
+type Mounter interface {
+	MountReadOnly(path ...string) clcap.LocalAnchor
+}
+
+// Server stores the host's cron entries in its anchor tree, and fires
+// them by submitting their jobs to the host's scheduler.  Each entry is
+// fired by exactly one host:  the host whose Cron capability added it.
+// Entries are mounted read-only, so remote vats can observe them, but
+// cannot modify them or add entries of their own.
+//
+// Entries are not persisted.  They are removed when the host leaves
+// the cluster, and are not fired by any other host.
+type Server struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	log      log.Logger
+	root     Mounter
+	sched    sched.Scheduler
+	interval time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// entry is a cron entry that was added through the host's capability.
+type entry struct {
+	anchor   clcap.LocalAnchor
+	msg      api.CronEntry // the source of truth; mirrored in anchor
+	schedule Schedule
+	job      sched.Job // last job fired for the entry; null if none
+}
+
+// New cron server.  Entries are stored beneath root, and fired by
+// submitting their jobs to s.
+func New(root Mounter, s sched.Scheduler, opt ...Option) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Server{
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		root:    root,
+		sched:   s,
+		now:     time.Now,
+		entries: make(map[string]*entry),
+	}
+
+	for _, option := range withDefault(opt) {
+		option(c)
+	}
+
+	go c.loop()
+
+	return c
+}
+
+// Close stops firing entries, and removes the host's entries from its
+// anchor tree.  Jobs that have already been fired keep running.
+func (c *Server) Close() error {
+	select {
+	case <-c.ctx.Done():
+		return fmt.Errorf("already %w", ErrClosed)
+	default:
+		c.cancel()
+		<-c.done
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, e := range c.entries {
+		e.release()
+		delete(c.entries, name)
+	}
+
+	c.sched.Release()
+	return nil
+}
+
+func (c *Server) Client() *capnp.Client {
+	return api.Cron_ServerToClient(c, &defaultPolicy).Client
+}
+
+func (c *Server) Add(_ context.Context, call api.Cron_add) error {
+	src, err := call.Args().Entry()
+	if err != nil {
+		return err
+	}
+
+	name, err := src.Name()
+	if err != nil {
+		return err
+	}
+
+	if name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid name: %q", name)
+	}
+
+	if err = validate(src); err != nil {
+		return err
+	}
+
+	// Copy the entry out of the call's message, which is released
+	// when Add returns.
+	data, err := marshal(src)
+	if err != nil {
+		return err
+	}
+
+	msg, err := unmarshal(data)
+	if err != nil {
+		return err
+	}
+
+	expr, err := msg.Schedule()
+	if err != nil {
+		return err
+	}
+
+	schedule, err := Parse(expr)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	select {
+	case <-c.ctx.Done():
+		return ErrClosed
+	default:
+	}
+
+	if _, ok := c.entries[name]; ok {
+		return fmt.Errorf("%w: %s", ErrExists, name)
+	}
+
+	e := &entry{
+		anchor:   c.root.MountReadOnly(Dir, name),
+		msg:      msg,
+		schedule: schedule,
+	}
+
+	// No runs have been missed.
+	if err = e.setLastRun(c.now()); err != nil {
+		e.release()
+		return err
+	}
+
+	c.entries[name] = e
+	return nil
+}
+
+func (c *Server) Rm(_ context.Context, call api.Cron_rm) error {
+	name, err := call.Args().Name()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	e.release()
+	delete(c.entries, name)
+
+	return nil
+}
+
+func (c *Server) Ls(_ context.Context, call api.Cron_ls) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	names := make([]string, 0, len(c.entries))
+	for name := range c.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res, err := call.AllocResults()
+	if err != nil || len(names) == 0 {
+		return err
+	}
+
+	es, err := res.NewEntries(int32(len(names)))
+	if err != nil {
+		return err
+	}
+
+	for i, name := range names {
+		if err = es.Set(i, c.entries[name].msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Server) loop() {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.tick()
+
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// tick fires the host's due entries.
+func (c *Server) tick() {
+	ctx, cancel := context.WithTimeout(c.ctx, c.interval)
+	defer cancel()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, e := range c.entries {
+		if err := c.fire(ctx, name, e); err != nil && c.ctx.Err() == nil {
+			c.log.WithError(err).
+				WithField("entry", name).
+				Warn("failed to fire cron entry")
+		}
+	}
+}
+
+// fire submits a job for each of the entry's runs that are due,
+// subject to its missed-run policy.  The entry's last run is updated
+// before the jobs are submitted, so that each run is fired at most
+// once.
+func (c *Server) fire(ctx context.Context, name string, e *entry) error {
+	now := c.now()
+	runs, ok := c.due(e.schedule, e.lastRun(), now, MissedRuns(e.msg.Missed()))
+	if !ok {
+		return nil
+	}
+
+	if err := e.setLastRun(now); err != nil {
+		return err
+	}
+
+	spec, err := e.msg.Job()
+	if err != nil {
+		return err
+	}
+
+	for _, t := range runs {
+		c.submit(ctx, e, spec, fmt.Sprintf("%s-%d", name, t.Unix()))
+	}
+
+	return nil
+}
+
+// due returns the scheduled times in (last, now] at which the entry
+// should be fired, and reports whether any times were scheduled.  Runs
+// are considered missed if they are older than twice the polling
+// interval.
+func (c *Server) due(s Schedule, last, now time.Time, policy MissedRuns) (runs []time.Time, ok bool) {
+	var missed []time.Time
+	for t := s.Next(last); !t.IsZero() && !t.After(now); t = s.Next(t) {
+		if now.Sub(t) > 2*c.interval {
+			missed = append(missed, t)
+		} else {
+			runs = append(runs, t)
+		}
+
+		if len(missed)+len(runs) == maxRuns {
+			break
+		}
+	}
+
+	switch ok = len(missed)+len(runs) > 0; {
+	case len(missed) == 0, policy == Skip:
+		return runs, ok
+
+	case policy == RunOnce:
+		if len(runs) > 0 {
+			return runs, ok
+		}
+		return missed[len(missed)-1:], ok
+
+	default: // RunAll
+		return append(missed, runs...), ok
+	}
+}
+
+// maxRuns caps the number of runs fired for a single entry at once.
+const maxRuns = 100
+
+// submit the entry's job to the scheduler.  The previous job fired for
+// the entry is stopped if all of its replicas have exited, so that
+// completed runs do not accumulate in the scheduler.
+func (c *Server) submit(ctx context.Context, e *entry, src api.JobSpec, name string) {
+	if prev := e.job; prev.Client != nil {
+		if rs, err := prev.Status(ctx); err != nil || exited(rs) {
+			_ = prev.Stop(ctx)
+		}
+		prev.Release()
+		e.job = sched.Job{}
+	}
+
+	f, release := api.Scheduler(c.sched).Submit(ctx, func(ps api.Scheduler_submit_Params) error {
+		if err := ps.SetSpec(src); err != nil {
+			return err
+		}
+
+		spec, err := ps.Spec()
+		if err == nil {
+			err = spec.SetName(name)
+		}
+
+		return err
+	})
+	defer release()
+
+	j := sched.Job(f.Job())
+	if _, err := j.Name(ctx); err != nil {
+		c.log.WithError(err).WithField("job", name).Warn("failed to submit cron job")
+		return
+	}
+
+	c.log.WithField("job", name).Debug("fired cron entry")
+	e.job = j.AddRef()
+}
+
+func (e *entry) lastRun() time.Time {
+	return time.Unix(0, e.msg.LastRun())
+}
+
+// setLastRun updates the entry, and mirrors it in its anchor.
+func (e *entry) setLastRun(t time.Time) error {
+	e.msg.SetLastRun(t.UnixNano())
+
+	data, err := marshal(e.msg)
+	if err == nil {
+		e.anchor.Set(data)
+	}
+
+	return err
+}
+
+// release the entry's last job, and remove it from the anchor tree.
+func (e *entry) release() {
+	e.job.Release()
+	e.anchor.Remove()
+}
+
+func exited(rs []sched.Replica) bool {
+	for _, r := range rs {
+		if r.State != sched.Exited {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validate(entry api.CronEntry) error {
+	expr, err := entry.Schedule()
+	if err != nil {
+		return err
+	}
+
+	if _, err = Parse(expr); err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	if !entry.HasJob() {
+		return errors.New("missing job")
+	}
+
+	spec, err := entry.Job()
+	if err != nil {
+		return err
+	}
+
+	if spec.HasCommand() == spec.HasModule() {
+		return errors.New("job must specify exactly one of command and module")
+	}
+
+	if spec.HasCommand() {
+		cmd, err := spec.Command()
+		if err != nil {
+			return err
+		}
+
+		if cmd.HasCaps() {
+			return errors.New("cron jobs cannot be passed capabilities")
+		}
+	}
+
+	return nil
+}
+
+// marshal the entry into the packed format stored in its anchor.
+func marshal(entry api.CronEntry) ([]byte, error) {
+	msg, _, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = msg.SetRoot(entry.ToPtr()); err != nil {
+		return nil, err
+	}
+
+	return msg.MarshalPacked()
+}
+
+func unmarshal(data []byte) (api.CronEntry, error) {
+	msg, err := capnp.UnmarshalPacked(data)
+	if err != nil {
+		return api.CronEntry{}, err
+	}
+
+	return api.ReadRootCronEntry(msg)
+}
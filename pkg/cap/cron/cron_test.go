@@ -0,0 +1,343 @@
+package cron
+
+import (
+	"context"
+	"crypto/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wetware/casm/pkg/cluster/routing"
+
+	api "github.com/wetware/ww/internal/api/process"
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/proc"
+	"github.com/wetware/ww/pkg/cap/sched"
+)
+
+func TestDue(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	var (
+		c     = Server{interval: 10 * time.Second}
+		s, _  = Parse("* * * * *")
+		start = time.Date(2021, 1, 1, 0, 0, 30, 0, time.UTC)
+	)
+
+	for _, tt := range []struct {
+		name   string
+		now    time.Time
+		policy MissedRuns
+		want   int
+		ok     bool
+	}{
+		{"NotDue", start.Add(20 * time.Second), Skip, 0, false},
+		{"OnTime", start.Add(35 * time.Second), Skip, 1, true},
+		{"Skip", start.Add(4*time.Minute + 30*time.Second), Skip, 1, true},
+		{"SkipAll", start.Add(4*time.Minute + 15*time.Second), Skip, 0, true},
+		{"RunOnce", start.Add(4*time.Minute + 15*time.Second), RunOnce, 1, true},
+		{"RunAll", start.Add(4*time.Minute + 30*time.Second), RunAll, 5, true},
+		{"Max", start.Add(24 * time.Hour), RunAll, maxRuns, true},
+	} {
+		runs, ok := c.due(s, start, tt.now, tt.policy)
+		assert.Len(t, runs, tt.want, tt.name)
+		assert.Equal(t, tt.ok, ok, tt.name)
+	}
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("AddLsRm", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHost(t)
+		c := New(h.anchors, h.scheduler(), WithInterval(time.Hour))
+		defer c.Close()
+
+		client := Cron{Client: c.Client()}
+		defer client.Release()
+
+		err := client.Add(context.Background(), Entry{
+			Name:     "backup",
+			Schedule: "@daily",
+			Job:      sched.JobSpec{Command: &proc.Command{Path: "true"}},
+			Missed:   RunOnce,
+		})
+		require.NoError(t, err)
+
+		err = client.Add(context.Background(), Entry{
+			Name:     "backup",
+			Schedule: "@daily",
+			Job:      sched.JobSpec{Command: &proc.Command{Path: "true"}},
+		})
+		assert.ErrorIs(t, err, ErrExists)
+
+		err = client.Add(context.Background(), Entry{
+			Name:     "invalid",
+			Schedule: "* * *",
+			Job:      sched.JobSpec{Command: &proc.Command{Path: "true"}},
+		})
+		assert.Error(t, err, "should reject invalid schedule")
+
+		es, err := client.Ls(context.Background())
+		require.NoError(t, err)
+		require.Len(t, es, 1)
+		assert.Equal(t, "backup", es[0].Name)
+		assert.Equal(t, "@daily", es[0].Schedule)
+		assert.Equal(t, RunOnce, es[0].Missed)
+		assert.False(t, es[0].LastRun.IsZero(), "should set last run")
+
+		data := h.anchors.MountReadOnly(Dir, "backup").Get()
+		assert.NotEmpty(t, data, "should store entry in anchor")
+
+		require.NoError(t, client.Rm(context.Background(), "backup"))
+		assert.ErrorIs(t, client.Rm(context.Background(), "backup"), ErrNotFound)
+
+		es, err = client.Ls(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, es)
+	})
+
+	t.Run("Fire", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			h     = newHost(t)
+			clock = newClock(time.Date(2021, 1, 1, 0, 0, 30, 0, time.UTC))
+			s     = h.scheduler()
+		)
+		defer s.Release()
+
+		c := New(h.anchors, s.AddRef(),
+			WithInterval(10*time.Millisecond),
+			withClock(clock.Now))
+		defer c.Close()
+
+		client := Cron{Client: c.Client()}
+		defer client.Release()
+
+		require.NoError(t, client.Add(context.Background(), Entry{
+			Name:     "tick",
+			Schedule: "* * * * *",
+			Job:      sched.JobSpec{Command: &proc.Command{Path: "true"}},
+		}))
+
+		clock.Set(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC))
+		require.Eventually(t, func() bool {
+			names, err := s.Ls(context.Background())
+			return err == nil && len(names) == 1 && names[0] == "tick-1609459260"
+		}, time.Second*5, time.Millisecond*10, "should fire entry")
+
+		es, err := client.Ls(context.Background())
+		require.NoError(t, err)
+		require.Len(t, es, 1)
+		assert.Equal(t, clock.Now(), es[0].LastRun.UTC(), "should record last run")
+	})
+
+	t.Run("ReadOnly", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHost(t)
+		c := New(h.anchors, h.scheduler(), WithInterval(time.Hour))
+		defer c.Close()
+
+		client := Cron{Client: c.Client()}
+		defer client.Release()
+
+		require.NoError(t, client.Add(context.Background(), Entry{
+			Name:     "backup",
+			Schedule: "@daily",
+			Job:      sched.JobSpec{Command: &proc.Command{Path: "true"}},
+		}))
+
+		root := clcap.Register{Client: h.anchors.Client()}
+		defer root.Client.Release()
+
+		r, release := root.Walk(context.Background(), []string{Dir, "backup"})
+		defer release()
+		assert.ErrorIs(t, r.Set(context.Background(), []byte("evil")), clcap.ErrReadOnly,
+			"remote vats should not modify entries")
+
+		r, release = root.Walk(context.Background(), []string{Dir, "evil"})
+		defer release()
+		assert.Error(t, r.Set(context.Background(), []byte("evil")),
+			"remote vats should not add entries")
+	})
+}
+
+func TestServer_tick(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("NoEntries", func(t *testing.T) {
+		t.Parallel()
+
+		h := newHost(t)
+		c := New(h.anchors, h.scheduler(), WithInterval(time.Hour))
+		defer c.Close()
+
+		c.tick()
+
+		root := clcap.Register{Client: h.anchors.Client()}
+		defer root.Client.Release()
+
+		rs, release := root.Ls(context.Background())
+		defer release()
+		assert.False(t, rs.Next(), "should not create anchors")
+		assert.NoError(t, rs.Err)
+	})
+
+	t.Run("Foreign", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			h     = newHost(t)
+			clock = newClock(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC))
+			s     = h.scheduler()
+		)
+		defer s.Release()
+
+		c := New(h.anchors, s.AddRef(),
+			WithInterval(time.Hour),
+			withClock(clock.Now))
+		defer c.Close()
+
+		// Only entries that were added through the capability are
+		// fired, even if others are written to the anchor tree.
+		_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		require.NoError(t, err)
+		entry, err := api.NewRootCronEntry(seg)
+		require.NoError(t, err)
+		require.NoError(t, entry.SetName("bad"))
+		require.NoError(t, entry.SetSchedule("* * * * *"))
+		spec, err := entry.NewJob()
+		require.NoError(t, err)
+		cmd, err := spec.NewCommand()
+		require.NoError(t, err)
+		require.NoError(t, cmd.SetPath("true"))
+		entry.SetLastRun(time.Date(2021, 1, 1, 0, 0, 30, 0, time.UTC).UnixNano())
+
+		data, err := marshal(entry)
+		require.NoError(t, err)
+		h.anchors.Mount(Dir, "bad").Set(data)
+
+		c.tick()
+
+		names, err := s.Ls(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, names, "should not fire foreign entry")
+	})
+}
+
+func withClock(now func() time.Time) Option {
+	return func(c *Server) {
+		c.now = now
+	}
+}
+
+type clock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func newClock(t time.Time) *clock { return &clock{t: t} }
+
+func (c *clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.t = t
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.t
+}
+
+// host is a single-host cluster.
+type host struct {
+	id      peer.ID
+	anchors clcap.HostServer
+	rt      *routingTable
+	exec    *proc.Server
+}
+
+func newHost(t *testing.T) *host {
+	t.Helper()
+
+	_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pk)
+	require.NoError(t, err)
+
+	h := &host{
+		id:      id,
+		anchors: clcap.NewHost(nil),
+		rt:      &routingTable{ids: []peer.ID{id}},
+		exec:    proc.New(),
+	}
+	t.Cleanup(func() { h.exec.Close() })
+
+	return h
+}
+
+func (h *host) scheduler() sched.Scheduler {
+	s := sched.New(h.rt, executor{h.exec}, sched.WithInterval(10*time.Millisecond))
+	return sched.Scheduler{Client: s.Client()}
+}
+
+type executor struct{ *proc.Server }
+
+func (e executor) Dial(context.Context, peer.ID) (proc.Executor, capnp.ReleaseFunc, error) {
+	x := proc.Executor{Client: e.Client()}
+	return x, x.Release, nil
+}
+
+type record peer.ID
+
+func (r record) Peer() peer.ID      { return peer.ID(r) }
+func (r record) TTL() time.Duration { return time.Minute }
+func (r record) Seq() uint64        { return 0 }
+
+type routingTable struct{ ids []peer.ID }
+
+func (rt *routingTable) Lookup(id peer.ID) (routing.Record, bool) {
+	for _, x := range rt.ids {
+		if x == id {
+			return record(id), true
+		}
+	}
+
+	return nil, false
+}
+
+func (rt *routingTable) Iter() routing.Iterator {
+	ids := append([]peer.ID(nil), rt.ids...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return &iterator{ids: ids}
+}
+
+type iterator struct{ ids []peer.ID }
+
+func (it *iterator) Next()               { it.ids = it.ids[1:] }
+func (it *iterator) Deadline() time.Time { return time.Time{} }
+func (it *iterator) Finish()             {}
+
+func (it *iterator) Record() routing.Record {
+	if len(it.ids) == 0 {
+		return nil
+	}
+
+	return record(it.ids[0])
+}
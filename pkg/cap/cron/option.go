@@ -0,0 +1,42 @@
+package cron
+
+import (
+	"time"
+
+	"github.com/lthibault/log"
+)
+
+type Option func(*Server)
+
+// WithLogger sets the logger for the cron server.  If l == nil, a
+// default logger is used.
+func WithLogger(l log.Logger) Option {
+	if l == nil {
+		l = log.New()
+	}
+
+	return func(c *Server) {
+		c.log = l
+	}
+}
+
+// WithInterval sets the interval at which the host checks for due
+// entries.  Runs that are more than two intervals late are handled
+// according to the entry's missed-run policy.  If d <= 0, a default of
+// ten seconds is used.
+func WithInterval(d time.Duration) Option {
+	if d <= 0 {
+		d = 10 * time.Second
+	}
+
+	return func(c *Server) {
+		c.interval = d
+	}
+}
+
+func withDefault(opt []Option) []Option {
+	return append([]Option{
+		WithLogger(nil),
+		WithInterval(0),
+	}, opt...)
+}
@@ -0,0 +1,178 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression.  Schedules are evaluated in
+// UTC, so that all hosts agree on when entries are due.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64 // bitsets
+
+	// Per POSIX, if both day fields are restricted, a time matches if
+	// either of them does.
+	domStar, dowStar bool
+}
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type bounds struct{ min, max uint }
+
+var (
+	minutes = bounds{0, 59}
+	hours   = bounds{0, 23}
+	doms    = bounds{1, 31}
+	months  = bounds{1, 12}
+	dows    = bounds{0, 7} // 0 and 7 are both Sunday
+)
+
+// Parse a standard five-field cron expression (minute, hour, day of
+// month, month, day of week), or one of the macros @yearly, @monthly,
+// @weekly, @daily and @hourly.  Fields may contain lists, ranges and
+// steps, e.g. "0,30 9-17 * * 1-5" or "*/15 * * * *".
+func Parse(expr string) (s Schedule, err error) {
+	if m, ok := macros[strings.TrimSpace(expr)]; ok {
+		expr = m
+	}
+
+	fs := strings.Fields(expr)
+	if len(fs) != 5 {
+		return s, fmt.Errorf("expected 5 fields, got %d", len(fs))
+	}
+
+	if s.minute, err = parseField(fs[0], minutes); err != nil {
+		return s, fmt.Errorf("minute: %w", err)
+	}
+
+	if s.hour, err = parseField(fs[1], hours); err != nil {
+		return s, fmt.Errorf("hour: %w", err)
+	}
+
+	if s.dom, err = parseField(fs[2], doms); err != nil {
+		return s, fmt.Errorf("day of month: %w", err)
+	}
+
+	if s.month, err = parseField(fs[3], months); err != nil {
+		return s, fmt.Errorf("month: %w", err)
+	}
+
+	if s.dow, err = parseField(fs[4], dows); err != nil {
+		return s, fmt.Errorf("day of week: %w", err)
+	}
+
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1 // Sunday
+	}
+
+	s.domStar = strings.HasPrefix(fs[2], "*")
+	s.dowStar = strings.HasPrefix(fs[4], "*")
+	return s, nil
+}
+
+func parseField(field string, b bounds) (set uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		lo, hi, step := b.min, b.max, uint(1)
+
+		rng := part
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			if step, err = parseUint(part[i+1:]); err != nil || step == 0 {
+				return 0, fmt.Errorf("invalid step: %s", part)
+			}
+			rng = part[:i]
+		}
+
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			l, h, _ := strings.Cut(rng, "-")
+			if lo, err = parseUint(l); err != nil {
+				return 0, err
+			}
+			if hi, err = parseUint(h); err != nil {
+				return 0, err
+			}
+		default:
+			if lo, err = parseUint(rng); err != nil {
+				return 0, err
+			}
+			if rng == part {
+				hi = lo // plain value; "5/10" ranges from 5 to max
+			}
+		}
+
+		if lo < b.min || hi > b.max || lo > hi {
+			return 0, fmt.Errorf("out of range: %s", part)
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+
+	return set, nil
+}
+
+func parseUint(s string) (uint, error) {
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, errors.New("invalid number: " + s)
+	}
+
+	return uint(n), nil
+}
+
+// Next returns the first time after t that matches the schedule, or
+// the zero time if there is none within five years, e.g. for Feb 30.
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	end := t.AddDate(5, 0, 0)
+
+	for t.Before(end) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
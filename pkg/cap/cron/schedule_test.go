@@ -0,0 +1,61 @@
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/ww/pkg/cap/cron"
+)
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	// Friday
+	start := time.Date(2021, time.January, 1, 10, 30, 15, 0, time.UTC)
+
+	for _, tt := range []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2021, 1, 1, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2021, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"0,30 9-17 * * *", time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2021, 1, 1, 10, 45, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * 1-5", time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 12 * * 1", time.Date(2021, 1, 4, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// day of month OR day of week, when both are restricted
+		{"0 0 15 * 1", time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	} {
+		s, err := cron.Parse(tt.expr)
+		require.NoError(t, err, tt.expr)
+		assert.Equal(t, tt.want, s.Next(start), tt.expr)
+	}
+
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		_, err := cron.Parse(expr)
+		assert.Error(t, err, "%q should be invalid", expr)
+	}
+}
@@ -0,0 +1,66 @@
+package host
+
+import (
+	"context"
+
+	api "github.com/wetware/ww/internal/api/host"
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/cron"
+	"github.com/wetware/ww/pkg/cap/proc"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
+	"github.com/wetware/ww/pkg/cap/sched"
+)
+
+// Host is the bootstrap capability of a wetware host.  Its methods
+// return promises, so that calls to a service can be pipelined with
+// the request for it.  Callers MUST release the returned capabilities.
+type Host api.Host
+
+func (h Host) View(ctx context.Context) clcap.View {
+	f, release := api.Host(h).View(ctx, nil)
+	defer release()
+
+	return clcap.View{Client: f.View().Client().AddRef()}
+}
+
+func (h Host) PubSub(ctx context.Context) pscap.PubSub {
+	f, release := api.Host(h).Pubsub(ctx, nil)
+	defer release()
+
+	return pscap.PubSub{Client: f.Pubsub().Client().AddRef()}
+}
+
+// Anchor returns the root of the host's anchor tree.
+func (h Host) Anchor(ctx context.Context) clcap.Register {
+	f, release := api.Host(h).Anchor(ctx, nil)
+	defer release()
+
+	return clcap.Register{Client: f.Anchor().Client().AddRef()}
+}
+
+func (h Host) Executor(ctx context.Context) proc.Executor {
+	f, release := api.Host(h).Executor(ctx, nil)
+	defer release()
+
+	return proc.Executor{Client: f.Executor().Client().AddRef()}
+}
+
+func (h Host) Scheduler(ctx context.Context) sched.Scheduler {
+	f, release := api.Host(h).Scheduler(ctx, nil)
+	defer release()
+
+	return sched.Scheduler{Client: f.Scheduler().Client().AddRef()}
+}
+
+func (h Host) Cron(ctx context.Context) cron.Cron {
+	f, release := api.Host(h).Cron(ctx, nil)
+	defer release()
+
+	return cron.Cron{Client: f.Cron().Client().AddRef()}
+}
+
+func (h Host) AddRef() Host {
+	return Host(api.Host(h).AddRef())
+}
+
+func (h Host) Release() { h.Client.Release() }
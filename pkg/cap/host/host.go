@@ -0,0 +1,113 @@
+// Package host provides the bootstrap capability of a wetware host,
+// which gives access to each of the host's services over a single
+// RPC connection.
+package host
+
+import (
+	"context"
+	"errors"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/server"
+
+	api "github.com/wetware/ww/internal/api/host"
+	"github.com/wetware/ww/pkg/vat"
+)
+
+var Capability = vat.BasicCap{
+	Name:     "host",
+	Versions: []string{"0.1.0"}}
+
+// ErrUnavailable is returned when a service is not provided by the
+// host.
+var ErrUnavailable = errors.New("service unavailable")
+
+var defaultPolicy = server.Policy{
+	MaxConcurrentCalls: 64,
+}
+
+// Services provided by a host.  Any of them MAY be nil, in which case
+// requests for the corresponding capability fail with ErrUnavailable.
+type Services struct {
+	View      vat.ClientProvider
+	PubSub    vat.ClientProvider
+	Anchor    vat.ClientProvider
+	Executor  vat.ClientProvider
+	Scheduler vat.ClientProvider
+	Cron      vat.ClientProvider
+}
+
+// Server provides the Host capability, and implements
+// vat.ClientProvider.
+type Server struct{ svc Services }
+
+func New(svc Services) *Server {
+	return &Server{svc: svc}
+}
+
+func (s *Server) Client() *capnp.Client {
+	return api.Host_ServerToClient(s, &defaultPolicy).Client
+}
+
+func (s *Server) View(_ context.Context, call api.Host_view) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = provide(res.Struct, res.SetView, s.svc.View)
+	}
+
+	return err
+}
+
+func (s *Server) Pubsub(_ context.Context, call api.Host_pubsub) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = provide(res.Struct, res.SetPubsub, s.svc.PubSub)
+	}
+
+	return err
+}
+
+func (s *Server) Anchor(_ context.Context, call api.Host_anchor) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = provide(res.Struct, res.SetAnchor, s.svc.Anchor)
+	}
+
+	return err
+}
+
+func (s *Server) Executor(_ context.Context, call api.Host_executor) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = provide(res.Struct, res.SetExecutor, s.svc.Executor)
+	}
+
+	return err
+}
+
+func (s *Server) Scheduler(_ context.Context, call api.Host_scheduler) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = provide(res.Struct, res.SetScheduler, s.svc.Scheduler)
+	}
+
+	return err
+}
+
+func (s *Server) Cron(_ context.Context, call api.Host_cron) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = provide(res.Struct, res.SetCron, s.svc.Cron)
+	}
+
+	return err
+}
+
+func provide(res capnp.Struct, set func(capnp.Ptr) error, p vat.ClientProvider) error {
+	if p == nil {
+		return ErrUnavailable
+	}
+
+	seg := res.Segment()
+	return set(capnp.NewInterface(seg, seg.Message().AddCap(p.Client())).ToPtr())
+}
@@ -0,0 +1,45 @@
+package host_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/host"
+)
+
+func TestHost(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	h := host.Host{Client: host.New(host.Services{
+		Anchor: clcap.NewHost(nil),
+	}).Client()}
+	defer h.Release()
+
+	root := h.Anchor(ctx)
+	defer root.Client.Release()
+
+	// Calls are pipelined with the request for the service.
+	r, release := root.Walk(ctx, []string{"foo"})
+	defer release()
+
+	require.NoError(t, r.Set(ctx, []byte("bar")), "should call service")
+
+	data, release := r.Get(ctx)
+	defer release()
+	assert.Equal(t, "bar", string(data))
+
+	ps := h.PubSub(ctx)
+	defer ps.Release()
+
+	_, err := ps.Ls(ctx)
+	assert.ErrorContains(t, err, host.ErrUnavailable.Error(),
+		"should fail if service is not provided")
+}
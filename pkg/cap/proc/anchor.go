@@ -0,0 +1,202 @@
+package proc
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+
+	api "github.com/wetware/ww/internal/api/process"
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+)
+
+// DefaultRetention is the amount of time for which an exited process
+// remains bound to its anchor, unless otherwise specified.
+const DefaultRetention = time.Minute
+
+// Mounter is the host's anchor tree.  It is typically provided by
+// cluster.HostServer.  Process anchors are read-only for remote vats,
+// so that only the host can report a process' status.
+type Mounter interface {
+	MountReadOnly(path ...string) clcap.LocalAnchor
+}
+
+// State of a process, as reported by its anchor.
+type State uint16
+
+const (
+	Running State = State(api.Status_State_running)
+	Exited  State = State(api.Status_State_exited)
+	Failed  State = State(api.Status_State_failed)
+)
+
+func (s State) String() string { return api.Status_State(s).String() }
+
+// Status is the data stored in a process' anchor, located at
+// /<peer>/proc/<id>.
+type Status struct {
+	State     State
+	PID       int // zero for WASI modules
+	ExitCode  int // valid iff State == Exited
+	Path      string
+	Restarts  int
+	OOMKilled bool
+}
+
+func (s Status) MarshalBinary() ([]byte, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := api.NewRootStatus(seg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.bind(st); err != nil {
+		return nil, err
+	}
+
+	return msg.MarshalPacked()
+}
+
+func (s *Status) UnmarshalBinary(b []byte) error {
+	msg, err := capnp.UnmarshalPacked(b)
+	if err != nil {
+		return err
+	}
+
+	st, err := api.ReadRootStatus(msg)
+	if err != nil {
+		return err
+	}
+
+	return s.load(st)
+}
+
+func (s Status) bind(st api.Status) error {
+	st.SetState(api.Status_State(s.State))
+	st.SetPid(int64(s.PID))
+	st.SetExitCode(int32(s.ExitCode))
+	st.SetRestarts(uint32(s.Restarts))
+	st.SetOomKilled(s.OOMKilled)
+	return st.SetPath(s.Path)
+}
+
+func (s *Status) load(st api.Status) (err error) {
+	if s.Path, err = st.Path(); err == nil {
+		s.State = State(st.State())
+		s.PID = int(st.Pid())
+		s.ExitCode = int(st.ExitCode())
+		s.Restarts = int(st.Restarts())
+		s.OOMKilled = st.OomKilled()
+	}
+
+	return
+}
+
+// bind the process to the anchor at /<peer>/proc/<id>, and keep its
+// status up-to-date.  When the process exits, the anchor is retained
+// according to the server's retention policy.  Bind is a nop if the
+// server was not provided with a Mounter.
+func (e *Server) bind(p *process, s Status) {
+	if e.root == nil {
+		return
+	}
+
+	var mu sync.Mutex // guards s
+
+	p.id = strconv.FormatUint(atomic.AddUint64(&e.nextID, 1), 10)
+	a := e.root.MountReadOnly("proc", p.id)
+	e.setStatus(a, s)
+	e.register(p)
+
+	p.onRestart = func(pid int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		s.PID = pid
+		s.Restarts++
+		e.setStatus(a, s)
+	}
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer a.Remove()
+		defer e.unregister(p)
+
+		<-p.done // processes are killed when the server is closed
+
+		mu.Lock()
+		if s.State = Exited; p.err != nil {
+			s.State = Failed
+		}
+		s.ExitCode = p.status.code
+		s.OOMKilled = p.status.oomKilled
+		e.setStatus(a, s)
+		e.saveLog(p.id, s, p.logs)
+		mu.Unlock()
+
+		e.retain()
+	}()
+}
+
+// register the process, so that it can be looked up by id until it
+// is removed from the anchor tree.
+func (e *Server) register(p *process) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.procs == nil {
+		e.procs = make(map[string]*process)
+	}
+
+	e.procs[p.id] = p
+}
+
+func (e *Server) unregister(p *process) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	delete(e.procs, p.id)
+}
+
+func (e *Server) lookup(id string) (p *process, ok bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, ok = e.procs[id]
+	return
+}
+
+func (e *Server) setStatus(a clcap.LocalAnchor, s Status) {
+	b, err := s.MarshalBinary()
+	if err != nil {
+		e.log.WithError(err).Error("failed to marshal process status")
+		return
+	}
+
+	a.Set(b)
+}
+
+// retain blocks until the retention period has elapsed, or until the
+// server is closed.
+func (e *Server) retain() {
+	switch {
+	case e.retention == 0:
+	case e.retention < 0:
+		<-e.cq
+	default:
+		t := time.NewTimer(e.retention)
+		defer t.Stop()
+
+		select {
+		case <-t.C:
+		case <-e.cq:
+		}
+	}
+}
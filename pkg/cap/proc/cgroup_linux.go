@@ -0,0 +1,281 @@
+//go:build linux
+
+package proc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"github.com/lthibault/log"
+)
+
+const (
+	cgroupPeriod   = 100000 // cpu.max period, in microseconds
+	cgroupMinQuota = 1000   // smallest cpu.max quota accepted by the kernel
+)
+
+// controllers that are enabled for the processes' cgroups.
+var controllers = []string{"cpu", "memory", "pids"}
+
+// cgroups manages the cgroup v2 hierarchy that has been delegated to
+// the executor.  Each process instance with non-zero limits is placed
+// in its own child cgroup.
+type cgroups struct {
+	log  log.Logger
+	root string   // empty if cgroups are unavailable
+	shim []string // command that holds processes; see WithShim
+	next uint64   // atomic
+}
+
+// newCgroups prepares the cgroup at root for use by the executor.  If
+// root is empty, or the cgroup is unavailable, e.g. because it was not
+// delegated to the current user, limits are not enforced.  Limits are
+// likewise not enforced if shim is empty, since processes could not be
+// held until they have been added to their cgroup.
+func newCgroups(log log.Logger, root string, shim []string) *cgroups {
+	cg := &cgroups{log: log, shim: shim}
+
+	if root == "" {
+		log.Debug("no cgroup configured")
+		return cg
+	}
+
+	if len(shim) == 0 {
+		log.WithField("cgroup", root).
+			Warn("no shim configured; limits will not be enforced")
+		return cg
+	}
+
+	if err := delegate(root); err != nil {
+		log.WithError(err).
+			WithField("cgroup", root).
+			Warn("cgroups v2 unavailable; limits will not be enforced")
+		return cg
+	}
+
+	cg.root = root
+	return cg
+}
+
+// create a cgroup for a process instance.  The returned cgroup is nil
+// if lim.IsZero(), or if cgroups are unavailable.
+func (cg *cgroups) create(lim Limits) (*cgroup, error) {
+	if lim.IsZero() {
+		return nil, nil
+	}
+
+	if cg.root == "" {
+		cg.log.Warn("cgroups v2 unavailable; limits will not be enforced")
+		return nil, nil
+	}
+
+	name := "proc-" + strconv.FormatUint(atomic.AddUint64(&cg.next, 1), 10)
+	c := &cgroup{path: filepath.Join(cg.root, name), shim: cg.shim}
+	if err := os.Mkdir(c.path, 0755); err != nil {
+		return nil, fmt.Errorf("cgroup: %w", err)
+	}
+
+	if err := c.limit(lim); err != nil {
+		c.remove()
+		return nil, fmt.Errorf("cgroup: %w", err)
+	}
+
+	return c, nil
+}
+
+// cgroup for a single process instance.  Methods are nops on a nil
+// cgroup.
+type cgroup struct {
+	path string
+	shim []string
+}
+
+func (c *cgroup) limit(lim Limits) error {
+	if lim.CPU > 0 {
+		quota := int(lim.CPU * cgroupPeriod)
+		if quota < cgroupMinQuota {
+			quota = cgroupMinQuota
+		}
+
+		if err := c.write("cpu.max", fmt.Sprintf("%d %d", quota, cgroupPeriod)); err != nil {
+			return err
+		}
+	}
+
+	if lim.Memory > 0 {
+		if err := c.write("memory.max", strconv.FormatUint(lim.Memory, 10)); err != nil {
+			return err
+		}
+
+		// Kill the whole process tree when the limit is exceeded.
+		if err := c.write("memory.oom.group", "1"); err != nil {
+			return err
+		}
+	}
+
+	if lim.PIDs > 0 {
+		return c.write("pids.max", strconv.FormatUint(uint64(lim.PIDs), 10))
+	}
+
+	return nil
+}
+
+// add the process to the cgroup.
+func (c *cgroup) add(pid int) error {
+	if c == nil {
+		return nil
+	}
+
+	return c.write("cgroup.procs", strconv.Itoa(pid))
+}
+
+// oomKilled returns true if any process in the cgroup was killed for
+// exceeding the memory limit.
+func (c *cgroup) oomKilled() bool {
+	if c == nil {
+		return false
+	}
+
+	b, err := os.ReadFile(filepath.Join(c.path, "memory.events"))
+	if err != nil {
+		return false
+	}
+
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		if f := strings.Fields(s.Text()); len(f) == 2 && f[0] == "oom_kill" {
+			return f[1] != "0"
+		}
+	}
+
+	return false
+}
+
+// remove the cgroup, killing any processes that are left in it.
+func (c *cgroup) remove() error {
+	if c == nil {
+		return nil
+	}
+
+	_ = c.write("cgroup.kill", "1") // requires Linux 5.14
+	return os.Remove(c.path)
+}
+
+// write to a cgroup interface file.  Interface files are created by
+// the kernel, so write fails if the file does not exist.
+func (c *cgroup) write(file, value string) error {
+	f, err := os.OpenFile(filepath.Join(c.path, file), os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.WriteString(value); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// delegate enables controllers for the children of root.  Cgroups
+// that contain processes cannot enable controllers for their children,
+// so root MUST be a cgroup that is dedicated to the executor.
+func delegate(root string) error {
+	c := cgroup{path: root}
+
+	available, err := os.ReadFile(filepath.Join(root, "cgroup.controllers"))
+	if err != nil {
+		return err
+	}
+
+	var enable []string
+	for _, ctrl := range controllers {
+		for _, a := range strings.Fields(string(available)) {
+			if a == ctrl {
+				enable = append(enable, "+"+ctrl)
+			}
+		}
+	}
+
+	if len(enable) == 0 {
+		return errors.New("no controllers available")
+	}
+
+	err = c.write("cgroup.subtree_control", strings.Join(enable, " "))
+	if errors.Is(err, syscall.EBUSY) {
+		return errors.New("cgroup contains processes")
+	}
+
+	return err
+}
+
+// hold cmd until it has been added to the cgroup.  Cmd is started by
+// executing the shim command, which blocks on a pipe before it executes
+// cmd.Path.  The returned function MUST be called after cmd has been
+// started; if run is false, the process exits without having executed
+// cmd.Path.  Hold is a nop on a nil cgroup.
+//
+// NOTE:  use SysProcAttr.CgroupFD once the minimum Go version is 1.20.
+func (c *cgroup) hold(cmd *exec.Cmd) (release func(run bool), err error) {
+	if c == nil {
+		return func(bool) {}, nil
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.ExtraFiles = append(cmd.ExtraFiles, r)
+
+	args := append([]string{}, c.shim...)
+	args = append(args, strconv.Itoa(2+len(cmd.ExtraFiles)), cmd.Path)
+	cmd.Path, cmd.Args = c.shim[0], append(args, cmd.Args...)
+
+	return func(run bool) {
+		r.Close()
+		if run {
+			w.Write([]byte{0})
+		}
+		w.Close()
+	}, nil
+}
+
+// Shim is the entrypoint of the command that holds a process until it
+// has been added to its cgroup (see WithShim).  Args holds the file
+// descriptor on which to wait, followed by the path and arguments of
+// the program to execute.  Shim does not return.
+func Shim(args []string) {
+	if len(args) < 3 {
+		fmt.Fprintln(os.Stderr, "shim: missing arguments")
+		os.Exit(126)
+	}
+
+	fd, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "shim: invalid fd: %v\n", err)
+		os.Exit(126)
+	}
+
+	// The host closes the pipe without writing if the process could
+	// not be added to its cgroup.
+	f := os.NewFile(uintptr(fd), "shim")
+	if _, err = io.ReadFull(f, make([]byte, 1)); err != nil {
+		os.Exit(126)
+	}
+	f.Close()
+
+	err = syscall.Exec(args[1], args[2:], os.Environ())
+	fmt.Fprintf(os.Stderr, "shim: %s: %v\n", args[1], err)
+	os.Exit(127)
+}
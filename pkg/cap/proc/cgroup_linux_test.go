@@ -0,0 +1,172 @@
+package proc
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lthibault/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testShimArg causes the test binary to act as the shim.  See hold.
+const testShimArg = "__shim"
+
+func TestMain(m *testing.M) {
+	if len(os.Args) > 1 && os.Args[1] == testShimArg {
+		Shim(os.Args[2:])
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestCgroup(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Delegate", func(t *testing.T) {
+		t.Parallel()
+
+		root := fakeCgroup(t, map[string]string{
+			"cgroup.controllers":     "cpuset cpu io memory",
+			"cgroup.subtree_control": "",
+		})
+
+		err := delegate(root)
+		require.NoError(t, err, "should enable controllers")
+		assert.Equal(t, "+cpu +memory", readFile(t, root, "cgroup.subtree_control"),
+			"should enable available controllers")
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		t.Parallel()
+
+		c := &cgroup{path: fakeCgroup(t, map[string]string{
+			"cpu.max":          "",
+			"memory.max":       "",
+			"memory.oom.group": "",
+			"pids.max":         "",
+		})}
+
+		err := c.limit(Limits{CPU: 1.5, Memory: 1 << 20, PIDs: 8})
+		require.NoError(t, err, "should set limits")
+
+		assert.Equal(t, "150000 100000", readFile(t, c.path, "cpu.max"))
+		assert.Equal(t, "1048576", readFile(t, c.path, "memory.max"))
+		assert.Equal(t, "1", readFile(t, c.path, "memory.oom.group"))
+		assert.Equal(t, "8", readFile(t, c.path, "pids.max"))
+
+		c = &cgroup{path: fakeCgroup(t, map[string]string{"cpu.max": ""})}
+		err = c.limit(Limits{CPU: 0.001})
+		require.NoError(t, err, "should set limits")
+		assert.Equal(t, "1000 100000", readFile(t, c.path, "cpu.max"),
+			"should round quota up to kernel minimum")
+	})
+
+	t.Run("Hold", func(t *testing.T) {
+		t.Parallel()
+
+		c := &cgroup{
+			path: fakeCgroup(t, map[string]string{"cgroup.procs": ""}),
+			shim: testShim(t),
+		}
+
+		var stdout bytes.Buffer
+		cmd := exec.Command("/bin/sh", "-c", "echo ran")
+		cmd.Stdout = &stdout
+
+		release, err := c.hold(cmd)
+		require.NoError(t, err, "should hold process")
+		require.NoError(t, cmd.Start(), "should start process")
+
+		time.Sleep(100 * time.Millisecond)
+		assert.Empty(t, stdout.String(), "should not run before release")
+
+		require.NoError(t, c.add(cmd.Process.Pid), "should add process")
+		release(true)
+
+		require.NoError(t, cmd.Wait(), "should run process")
+		assert.Equal(t, "ran\n", stdout.String(), "should execute command")
+		assert.Equal(t, strconv.Itoa(cmd.Process.Pid), readFile(t, c.path, "cgroup.procs"),
+			"held process should be added to cgroup")
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		t.Parallel()
+
+		c := &cgroup{path: t.TempDir(), shim: testShim(t)}
+
+		var stdout bytes.Buffer
+		cmd := exec.Command("/bin/sh", "-c", "echo ran")
+		cmd.Stdout = &stdout
+
+		release, err := c.hold(cmd)
+		require.NoError(t, err, "should hold process")
+		require.NoError(t, cmd.Start(), "should start process")
+		release(false)
+
+		assert.Error(t, cmd.Wait(), "should report failure")
+		assert.Empty(t, stdout.String(), "should not execute command")
+	})
+
+	t.Run("OOMKilled", func(t *testing.T) {
+		t.Parallel()
+
+		c := &cgroup{path: fakeCgroup(t, map[string]string{
+			"memory.events": "low 0\nhigh 0\nmax 2\noom 1\noom_kill 1\n",
+		})}
+		assert.True(t, c.oomKilled(), "should report OOM kill")
+
+		c = &cgroup{path: fakeCgroup(t, map[string]string{
+			"memory.events": "low 0\nhigh 0\nmax 0\noom 0\noom_kill 0\n",
+		})}
+		assert.False(t, c.oomKilled(), "should not report OOM kill")
+
+		assert.False(t, (*cgroup)(nil).oomKilled(), "nil cgroup should be nop")
+	})
+
+	t.Run("Unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		cg := newCgroups(log.New(), "", testShim(t))
+		c, err := cg.create(Limits{Memory: 1 << 20})
+		require.NoError(t, err, "should fall back to nop")
+		assert.Nil(t, c, "should not create cgroup")
+
+		root := fakeCgroup(t, map[string]string{
+			"cgroup.controllers":     "cpu memory",
+			"cgroup.subtree_control": "",
+		})
+		cg = newCgroups(log.New(), root, nil)
+		c, err = cg.create(Limits{Memory: 1 << 20})
+		require.NoError(t, err, "should fall back to nop")
+		assert.Nil(t, c, "should not create cgroup without shim")
+	})
+}
+
+func testShim(t *testing.T) []string {
+	self, err := os.Executable()
+	require.NoError(t, err, "should find test binary")
+	return []string{self, testShimArg}
+}
+
+func fakeCgroup(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		require.NoError(t, err, "should create interface file")
+	}
+
+	return dir
+}
+
+func readFile(t *testing.T, dir, name string) string {
+	b, err := os.ReadFile(filepath.Join(dir, name))
+	require.NoError(t, err, "should read interface file")
+	return string(b)
+}
@@ -0,0 +1,40 @@
+//go:build !linux
+
+package proc
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/lthibault/log"
+)
+
+// cgroups are only supported on Linux.  Limits are ignored on other
+// platforms.
+type cgroups struct{ log log.Logger }
+
+func newCgroups(log log.Logger, _ string, _ []string) *cgroups {
+	return &cgroups{log: log}
+}
+
+func (cg *cgroups) create(lim Limits) (*cgroup, error) {
+	if !lim.IsZero() {
+		cg.log.Warn("cgroups unsupported on this platform; limits will not be enforced")
+	}
+
+	return nil, nil
+}
+
+type cgroup struct{}
+
+func (*cgroup) hold(*exec.Cmd) (func(bool), error) { return func(bool) {}, nil }
+func (*cgroup) add(int) error                      { return nil }
+func (*cgroup) oomKilled() bool                    { return false }
+func (*cgroup) remove() error                      { return nil }
+
+// Shim is never executed on platforms without cgroups.
+func Shim([]string) {
+	fmt.Fprintln(os.Stderr, "shim: unsupported on this platform")
+	os.Exit(126)
+}
@@ -0,0 +1,290 @@
+package proc
+
+import (
+	"context"
+	"io"
+	"syscall"
+
+	"capnproto.org/go/capnp/v3"
+
+	api "github.com/wetware/ww/internal/api/process"
+)
+
+// Command describes a process to be executed on a remote host.
+type Command struct {
+	Path string
+	Args []string
+	Env  []string // KEY=VALUE pairs; the host's env is not inherited
+	Dir  string   // if empty, the host's working directory is used
+
+	Restart RestartPolicy
+	Limits  Limits
+
+	// Caps are passed to the process over an inherited RPC connection.
+	// The process can retrieve them with client.Inherit.  Commands do
+	// not take ownership of their Caps.
+	Caps map[string]*capnp.Client
+}
+
+func (cmd Command) bind(c api.Command) (err error) {
+	if err = c.SetPath(cmd.Path); err != nil {
+		return
+	}
+
+	if err = c.SetDir(cmd.Dir); err != nil {
+		return
+	}
+
+	if err = bindTextList(cmd.Args, c.NewArgs); err != nil {
+		return
+	}
+
+	if err = bindTextList(cmd.Env, c.NewEnv); err != nil {
+		return
+	}
+
+	r, err := c.NewRestart()
+	if err != nil {
+		return
+	}
+	cmd.Restart.bind(r)
+
+	lim, err := c.NewLimits()
+	if err != nil {
+		return
+	}
+	cmd.Limits.bind(lim)
+
+	return capSet(cmd.Caps).bind(c.NewCaps)
+}
+
+// Bind the command to a capnp struct.  It is used by capabilities
+// that embed commands, such as the scheduler.
+func (cmd Command) Bind(c api.Command) error { return cmd.bind(c) }
+
+func (cmd *Command) load(c api.Command) (err error) {
+	if cmd.Path, err = c.Path(); err != nil {
+		return
+	}
+
+	if cmd.Dir, err = c.Dir(); err != nil {
+		return
+	}
+
+	if cmd.Args, err = loadTextList(c.Args); err != nil {
+		return
+	}
+
+	if cmd.Env, err = loadTextList(c.Env); err != nil {
+		return
+	}
+
+	if c.HasRestart() {
+		var r api.RestartPolicy
+		if r, err = c.Restart(); err != nil {
+			return
+		}
+		cmd.Restart.load(r)
+	}
+
+	if c.HasLimits() {
+		var lim api.Limits
+		if lim, err = c.Limits(); err != nil {
+			return
+		}
+		cmd.Limits.load(lim)
+	}
+
+	return (*capSet)(&cmd.Caps).load(c.Caps)
+}
+
+func bindTextList(ss []string, alloc func(int32) (capnp.TextList, error)) error {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	l, err := alloc(int32(len(ss)))
+	if err != nil {
+		return err
+	}
+
+	for i, s := range ss {
+		if err = l.Set(i, s); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+func loadTextList(get func() (capnp.TextList, error)) ([]string, error) {
+	l, err := get()
+	if err != nil || l.Len() == 0 {
+		return nil, err
+	}
+
+	ss := make([]string, l.Len())
+	for i := range ss {
+		if ss[i], err = l.At(i); err != nil {
+			break
+		}
+	}
+
+	return ss, err
+}
+
+type Executor api.Executor
+
+// Exec spawns a process on the remote host.  The process' standard
+// output and error are streamed to stdout and stderr, respectively.
+// Either MAY be nil, in which case the corresponding output is
+// discarded.
+//
+// The process is killed when the returned release function is called,
+// if it has not already exited.
+func (e Executor) Exec(ctx context.Context, cmd Command, stdout, stderr io.Writer) (Process, capnp.ReleaseFunc) {
+	f, release := api.Executor(e).Exec(ctx, func(ps api.Executor_exec_Params) error {
+		c, err := ps.NewCmd()
+		if err != nil {
+			return err
+		}
+
+		if err = cmd.bind(c); err != nil {
+			return err
+		}
+
+		if stdout != nil {
+			if err = ps.SetStdout(newWriter(stdout)); err != nil {
+				return err
+			}
+		}
+
+		if stderr != nil {
+			err = ps.SetStderr(newWriter(stderr))
+		}
+
+		return err
+	})
+
+	return Process(f.Proc()), release
+}
+
+// Lookup the process bound to /<peer>/proc/<id>.  Unlike Exec, the
+// process is not killed when the returned release function is called.
+func (e Executor) Lookup(ctx context.Context, id string) (Process, capnp.ReleaseFunc) {
+	f, release := api.Executor(e).Lookup(ctx, func(ps api.Executor_lookup_Params) error {
+		return ps.SetId(id)
+	})
+
+	return Process(f.Proc()), release
+}
+
+func (e Executor) AddRef() Executor {
+	return Executor(api.Executor(e).AddRef())
+}
+
+func (e Executor) Release() { e.Client.Release() }
+
+func newWriter(w io.Writer) api.Writer {
+	return api.Writer_ServerToClient(writeServer{w}, &defaultPolicy)
+}
+
+type Process api.Process
+
+// Wait blocks until the process has exited, and returns its exit code.
+func (p Process) Wait(ctx context.Context) (int, error) {
+	status, err := p.WaitStatus(ctx)
+	return status.Code, err
+}
+
+// ExitStatus of a process.
+type ExitStatus struct {
+	Code      int
+	OOMKilled bool // killed for exceeding its memory limit
+}
+
+// WaitStatus is like Wait, but also reports whether the process was
+// killed for exceeding its memory limit.
+func (p Process) WaitStatus(ctx context.Context) (ExitStatus, error) {
+	f, release := api.Process(p).Wait(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return ExitStatus{}, err
+	}
+
+	return ExitStatus{
+		Code:      int(res.ExitCode()),
+		OOMKilled: res.OomKilled(),
+	}, nil
+}
+
+// Kill sends a signal to the process.  If sig == 0, SIGKILL is sent.
+func (p Process) Kill(ctx context.Context, sig syscall.Signal) error {
+	f, release := api.Process(p).Kill(ctx, func(ps api.Process_kill_Params) error {
+		ps.SetSignal(int32(sig))
+		return nil
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+// Stdin returns a writer that streams data to the process' standard
+// input.  Closing the writer closes the process' standard input.
+func (p Process) Stdin(ctx context.Context) io.WriteCloser {
+	f, release := api.Process(p).Stdin(ctx, nil)
+	defer release()
+
+	return writer{ctx: ctx, w: f.Stdin().AddRef()}
+}
+
+// ID returns the name of the anchor to which the process is bound,
+// i.e. /<peer>/proc/<id>.  It is empty if the host does not bind
+// processes to anchors.
+func (p Process) ID(ctx context.Context) (string, error) {
+	f, release := api.Process(p).Id(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return "", err
+	}
+
+	return res.Id()
+}
+
+// Logs writes the output retained by the host to stdout and stderr,
+// either of which MAY be nil.  If tail > 0, only the last 'tail' lines
+// are written.  If follow is true, Logs streams new output until the
+// process exits, or until the context expires.
+func (p Process) Logs(ctx context.Context, follow bool, tail int, stdout, stderr io.Writer) error {
+	f, release := api.Process(p).Logs(ctx, func(ps api.Process_logs_Params) error {
+		ps.SetFollow(follow)
+		ps.SetTail(uint32(tail))
+
+		if stdout != nil {
+			if err := ps.SetStdout(newWriter(stdout)); err != nil {
+				return err
+			}
+		}
+
+		if stderr != nil {
+			return ps.SetStderr(newWriter(stderr))
+		}
+
+		return nil
+	})
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+func (p Process) AddRef() Process {
+	return Process(api.Process(p).AddRef())
+}
+
+func (p Process) Release() { p.Client.Release() }
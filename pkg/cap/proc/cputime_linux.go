@@ -0,0 +1,30 @@
+//go:build linux
+
+package proc
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// threadClock returns a function that reports the CPU time consumed
+// by the calling OS thread.  The caller MUST be locked to its thread.
+func threadClock() (func() (time.Duration, error), error) {
+	// See MAKE_THREAD_CPUCLOCK in the Linux kernel's posix-timers.h.
+	const (
+		perThread = 4
+		sched     = 2
+	)
+
+	clock := int32(^unix.Gettid()<<3 | perThread | sched)
+
+	return func() (time.Duration, error) {
+		var ts unix.Timespec
+		if err := unix.ClockGettime(clock, &ts); err != nil {
+			return 0, err
+		}
+
+		return time.Duration(ts.Nano()), nil
+	}, nil
+}
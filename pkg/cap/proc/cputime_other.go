@@ -0,0 +1,13 @@
+//go:build !linux
+
+package proc
+
+import (
+	"errors"
+	"time"
+)
+
+// CPU time limits are only supported on Linux.
+func threadClock() (func() (time.Duration, error), error) {
+	return nil, errors.New("unsupported on this platform")
+}
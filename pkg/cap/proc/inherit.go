@@ -0,0 +1,196 @@
+package proc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"syscall"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+
+	api "github.com/wetware/ww/internal/api/process"
+)
+
+// EnvRPCFD is the environment variable containing the file descriptor
+// of the RPC connection inherited by a process, if any.
+const EnvRPCFD = "WW_RPC_FD"
+
+// CapSet is the bootstrap capability of a process' inherited RPC
+// connection.  It contains the capabilities passed to the process
+// through Command.Caps.
+type CapSet api.CapSet
+
+// Lookup returns the capability with the specified name.  The returned
+// client is pipelined; errors are reported when it is first used.
+func (cs CapSet) Lookup(ctx context.Context, name string) *capnp.Client {
+	f, release := api.CapSet(cs).Lookup(ctx, func(ps api.CapSet_lookup_Params) error {
+		return ps.SetName(name)
+	})
+	defer release()
+
+	return f.Cap().Client().AddRef()
+}
+
+// Ls returns the names of the capabilities in the set.
+func (cs CapSet) Ls(ctx context.Context) ([]string, error) {
+	f, release := api.CapSet(cs).Ls(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	return loadTextList(res.Names)
+}
+
+func (cs CapSet) AddRef() CapSet {
+	return CapSet(api.CapSet(cs).AddRef())
+}
+
+func (cs CapSet) Release() { cs.Client.Release() }
+
+// capSet is the server implementation of CapSet.  It owns the
+// references to its clients.
+type capSet map[string]*capnp.Client
+
+func (cs capSet) bind(alloc func(int32) (api.CapSet_Entry_List, error)) error {
+	if len(cs) == 0 {
+		return nil
+	}
+
+	es, err := alloc(int32(len(cs)))
+	if err != nil {
+		return err
+	}
+
+	var i int
+	for name, c := range cs {
+		if err = es.At(i).SetName(name); err != nil {
+			return err
+		}
+
+		if err = es.At(i).SetCap(capPtr(es.Segment(), c.AddRef())); err != nil {
+			return err
+		}
+
+		i++
+	}
+
+	return nil
+}
+
+func (cs *capSet) load(get func() (api.CapSet_Entry_List, error)) error {
+	es, err := get()
+	if err != nil || es.Len() == 0 {
+		return err
+	}
+
+	*cs = make(capSet, es.Len())
+	for i := 0; i < es.Len(); i++ {
+		name, err := es.At(i).Name()
+		if err != nil {
+			return err
+		}
+
+		ptr, err := es.At(i).Cap()
+		if err != nil {
+			return err
+		}
+
+		(*cs)[name] = ptr.Interface().Client().AddRef()
+	}
+
+	return nil
+}
+
+// Release the capabilities in the set.
+func (cs capSet) Release() {
+	for _, c := range cs {
+		c.Release()
+	}
+}
+
+func (cs capSet) Lookup(_ context.Context, call api.CapSet_lookup) error {
+	name, err := call.Args().Name()
+	if err != nil {
+		return err
+	}
+
+	c, ok := cs[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoCap, name)
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetCap(capPtr(res.Segment(), c.AddRef()))
+}
+
+func (cs capSet) Ls(_ context.Context, call api.CapSet_ls) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(cs))
+	for name := range cs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return bindTextList(names, res.NewNames)
+}
+
+func capPtr(seg *capnp.Segment, c *capnp.Client) capnp.Ptr {
+	return capnp.NewInterface(seg, seg.Message().AddCap(c)).ToPtr()
+}
+
+// inherit passes an RPC connection to cmd, whose bootstrap capability
+// serves cs.  The connection is inherited as an extra file, whose
+// descriptor is set in the EnvRPCFD environment variable.  The caller
+// MUST call the returned function after starting cmd, and MUST close
+// the connection when the process has exited.
+func inherit(cmd *exec.Cmd, cs capSet) (*rpc.Conn, func(), error) {
+	syscall.ForkLock.RLock()
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err == nil {
+		syscall.CloseOnExec(fds[0])
+		syscall.CloseOnExec(fds[1])
+	}
+	syscall.ForkLock.RUnlock()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("socketpair: %w", err)
+	}
+
+	host := os.NewFile(uintptr(fds[0]), "rpc")
+	defer host.Close()
+
+	child := os.NewFile(uintptr(fds[1]), "rpc")
+
+	sock, err := net.FileConn(host) // dups fds[0]
+	if err != nil {
+		child.Close()
+		return nil, nil, err
+	}
+
+	cmd.ExtraFiles = append(cmd.ExtraFiles, child)
+	cmd.Env = append(cmd.Env, EnvRPCFD+"="+strconv.Itoa(2+len(cmd.ExtraFiles)))
+
+	conn := rpc.NewConn(rpc.NewPackedStreamTransport(sock), &rpc.Options{
+		BootstrapClient: api.CapSet_ServerToClient(cs, &defaultPolicy).Client,
+	})
+
+	// The child's end of the socket is duplicated into the process
+	// when it starts, and must then be closed in the host.
+	return conn, func() { child.Close() }, nil
+}
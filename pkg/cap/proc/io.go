@@ -0,0 +1,59 @@
+package proc
+
+import (
+	"context"
+	"io"
+
+	api "github.com/wetware/ww/internal/api/process"
+)
+
+// writeServer exports an io.Writer as a Writer capability.
+type writeServer struct{ io.Writer }
+
+func (w writeServer) Write(_ context.Context, call api.Writer_write) error {
+	b, err := call.Args().Data()
+	if err == nil {
+		_, err = w.Writer.Write(b)
+	}
+
+	return err
+}
+
+// Close the underlying writer, if it implements io.Closer.
+func (w writeServer) Close(context.Context, api.Writer_close) error {
+	if c, ok := w.Writer.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// writer adapts a Writer capability to io.WriteCloser.  Each call to
+// Write blocks until the remote end has acknowledged the data.
+type writer struct {
+	ctx context.Context
+	w   api.Writer
+}
+
+func (w writer) Write(b []byte) (int, error) {
+	f, release := w.w.Write(w.ctx, func(ps api.Writer_write_Params) error {
+		return ps.SetData(b)
+	})
+	defer release()
+
+	if _, err := f.Struct(); err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+func (w writer) Close() error {
+	f, release := w.w.Close(w.ctx, nil)
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+func (w writer) Release() { w.w.Client.Release() }
@@ -0,0 +1,33 @@
+package proc
+
+import api "github.com/wetware/ww/internal/api/process"
+
+// Limits on the resources consumed by a process.  Limits are enforced
+// using cgroups v2 on Linux, provided the host has been configured with
+// a cgroup (see WithCgroup).  Otherwise, they are ignored.  Zero values
+// are unlimited.  CPU limits are rounded up to 1% of one core.
+type Limits struct {
+	CPU    float64 // max CPUs, e.g. 0.5 is half of one core
+	Memory uint64  // max bytes of memory, including page cache
+	PIDs   uint32  // max number of processes and threads
+}
+
+// IsZero returns true if no limits are set.
+func (l Limits) IsZero() bool {
+	return l == Limits{}
+}
+
+// Bind the limits to a capnp struct.
+func (l Limits) Bind(lim api.Limits) { l.bind(lim) }
+
+func (l Limits) bind(lim api.Limits) {
+	lim.SetCpu(l.CPU)
+	lim.SetMemory(l.Memory)
+	lim.SetPids(l.PIDs)
+}
+
+func (l *Limits) load(lim api.Limits) {
+	l.CPU = lim.Cpu()
+	l.Memory = lim.Memory()
+	l.PIDs = lim.Pids()
+}
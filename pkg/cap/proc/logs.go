@@ -0,0 +1,367 @@
+package proc
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	api "github.com/wetware/ww/internal/api/process"
+)
+
+// DefaultLogSize is the number of bytes of output retained for each
+// process, unless otherwise specified.
+const DefaultLogSize = 1 << 20 // 1MiB
+
+// maxStoredLogs is the number of exited processes whose logs are kept
+// in the host's datastore.  Older logs are deleted.
+const maxStoredLogs = 128
+
+var logPrefix = ds.NewKey("/proc/logs")
+
+// logBuffer retains the most recent output of a process, up to a fixed
+// number of bytes.  Older output is discarded.  It is shared by all
+// instances of a supervised process.
+type logBuffer struct {
+	mu      sync.Mutex
+	max     int
+	size    int
+	seq     uint64 // number of entries ever written
+	entries []logEntry
+	closed  bool          // the process has exited
+	changed chan struct{} // closed and replaced when the buffer changes
+}
+
+type logEntry struct {
+	stream api.Log_Stream
+	data   []byte
+}
+
+func newLogBuffer(max int) *logBuffer {
+	return &logBuffer{
+		max:     max,
+		changed: make(chan struct{}),
+	}
+}
+
+// writer returns an io.Writer that appends to the buffer, and then to
+// w.  If w == nil, output is only appended to the buffer.
+func (b *logBuffer) writer(s api.Log_Stream, w io.Writer) io.Writer {
+	if w == nil {
+		return logWriter{b: b, stream: s}
+	}
+
+	return io.MultiWriter(logWriter{b: b, stream: s}, w)
+}
+
+func (b *logBuffer) append(s api.Log_Stream, p []byte) {
+	if b.max <= 0 || len(p) == 0 {
+		return
+	}
+
+	if len(p) > b.max {
+		p = p[len(p)-b.max:]
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries = append(b.entries, logEntry{
+		stream: s,
+		data:   append([]byte(nil), p...),
+	})
+	b.size += len(p)
+	b.seq++
+
+	for b.size > b.max {
+		b.size -= len(b.entries[0].data)
+		b.entries[0] = logEntry{}
+		b.entries = b.entries[1:]
+	}
+
+	b.notify()
+}
+
+// close the buffer when the process has exited.  Followers are
+// notified that no more output will be written.
+func (b *logBuffer) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.closed {
+		b.closed = true
+		b.notify()
+	}
+}
+
+func (b *logBuffer) notify() {
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
+
+// since returns the entries written after the first 'seq' entries,
+// along with the total number of entries written.  Entries that have
+// been discarded are skipped.  The returned channel is closed when
+// the buffer next changes.
+func (b *logBuffer) since(seq uint64) ([]logEntry, uint64, <-chan struct{}, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	first := b.seq - uint64(len(b.entries))
+	if seq < first {
+		seq = first
+	}
+
+	es := append([]logEntry(nil), b.entries[seq-first:]...)
+	return es, b.seq, b.changed, b.closed
+}
+
+// copy the buffered output to stdout and stderr.  If tail > 0, only
+// the last 'tail' lines are copied.  If follow is true, copy blocks
+// until the process has exited, copying output as it is written.
+func (b *logBuffer) copy(ctx context.Context, follow bool, tail int, stdout, stderr io.Writer) error {
+	es, seq, changed, closed := b.since(0)
+	if tail > 0 {
+		es = tailLines(es, tail)
+	}
+
+	for {
+		if err := writeEntries(es, stdout, stderr); err != nil {
+			return err
+		}
+
+		if !follow || closed {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		es, seq, changed, closed = b.since(seq)
+	}
+}
+
+func writeEntries(es []logEntry, stdout, stderr io.Writer) (err error) {
+	for _, e := range es {
+		w := stdout
+		if e.stream == api.Log_Stream_stderr {
+			w = stderr
+		}
+
+		if w != nil {
+			if _, err = w.Write(e.data); err != nil {
+				break
+			}
+		}
+	}
+
+	return
+}
+
+// tailLines returns the suffix of es that contains the last n lines,
+// across both streams.  A trailing newline does not begin a new line.
+func tailLines(es []logEntry, n int) []logEntry {
+	skip := true // ignore the final newline, if any
+	for i := len(es) - 1; i >= 0; i-- {
+		data := es[i].data
+		if skip && bytes.HasSuffix(data, []byte{'\n'}) {
+			data = data[:len(data)-1]
+		}
+		skip = false
+
+		for j := len(data) - 1; j >= 0; j-- {
+			if data[j] != '\n' {
+				continue
+			}
+
+			if n--; n == 0 {
+				head := logEntry{stream: es[i].stream, data: es[i].data[j+1:]}
+				return append([]logEntry{head}, es[i+1:]...)
+			}
+		}
+	}
+
+	return es
+}
+
+type logWriter struct {
+	b      *logBuffer
+	stream api.Log_Stream
+}
+
+func (w logWriter) Write(p []byte) (int, error) {
+	w.b.append(w.stream, p)
+	return len(p), nil
+}
+
+/*
+	Persistence
+*/
+
+// saveLog persists the output of an exited process to the datastore,
+// so that it remains available once the process has been removed from
+// the anchor tree.
+func (e *Server) saveLog(id string, s Status, b *logBuffer) {
+	if e.store == nil {
+		return
+	}
+
+	data, err := marshalLog(s, b)
+	if err == nil {
+		err = e.store.Put(context.Background(), logPrefix.ChildString(id), data)
+	}
+
+	if err != nil {
+		e.log.WithError(err).Error("failed to persist process logs")
+		return
+	}
+
+	e.pruneLogs()
+}
+
+// loadLog returns the persisted output of an exited process.
+func (e *Server) loadLog(ctx context.Context, id string) (*storedProcess, error) {
+	if e.store == nil {
+		return nil, ds.ErrNotFound
+	}
+
+	data, err := e.store.Get(ctx, logPrefix.ChildString(id))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &storedProcess{id: id}
+	p.status, p.logs, err = unmarshalLog(data)
+	return p, err
+}
+
+// pruneLogs deletes all but the most recent persisted logs.
+func (e *Server) pruneLogs() {
+	ids, err := e.storedLogs()
+	if err != nil {
+		e.log.WithError(err).Error("failed to list persisted logs")
+		return
+	}
+
+	for len(ids) > maxStoredLogs {
+		key := logPrefix.ChildString(strconv.FormatUint(ids[0], 10))
+		if err = e.store.Delete(context.Background(), key); err != nil {
+			e.log.WithError(err).Error("failed to delete persisted logs")
+			return
+		}
+
+		ids = ids[1:]
+	}
+}
+
+// storedLogs returns the ids of processes whose logs have been
+// persisted, in ascending order.
+func (e *Server) storedLogs() ([]uint64, error) {
+	rs, err := e.store.Query(context.Background(), query.Query{
+		Prefix:   logPrefix.String(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	es, err := rs.Rest()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(es))
+	for _, r := range es {
+		if id, err := strconv.ParseUint(ds.RawKey(r.Key).BaseNamespace(), 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+func marshalLog(s Status, b *logBuffer) ([]byte, error) {
+	msg, seg, err := capnp.NewMessage(capnp.MultiSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := api.NewRootLog(seg)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := l.NewStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.bind(st); err != nil {
+		return nil, err
+	}
+
+	es, _, _, _ := b.since(0)
+	list, err := l.NewEntries(int32(len(es)))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, e := range es {
+		list.At(i).SetStream(e.stream)
+		if err = list.At(i).SetData(e.data); err != nil {
+			return nil, err
+		}
+	}
+
+	return msg.MarshalPacked()
+}
+
+func unmarshalLog(data []byte) (s Status, b *logBuffer, err error) {
+	msg, err := capnp.UnmarshalPacked(data)
+	if err != nil {
+		return
+	}
+
+	l, err := api.ReadRootLog(msg)
+	if err != nil {
+		return
+	}
+
+	st, err := l.Status()
+	if err != nil {
+		return
+	}
+
+	if err = s.load(st); err != nil {
+		return
+	}
+
+	list, err := l.Entries()
+	if err != nil {
+		return
+	}
+
+	b = newLogBuffer(math.MaxInt) // bounded when the log was saved
+	for i := 0; i < list.Len(); i++ {
+		var p []byte
+		if p, err = list.At(i).Data(); err != nil {
+			return
+		}
+
+		b.append(list.At(i).Stream(), p)
+	}
+	b.close()
+
+	return
+}
@@ -0,0 +1,109 @@
+package proc
+
+import (
+	"time"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/lthibault/log"
+	"github.com/thejerf/suture/v4"
+)
+
+type Option func(*Server)
+
+// WithLogger sets the logger for the executor server.
+// If l == nil, a default logger is used.
+func WithLogger(l log.Logger) Option {
+	if l == nil {
+		l = log.New()
+	}
+
+	return func(e *Server) {
+		e.log = l
+	}
+}
+
+// WithAnchor binds each process to the anchor at proc/<id> under
+// root, allowing other vats to discover it and observe its status.
+// If root == nil, processes are not bound to anchors.
+func WithAnchor(root Mounter) Option {
+	return func(e *Server) {
+		e.root = root
+	}
+}
+
+// WithRetention sets the amount of time for which an exited process
+// remains bound to its anchor.  If d == 0, the anchor is removed as
+// soon as the process exits.  If d < 0, it is retained until the
+// server is closed.
+func WithRetention(d time.Duration) Option {
+	return func(e *Server) {
+		e.retention = d
+	}
+}
+
+// WithEventHook sets the hook that is called when the supervisor
+// restarts a process.  If h == nil, events are logged at the debug
+// level.
+func WithEventHook(h suture.EventHook) Option {
+	return func(e *Server) {
+		e.hook = h
+	}
+}
+
+// WithCgroup sets the cgroup v2 directory beneath which processes
+// with resource limits are placed, e.g. /sys/fs/cgroup/ww.slice.  The
+// cgroup MUST be writable by the host, and MUST NOT contain any
+// processes.  If path is empty, limits are not enforced.
+func WithCgroup(path string) Option {
+	return func(e *Server) {
+		e.cgroup = path
+	}
+}
+
+// WithShim sets the command that holds a process until it has been
+// added to its cgroup.  The command is executed with additional
+// arguments, which it MUST pass to Shim.  If argv is empty, limits are
+// not enforced.
+func WithShim(argv ...string) Option {
+	return func(e *Server) {
+		e.shim = argv
+	}
+}
+
+// WithLogSize sets the number of bytes of output that are retained
+// for each process, and that can be read with Process.Logs.  If n <= 0,
+// output is not retained.
+func WithLogSize(n int) Option {
+	return func(e *Server) {
+		e.logSize = n
+	}
+}
+
+// WithLogStore persists the logs of exited processes to the supplied
+// datastore, allowing them to be read after the process has been
+// removed from the anchor tree.  Logs are persisted for the most
+// recent processes only.  If d == nil, logs are not persisted.
+func WithLogStore(d ds.Datastore) Option {
+	return func(e *Server) {
+		e.store = d
+	}
+}
+
+// WithPreopenRoots sets the host directories to which WASI modules can
+// be granted access.  A module's preopens MUST lie within one of the
+// roots, or Spawn fails with ErrPreopen.  By default, modules cannot
+// access the host's filesystem.
+func WithPreopenRoots(roots ...string) Option {
+	return func(e *Server) {
+		e.preopenRoots = roots
+	}
+}
+
+func withDefault(opt []Option) []Option {
+	return append([]Option{
+		WithLogger(nil),
+		WithRetention(DefaultRetention),
+		WithLogSize(DefaultLogSize),
+		WithEventHook(nil),
+	}, opt...)
+}
@@ -0,0 +1,8 @@
+// Package proc provides a capability for executing processes on a host.
+package proc
+
+import "github.com/wetware/ww/pkg/vat"
+
+var Capability = vat.BasicCap{
+	Name:     "proc",
+	Versions: []string{"0.1.0"}}
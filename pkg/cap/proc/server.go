@@ -0,0 +1,631 @@
+package proc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"capnproto.org/go/capnp/v3/server"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/lthibault/log"
+	ctxutil "github.com/lthibault/util/ctx"
+	"github.com/thejerf/suture/v4"
+
+	api "github.com/wetware/ww/internal/api/process"
+)
+
+var (
+	ErrClosed   = errors.New("closed")
+	ErrNotFound = errors.New("not found")
+)
+
+var defaultPolicy = server.Policy{
+	MaxConcurrentCalls: 64,
+}
+
+// Server spawns local OS processes on behalf of remote vats,
+// and provides vat.ClientProvider.  Processes are killed when the
+// server is closed, or when all references to the corresponding
+// Process capability have been released.
+type Server struct {
+	cq  chan struct{}
+	log log.Logger
+	wg  sync.WaitGroup // blocks shutdown until all processes have exited
+
+	root      Mounter
+	retention time.Duration
+	nextID    uint64 // atomic
+
+	mu    sync.Mutex
+	procs map[string]*process // bound processes, by id
+
+	logSize int
+	store   ds.Datastore // persists the logs of exited processes
+
+	hook    suture.EventHook
+	sup     *suture.Supervisor // restarts processes, per their policy
+	supDone chan struct{}
+
+	preopenRoots []string // host directories that modules may access
+
+	cgroup string   // root of delegated cgroup hierarchy
+	shim   []string // holds processes until they are in their cgroup
+	cgOnce sync.Once
+	cg     *cgroups
+}
+
+func New(opt ...Option) *Server {
+	var e = &Server{
+		cq:      make(chan struct{}),
+		supDone: make(chan struct{}),
+	}
+
+	for _, option := range withDefault(opt) {
+		option(e)
+	}
+
+	if e.hook == nil {
+		e.hook = e.logEvent
+	}
+
+	// Don't reuse the ids of processes whose logs were persisted by a
+	// previous instance of the host.
+	if e.store != nil {
+		if ids, err := e.storedLogs(); err != nil {
+			e.log.WithError(err).Error("failed to list persisted logs")
+		} else if len(ids) > 0 {
+			e.nextID = ids[len(ids)-1]
+		}
+	}
+
+	// Processes are restarted according to their own restart policy.
+	// The supervisor must not throttle restarts across all processes
+	// when some of them fail repeatedly, so its failure threshold is
+	// disabled.
+	e.sup = suture.New("proc", suture.Spec{
+		EventHook:        e.hook,
+		FailureThreshold: math.Inf(1),
+	})
+	cherr := e.sup.ServeBackground(ctxutil.C(e.cq))
+	go func() {
+		defer close(e.supDone)
+		<-cherr
+	}()
+
+	return e
+}
+
+func (e *Server) logEvent(ev suture.Event) {
+	e.log.WithFields(ev.Map()).Debug(ev.String())
+}
+
+// Close kills all running processes and blocks until they have
+// exited.
+func (e *Server) Close() (err error) {
+	if e != nil {
+		select {
+		case <-e.cq:
+			err = fmt.Errorf("already %w", ErrClosed)
+		default:
+			close(e.cq)
+			e.wg.Wait()
+			<-e.supDone
+		}
+	}
+
+	return
+}
+
+func (e *Server) Client() *capnp.Client {
+	return api.Executor_ServerToClient(e, &defaultPolicy).Client
+}
+
+func (e *Server) Exec(_ context.Context, call api.Executor_exec) error {
+	select {
+	case <-e.cq:
+		return ErrClosed
+	default:
+	}
+
+	cmd, err := e.command(call.Args())
+	if err != nil {
+		return err
+	}
+
+	stdout, stderr := e.output(call.Args())
+	release := func() {
+		releaseWriters(stdout, stderr)
+		capSet(cmd.Caps).Release()
+	}
+
+	logs := newLogBuffer(e.logSize)
+	start := func() (instance, error) {
+		return e.startCmd(e.newCmd(cmd,
+			logs.writer(api.Log_Stream_stdout, stdout),
+			logs.writer(api.Log_Stream_stderr, stderr)),
+			cmd.Limits, cmd.Caps)
+	}
+
+	inst, err := start()
+	if err != nil {
+		release()
+		return err
+	}
+
+	p := newProcess(e.log.WithField("path", cmd.Path), inst, logs)
+	e.bind(p, Status{
+		State: Running,
+		PID:   inst.pid,
+		Path:  cmd.Path,
+	})
+
+	if cmd.Restart.Mode == Never {
+		e.wait(p, inst, release)
+	} else {
+		e.supervise(p, cmd, inst, start)
+		e.release(p, release)
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		p.Shutdown()
+		return err
+	}
+
+	return res.SetProc(api.Process_ServerToClient(p, &defaultPolicy))
+}
+
+func (e *Server) command(args api.Executor_exec_Params) (cmd Command, err error) {
+	c, err := args.Cmd()
+	if err != nil {
+		return
+	}
+
+	if err = cmd.load(c); err == nil && cmd.Path == "" {
+		err = errors.New("missing command path")
+	}
+
+	if err != nil {
+		capSet(cmd.Caps).Release()
+	}
+
+	return
+}
+
+func (e *Server) newCmd(cmd Command, stdout, stderr io.Writer) *exec.Cmd {
+	proc := exec.CommandContext(ctxutil.C(e.cq), cmd.Path, cmd.Args...)
+	proc.Env = append([]string{}, cmd.Env...) // don't inherit the host's env
+	proc.Dir = cmd.Dir
+	proc.Stdout = stdout
+	proc.Stderr = stderr
+	return proc
+}
+
+type outputParams interface {
+	HasStdout() bool
+	Stdout() api.Writer
+	HasStderr() bool
+	Stderr() api.Writer
+}
+
+// output returns writers that stream a process' output back to the
+// caller.  If no Writer capability was provided, the corresponding
+// writer is nil, and output is discarded.
+func (e *Server) output(args outputParams) (stdout, stderr io.Writer) {
+	return writers(ctxutil.C(e.cq), args)
+}
+
+func writers(ctx context.Context, args outputParams) (stdout, stderr io.Writer) {
+	if args.HasStdout() {
+		stdout = writer{ctx: ctx, w: args.Stdout().AddRef()}
+	}
+
+	if args.HasStderr() {
+		stderr = writer{ctx: ctx, w: args.Stderr().AddRef()}
+	}
+
+	return
+}
+
+// Lookup a process that is bound to the anchor tree.  If the process
+// has been removed from the tree, but its logs were persisted, a
+// Process that reports its final status and output is returned.
+func (e *Server) Lookup(ctx context.Context, call api.Executor_lookup) error {
+	id, err := call.Args().Id()
+	if err != nil {
+		return err
+	}
+
+	var srv api.Process_Server
+	if p, ok := e.lookup(id); ok {
+		srv = handle{p}
+	} else if srv, err = e.loadLog(ctx, id); errors.Is(err, ds.ErrNotFound) {
+		return fmt.Errorf("process %s: %w", id, ErrNotFound)
+	} else if err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetProc(api.Process_ServerToClient(srv, &defaultPolicy))
+}
+
+// wait for an unsupervised process to exit.
+func (e *Server) wait(p *process, inst instance, release func()) {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer release()
+
+		p.exit(inst.wait())
+	}()
+}
+
+// release the resources of a supervised process once it has exited.
+// If the supervisor stops before the process has exited, which can
+// happen when it is backing off, the process is marked as exited.
+func (e *Server) release(p *process, release func()) {
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer release()
+
+		select {
+		case <-p.done:
+		case <-e.cq:
+			<-e.supDone
+			p.exit(exitStatus{code: -1}, ErrClosed)
+		}
+	}()
+}
+
+// instance is a single execution of a process.  Supervised processes
+// may consist of several instances over their lifetime.
+type instance struct {
+	pid   int // zero for WASI modules
+	stdin io.WriteCloser
+	kill  func(syscall.Signal) error
+	wait  func() (exitStatus, error)
+}
+
+// exitStatus of a process instance.
+type exitStatus struct {
+	code      int
+	oomKilled bool // killed for exceeding its memory limit
+}
+
+// cgroups are initialized lazily, so that hosts that never receive
+// limits are not required to have been delegated a cgroup.
+func (e *Server) cgroups() *cgroups {
+	e.cgOnce.Do(func() {
+		e.cg = newCgroups(e.log, e.cgroup, e.shim)
+	})
+
+	return e.cg
+}
+
+func (e *Server) startCmd(cmd *exec.Cmd, lim Limits, caps capSet) (instance, error) {
+	cg, err := e.cgroups().create(lim)
+	if err != nil {
+		return instance{}, err
+	}
+
+	var conn *rpc.Conn
+	if len(caps) > 0 {
+		var started func()
+		if conn, started, err = inherit(cmd, caps); err != nil {
+			cg.remove()
+			return instance{}, err
+		}
+		defer started()
+	}
+
+	// The process is held until it has been moved into its cgroup.
+	release, err := cg.hold(cmd)
+	if err != nil {
+		closeConn(conn)
+		cg.remove()
+		return instance{}, err
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err == nil {
+		err = cmd.Start()
+	}
+
+	if err != nil {
+		release(false)
+		closeConn(conn)
+		cg.remove()
+		return instance{}, err
+	}
+
+	if err = cg.add(cmd.Process.Pid); err != nil {
+		release(false)
+		cmd.Process.Kill()
+		cmd.Wait()
+		closeConn(conn)
+		cg.remove()
+		return instance{}, err
+	}
+
+	release(true)
+
+	return instance{
+		pid:   cmd.Process.Pid,
+		stdin: stdin,
+		kill: func(sig syscall.Signal) error {
+			if err := cmd.Process.Signal(sig); !errors.Is(err, os.ErrProcessDone) {
+				return err
+			}
+			return nil
+		},
+		wait: func() (exitStatus, error) {
+			code, err := exitCode(cmd.Wait())
+			status := exitStatus{code: code, oomKilled: cg.oomKilled()}
+
+			closeConn(conn)
+
+			if err := cg.remove(); err != nil {
+				e.log.WithError(err).Debug("failed to remove cgroup")
+			}
+
+			return status, err
+		},
+	}, nil
+}
+
+// exitCode returns the exit code of a process, given the error returned
+// by Cmd.Wait.  A nil error is returned if the process ran to completion,
+// even if it exited with a non-zero status.
+func exitCode(err error) (int, error) {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode(), nil
+	}
+
+	return 0, err
+}
+
+func closeConn(conn *rpc.Conn) {
+	if conn != nil {
+		conn.Close()
+	}
+}
+
+func releaseWriters(ws ...io.Writer) {
+	for _, w := range ws {
+		if w, ok := w.(writer); ok {
+			w.Release()
+		}
+	}
+}
+
+// process is the server implementation of Process.  It is shared by
+// all executor backends.
+type process struct {
+	id        string // anchor name; empty if unbound
+	log       log.Logger
+	logs      *logBuffer
+	onRestart func(pid int) // called when a new instance is started
+
+	mu   sync.Mutex
+	inst instance // current instance
+
+	haltOnce sync.Once
+	halt     chan struct{} // closed when the process must not be restarted
+
+	exitOnce sync.Once
+	done     chan struct{}
+	status   exitStatus
+	err      error // set before done is closed
+}
+
+func newProcess(log log.Logger, inst instance, logs *logBuffer) *process {
+	p := &process{
+		log:  log,
+		logs: logs,
+		inst: inst,
+		halt: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+
+	p.log.WithField("pid", inst.pid).Debug("process started")
+	return p
+}
+
+func (p *process) current() instance {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.inst
+}
+
+// restart replaces the current instance.
+func (p *process) restart(inst instance) {
+	p.mu.Lock()
+	p.inst = inst
+	p.mu.Unlock()
+
+	if p.onRestart != nil {
+		p.onRestart(inst.pid)
+	}
+
+	p.log.WithField("pid", inst.pid).Debug("process restarted")
+}
+
+// stop prevents the process from being restarted.
+func (p *process) stop() {
+	p.haltOnce.Do(func() { close(p.halt) })
+}
+
+// exit is called when the process has exited, and will not be
+// restarted.  Subsequent calls are nops.
+func (p *process) exit(status exitStatus, err error) {
+	p.exitOnce.Do(func() {
+		p.status, p.err = status, err
+		p.logs.close()
+		close(p.done)
+
+		p.log.
+			WithError(err).
+			WithField("code", status.code).
+			WithField("oom", status.oomKilled).
+			Debug("process exited")
+	})
+}
+
+// Shutdown kills the process if it is still running.  It is called
+// when the last reference to the Process capability is released.
+func (p *process) Shutdown() {
+	p.stop()
+
+	select {
+	case <-p.done:
+	default:
+		if err := p.current().kill(syscall.SIGKILL); err != nil {
+			p.log.WithError(err).Debug("failed to kill process")
+		}
+	}
+}
+
+func (p *process) Wait(ctx context.Context, call api.Process_wait) error {
+	call.Ack() // don't block calls to Kill and Stdin
+
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if p.err != nil {
+		return p.err
+	}
+
+	res, err := call.AllocResults()
+	if err == nil {
+		res.SetExitCode(int32(p.status.code))
+		res.SetOomKilled(p.status.oomKilled)
+	}
+
+	return err
+}
+
+func (p *process) Kill(_ context.Context, call api.Process_kill) error {
+	sig := syscall.Signal(call.Args().Signal())
+	if sig == 0 {
+		sig = syscall.SIGKILL
+	}
+
+	select {
+	case <-p.done:
+		return errors.New("process already exited")
+	default:
+		p.stop()
+		return p.current().kill(sig)
+	}
+}
+
+func (p *process) Id(_ context.Context, call api.Process_id) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetId(p.id)
+}
+
+func (p *process) Stdin(_ context.Context, call api.Process_stdin) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetStdin(api.Writer_ServerToClient(
+		writeServer{stdin{p}},
+		&defaultPolicy))
+}
+
+// stdin forwards to the standard input of the process' current
+// instance, so that it remains usable when the process is restarted.
+type stdin struct{ p *process }
+
+func (s stdin) Write(b []byte) (int, error) { return s.p.current().stdin.Write(b) }
+func (s stdin) Close() error                { return s.p.current().stdin.Close() }
+
+func (p *process) Logs(ctx context.Context, call api.Process_logs) error {
+	call.Ack() // don't block calls to Wait and Kill while following
+
+	stdout, stderr := writers(ctx, call.Args())
+	defer releaseWriters(stdout, stderr)
+
+	return p.logs.copy(ctx,
+		call.Args().Follow(),
+		int(call.Args().Tail()),
+		stdout, stderr)
+}
+
+// handle is a Process that was obtained through Lookup.  Unlike the
+// Process returned by Exec and Spawn, releasing it does not kill the
+// process.
+type handle struct{ *process }
+
+func (handle) Shutdown() {}
+
+// storedProcess is a Process whose output was persisted by the host
+// after it exited.
+type storedProcess struct {
+	id     string
+	status Status
+	logs   *logBuffer
+}
+
+func (p *storedProcess) Wait(_ context.Context, call api.Process_wait) error {
+	if p.status.State == Failed {
+		return errors.New("process could not be waited upon")
+	}
+
+	res, err := call.AllocResults()
+	if err == nil {
+		res.SetExitCode(int32(p.status.ExitCode))
+		res.SetOomKilled(p.status.OOMKilled)
+	}
+
+	return err
+}
+
+func (p *storedProcess) Kill(context.Context, api.Process_kill) error {
+	return errors.New("process already exited")
+}
+
+func (p *storedProcess) Stdin(context.Context, api.Process_stdin) error {
+	return errors.New("process already exited")
+}
+
+func (p *storedProcess) Id(_ context.Context, call api.Process_id) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetId(p.id)
+}
+
+func (p *storedProcess) Logs(ctx context.Context, call api.Process_logs) error {
+	stdout, stderr := writers(ctx, call.Args())
+	defer releaseWriters(stdout, stderr)
+
+	return p.logs.copy(ctx, false, int(call.Args().Tail()), stdout, stderr)
+}
@@ -0,0 +1,696 @@
+package proc_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	ds "github.com/ipfs/go-datastore"
+	dssync "github.com/ipfs/go-datastore/sync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thejerf/suture/v4"
+
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/proc"
+	"github.com/wetware/ww/pkg/client"
+)
+
+func TestExecutor(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	s := proc.New()
+	defer func() {
+		assert.NoError(t, s.Close(), "executor should close gracefully")
+		assert.ErrorIs(t, s.Close(), proc.ErrClosed)
+	}()
+
+	e := proc.Executor{s.Client()}
+	defer e.Release()
+
+	t.Run("Stdout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		var stdout buffer
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "echo hello"},
+		}, &stdout, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Zero(t, code, "should exit successfully")
+		assert.Equal(t, "hello\n", stdout.String(), "unexpected output")
+	})
+
+	t.Run("ExitCode", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "exit 3"},
+		}, nil, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Equal(t, 3, code, "should report exit code")
+	})
+
+	t.Run("Env", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		var stdout buffer
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", `echo "$FOO$HOME"`},
+			Env:  []string{"FOO=bar"},
+		}, &stdout, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Zero(t, code, "should exit successfully")
+		assert.Equal(t, "bar\n", stdout.String(),
+			"should set env without inheriting the host's")
+	})
+
+	t.Run("Stdin", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		var stdout buffer
+		p, release := e.Exec(ctx, proc.Command{Path: "/bin/cat"}, &stdout, nil)
+		defer release()
+
+		stdin := p.Stdin(ctx)
+		_, err := io.WriteString(stdin, "hello")
+		require.NoError(t, err, "should write to stdin")
+		require.NoError(t, stdin.Close(), "should close stdin")
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Zero(t, code, "should exit successfully")
+		assert.Equal(t, "hello", stdout.String(), "should echo stdin")
+	})
+
+	t.Run("Kill", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sleep",
+			Args: []string{"60"},
+		}, nil, nil)
+		defer release()
+
+		err := p.Kill(ctx, syscall.SIGTERM)
+		require.NoError(t, err, "should signal process")
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Equal(t, -1, code, "should report termination by signal")
+	})
+}
+
+// buffer is a thread-safe bytes.Buffer.
+func TestExecutor_limits(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	// Limits are best-effort; processes run regardless of whether the
+	// host has been delegated a cgroup.
+	s := proc.New(proc.WithCgroup(t.TempDir()))
+	defer s.Close()
+
+	e := proc.Executor{s.Client()}
+	defer e.Release()
+
+	p, release := e.Exec(ctx, proc.Command{
+		Path: "/bin/sh",
+		Args: []string{"-c", "exit 2"},
+		Limits: proc.Limits{
+			CPU:    0.5,
+			Memory: 64 << 20,
+			PIDs:   16,
+		},
+	}, nil, nil)
+	defer release()
+
+	status, err := p.WaitStatus(ctx)
+	require.NoError(t, err, "should wait for process")
+	assert.Equal(t, 2, status.Code, "should report exit code")
+	assert.False(t, status.OOMKilled, "should not report OOM kill")
+}
+
+func TestExecutor_restart(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("OnFailure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		var restarts int32
+		s := proc.New(proc.WithEventHook(func(ev suture.Event) {
+			if _, ok := ev.(suture.EventServiceTerminate); ok {
+				atomic.AddInt32(&restarts, 1)
+			}
+		}))
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		// fail twice, then succeed
+		script := `n=$(cat "$0" 2>/dev/null || echo 0); n=$((n+1)); echo $n > "$0"; echo $n; [ $n -ge 3 ]`
+
+		var stdout buffer
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", script, filepath.Join(t.TempDir(), "count")},
+			Restart: proc.RestartPolicy{
+				Mode:    proc.OnFailure,
+				Backoff: time.Millisecond,
+			},
+		}, &stdout, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Zero(t, code, "should exit successfully")
+		assert.Equal(t, "1\n2\n3\n", stdout.String(), "should restart failed process")
+		assert.Equal(t, int32(2), atomic.LoadInt32(&restarts), "should report restarts")
+	})
+
+	t.Run("MaxRestarts", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		s := proc.New()
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		var stdout buffer
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "echo x; exit 0"},
+			Restart: proc.RestartPolicy{
+				Mode:        proc.Always,
+				MaxRestarts: 2,
+			},
+		}, &stdout, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Zero(t, code, "should exit successfully")
+		assert.Equal(t, "x\nx\nx\n", stdout.String(), "should restart twice")
+	})
+
+	t.Run("Independent", func(t *testing.T) {
+		t.Parallel()
+
+		// Restarts are governed by each process' policy alone.  A
+		// process that fails repeatedly must not delay the restarts
+		// of other processes.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		s := proc.New()
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		var ps []proc.Process
+		for i := 0; i < 3; i++ {
+			p, release := e.Exec(ctx, proc.Command{
+				Path: "/bin/sh",
+				Args: []string{"-c", "exit 1"},
+				Restart: proc.RestartPolicy{
+					Mode:        proc.OnFailure,
+					MaxRestarts: 5,
+				},
+			}, nil, nil)
+			defer release()
+
+			ps = append(ps, p)
+		}
+
+		for _, p := range ps {
+			code, err := p.Wait(ctx)
+			require.NoError(t, err, "should wait for process")
+			assert.Equal(t, 1, code, "should report exit code")
+		}
+	})
+
+	t.Run("Stdin", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		s := proc.New()
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		// Each instance echoes one line of input, then exits.
+		var stdout buffer
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "read line; echo $line"},
+			Restart: proc.RestartPolicy{
+				Mode:        proc.Always,
+				MaxRestarts: 1,
+			},
+		}, &stdout, nil)
+		defer release()
+
+		stdin := p.Stdin(ctx)
+		_, err := io.WriteString(stdin, "first\n")
+		require.NoError(t, err, "should write to stdin")
+
+		require.Eventually(t, func() bool {
+			return stdout.String() == "first\n"
+		}, time.Second, time.Millisecond*10, "first instance should read stdin")
+
+		// The write fails if the process has not yet been restarted.
+		require.Eventually(t, func() bool {
+			_, err := io.WriteString(stdin, "second\n")
+			return err == nil
+		}, time.Second, time.Millisecond*10, "should write to restarted process")
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Zero(t, code, "should exit successfully")
+		assert.Equal(t, "first\nsecond\n", stdout.String(),
+			"restarted instance should read stdin")
+	})
+
+	t.Run("Kill", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		s := proc.New()
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		p, release := e.Exec(ctx, proc.Command{
+			Path:    "/bin/sleep",
+			Args:    []string{"10"},
+			Restart: proc.RestartPolicy{Mode: proc.Always},
+		}, nil, nil)
+		defer release()
+
+		err := p.Kill(ctx, syscall.SIGTERM)
+		require.NoError(t, err, "should signal process")
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+		assert.Equal(t, -1, code, "should not restart killed process")
+	})
+}
+
+func TestExecutor_anchor(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Retain", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		host := clcap.NewHost(nil)
+		root := clcap.Register{Client: host.Client()}
+
+		s := proc.New(proc.WithAnchor(host), proc.WithRetention(-1))
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "exit 3"},
+		}, nil, nil)
+		defer release()
+
+		id, err := p.ID(ctx)
+		require.NoError(t, err, "should return process ID")
+		require.NotEmpty(t, id, "should bind process to anchor")
+
+		_, err = p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+
+		r, release := root.Walk(ctx, []string{"proc", id})
+		defer release()
+
+		require.Eventually(t, func() bool {
+			data, release := r.Get(ctx)
+			defer release()
+
+			var status proc.Status
+			require.NoError(t, status.UnmarshalBinary(data),
+				"should unmarshal status")
+
+			return status.State == proc.Exited && status.ExitCode == 3
+		}, time.Second, time.Millisecond*10, "should report exit status")
+
+		err = r.Set(ctx, []byte("forged"))
+		assert.ErrorContains(t, err, clcap.ErrReadOnly.Error(),
+			"should not allow callers to overwrite process status")
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		host := clcap.NewHost(nil)
+		root := clcap.Register{Client: host.Client()}
+
+		s := proc.New(proc.WithAnchor(host), proc.WithRetention(0))
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		p, release := e.Exec(ctx, proc.Command{Path: "/bin/true"}, nil, nil)
+		defer release()
+
+		_, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+
+		r, release := root.Walk(ctx, []string{"proc"})
+		defer release()
+
+		require.Eventually(t, func() bool {
+			rs, release := r.Ls(ctx)
+			defer release()
+
+			return !rs.More()
+		}, time.Second, time.Millisecond*10, "should remove anchor")
+	})
+}
+
+func TestExecutor_logs(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Tail", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		s := proc.New(proc.WithAnchor(clcap.NewHost(nil)), proc.WithRetention(-1))
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "echo one; echo two; echo three; echo oops >&2"},
+		}, nil, nil)
+		defer release()
+
+		_, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+
+		var stdout, stderr buffer
+		err = p.Logs(ctx, false, 0, &stdout, &stderr)
+		require.NoError(t, err, "should read logs")
+		assert.Equal(t, "one\ntwo\nthree\n", stdout.String())
+		assert.Equal(t, "oops\n", stderr.String())
+
+		// Lines are interleaved across streams, so tail a process that
+		// only writes to stdout.
+		p, release = e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "echo one; echo two; echo three"},
+		}, nil, nil)
+		defer release()
+
+		_, err = p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+
+		stdout = buffer{}
+		err = p.Logs(ctx, false, 2, &stdout, nil)
+		require.NoError(t, err, "should read logs")
+		assert.Equal(t, "two\nthree\n", stdout.String(), "should only print last lines")
+	})
+
+	t.Run("Follow", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		s := proc.New(proc.WithAnchor(clcap.NewHost(nil)))
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		p, release := e.Exec(ctx, proc.Command{Path: "/bin/cat"}, nil, nil)
+		defer release()
+
+		id, err := p.ID(ctx)
+		require.NoError(t, err, "should return process ID")
+
+		// Releasing a process obtained by lookup must not kill it.
+		q, done := e.Lookup(ctx, id)
+		_, err = q.ID(ctx)
+		require.NoError(t, err, "should look up process")
+		done()
+
+		var stdout buffer
+		cherr := make(chan error, 1)
+		go func() {
+			q, done := e.Lookup(ctx, id)
+			defer done()
+
+			cherr <- q.Logs(ctx, true, 0, &stdout, nil)
+		}()
+
+		stdin := p.Stdin(ctx)
+		_, err = io.WriteString(stdin, "hello\n")
+		require.NoError(t, err, "should write to stdin")
+
+		require.Eventually(t, func() bool {
+			return stdout.String() == "hello\n"
+		}, time.Second, time.Millisecond*10, "should stream output")
+
+		select {
+		case err = <-cherr:
+			t.Fatalf("should follow until process exits (err=%v)", err)
+		default:
+		}
+
+		require.NoError(t, stdin.Close(), "should close stdin")
+		require.NoError(t, <-cherr, "should stop following when process exits")
+	})
+
+	t.Run("Bounded", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		s := proc.New(proc.WithLogSize(4))
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "echo hello"},
+		}, nil, nil)
+		defer release()
+
+		_, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+
+		var stdout buffer
+		require.NoError(t, p.Logs(ctx, false, 0, &stdout, nil))
+		assert.Equal(t, "llo\n", stdout.String(), "should discard old output")
+	})
+
+	t.Run("Persist", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		store := dssync.MutexWrap(ds.NewMapDatastore())
+		s := proc.New(
+			proc.WithAnchor(clcap.NewHost(nil)),
+			proc.WithRetention(0),
+			proc.WithLogStore(store))
+		defer s.Close()
+
+		e := proc.Executor{s.Client()}
+		defer e.Release()
+
+		p, release := e.Exec(ctx, proc.Command{
+			Path: "/bin/sh",
+			Args: []string{"-c", "echo hello; exit 3"},
+		}, nil, nil)
+		defer release()
+
+		id, err := p.ID(ctx)
+		require.NoError(t, err, "should return process ID")
+
+		_, err = p.Wait(ctx)
+		require.NoError(t, err, "should wait for process")
+
+		require.Eventually(t, func() bool {
+			ok, err := store.Has(ctx, ds.NewKey("/proc/logs/"+id))
+			return err == nil && ok
+		}, time.Second, time.Millisecond*10, "should persist logs")
+
+		// A new server sees the logs of its predecessor.
+		s2 := proc.New(proc.WithAnchor(clcap.NewHost(nil)), proc.WithLogStore(store))
+		defer s2.Close()
+
+		e2 := proc.Executor{s2.Client()}
+		defer e2.Release()
+
+		q, done := e2.Lookup(ctx, id)
+		defer done()
+
+		var stdout buffer
+		require.NoError(t, q.Logs(ctx, true, 0, &stdout, nil))
+		assert.Equal(t, "hello\n", stdout.String())
+
+		code, err := q.Wait(ctx)
+		require.NoError(t, err, "should report exit status")
+		assert.Equal(t, 3, code)
+
+		q, done = e2.Lookup(ctx, "nonexistent")
+		defer done()
+
+		_, err = q.ID(ctx)
+		assert.ErrorContains(t, err, proc.ErrNotFound.Error())
+
+		// Ids are not reused across servers.
+		p, release = e2.Exec(ctx, proc.Command{Path: "/bin/true"}, nil, nil)
+		defer release()
+
+		id2, err := p.ID(ctx)
+		require.NoError(t, err, "should return process ID")
+		assert.NotEqual(t, id, id2, "should not reuse persisted id")
+	})
+}
+
+func TestExecutor_caps(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	root := clcap.Register{Client: clcap.NewHost(nil).Client()}
+
+	s := proc.New()
+	defer s.Close()
+
+	e := proc.Executor{s.Client()}
+	defer e.Release()
+
+	// Re-execute the test binary, which walks the inherited anchor.
+	var stderr buffer
+	p, release := e.Exec(ctx, proc.Command{
+		Path: os.Args[0],
+		Args: []string{"-test.run=TestHelperProcess"},
+		Env:  []string{"WW_TEST_HELPER=1"},
+		Caps: map[string]*capnp.Client{"root": root.Client},
+	}, nil, &stderr)
+	defer release()
+
+	code, err := p.Wait(ctx)
+	require.NoError(t, err, "should wait for process")
+	require.Zero(t, code, "helper failed: %s", stderr.String())
+
+	rs, release := root.Ls(ctx)
+	defer release()
+
+	require.True(t, rs.Next(), "should have child")
+	assert.Equal(t, "hello", rs.Name, "child should have created anchor")
+}
+
+// TestHelperProcess is executed by TestExecutor_caps in a child process.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("WW_TEST_HELPER") != "1" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	caps, err := client.Inherit(ctx)
+	require.NoError(t, err, "should inherit connection")
+	defer caps.Close()
+
+	names, err := caps.Ls(ctx)
+	require.NoError(t, err, "should list capabilities")
+	require.Equal(t, []string{"root"}, names)
+
+	root := clcap.Register{Client: caps.Lookup(ctx, "root")}
+	r, release := root.Walk(ctx, []string{"hello"})
+	defer release()
+
+	rs, release := r.Ls(ctx)
+	defer release()
+	require.NoError(t, rs.Err, "should walk inherited anchor")
+}
+
+type buffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *buffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *buffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
@@ -0,0 +1,188 @@
+package proc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/thejerf/suture/v4"
+
+	api "github.com/wetware/ww/internal/api/process"
+)
+
+// maxBackoff caps the exponential backoff applied to failing
+// processes.
+const maxBackoff = time.Minute * 5
+
+var errHalted = errors.New("halted")
+
+// RestartMode determines the circumstances under which a process is
+// restarted after it exits.
+type RestartMode uint16
+
+const (
+	Never     RestartMode = RestartMode(api.RestartPolicy_Mode_never)
+	OnFailure RestartMode = RestartMode(api.RestartPolicy_Mode_onFailure)
+	Always    RestartMode = RestartMode(api.RestartPolicy_Mode_always)
+)
+
+func (m RestartMode) String() string { return api.RestartPolicy_Mode(m).String() }
+
+// RestartPolicy for a Command.  Processes with a restart policy other
+// than Never are supervised by the host.  Signalling a process through
+// its Process capability prevents it from being restarted.
+type RestartPolicy struct {
+	Mode RestartMode
+
+	// Backoff is the delay before a failed process is restarted.  It
+	// is doubled after each consecutive failure, up to five minutes.
+	Backoff time.Duration
+
+	// MaxRestarts is the maximum number of times the process will be
+	// restarted.  If zero, the process is restarted indefinitely.
+	MaxRestarts uint32
+}
+
+func (p RestartPolicy) bind(r api.RestartPolicy) {
+	r.SetMode(api.RestartPolicy_Mode(p.Mode))
+	r.SetBackoff(int64(p.Backoff))
+	r.SetMaxRestarts(p.MaxRestarts)
+}
+
+func (p *RestartPolicy) load(r api.RestartPolicy) {
+	p.Mode = RestartMode(r.Mode())
+	p.Backoff = time.Duration(r.Backoff())
+	p.MaxRestarts = r.MaxRestarts()
+}
+
+func (p RestartPolicy) restart(failed bool) bool {
+	switch p.Mode {
+	case OnFailure:
+		return failed
+	case Always:
+		return true
+	default:
+		return false
+	}
+}
+
+// supervise the process according to its restart policy.  The first
+// instance MUST already have been started.
+func (e *Server) supervise(p *process, cmd Command, first instance, start func() (instance, error)) {
+	e.sup.Add(&service{
+		p:      p,
+		name:   filepath.Base(cmd.Path),
+		policy: cmd.Restart,
+		start:  start,
+		next:   &first,
+	})
+}
+
+// service restarts a process according to its restart policy.  It
+// implements suture.Service, so that restarts are reported through
+// the host's event hook.
+type service struct {
+	p      *process
+	name   string
+	policy RestartPolicy
+	start  func() (instance, error)
+
+	next               *instance // started, but not yet waited upon
+	restarts, failures uint32
+
+	// last exit status
+	status exitStatus
+	err    error
+}
+
+func (s *service) String() string { return s.name }
+
+func (s *service) Serve(ctx context.Context) error {
+	if s.next == nil {
+		if err := s.backoff(ctx); err != nil {
+			return s.exit()
+		}
+
+		inst, err := s.start()
+		if err != nil {
+			return s.exited(ctx, exitStatus{code: -1}, err)
+		}
+
+		s.restarts++
+		s.p.restart(inst)
+		s.next = &inst
+	}
+
+	inst := *s.next
+	s.next = nil
+
+	status, err := inst.wait()
+	return s.exited(ctx, status, err)
+}
+
+// exited returns a nil or non-nil error if the process should be
+// restarted, and suture.ErrDoNotRestart otherwise.
+func (s *service) exited(ctx context.Context, status exitStatus, err error) error {
+	s.status, s.err = status, err
+
+	failed := err != nil || status.code != 0
+	if failed {
+		s.failures++
+	} else {
+		s.failures = 0
+	}
+
+	if ctx.Err() != nil || s.halted() || !s.policy.restart(failed) ||
+		(s.policy.MaxRestarts > 0 && s.restarts >= s.policy.MaxRestarts) {
+		return s.exit()
+	}
+
+	if err == nil && failed {
+		err = fmt.Errorf("exit status %d", status.code)
+	}
+
+	return err
+}
+
+func (s *service) exit() error {
+	s.p.exit(s.status, s.err)
+	return suture.ErrDoNotRestart
+}
+
+func (s *service) halted() bool {
+	select {
+	case <-s.p.halt:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *service) backoff(ctx context.Context) error {
+	if s.failures == 0 || s.policy.Backoff <= 0 {
+		return nil
+	}
+
+	d := s.policy.Backoff
+	for i := uint32(1); i < s.failures && d < maxBackoff; i++ {
+		d *= 2
+	}
+
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-s.p.halt:
+		return errHalted
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
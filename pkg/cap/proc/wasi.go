@@ -0,0 +1,256 @@
+package proc
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lthibault/log"
+	ctxutil "github.com/lthibault/util/ctx"
+	"github.com/tetratelabs/wazero"
+	wapi "github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+
+	api "github.com/wetware/ww/internal/api/process"
+)
+
+// defaultMemoryPages limits a module's linear memory to 256MiB,
+// unless otherwise specified.
+const defaultMemoryPages = 4096
+
+// cpuPollInterval is the interval at which a module's CPU time is
+// checked against its limit.
+const cpuPollInterval = 10 * time.Millisecond
+
+// spawn a WASI module using the wazero runtime, which is implemented
+// in pure Go.  The module runs in-process, and is terminated when its
+// context expires, or when it exceeds its CPU time limit.
+func (e *Server) spawn(m Module, stdout, stderr io.Writer) (*process, error) {
+	ctx, cancel := context.WithCancel(ctxutil.C(e.cq))
+	if m.Timeout > 0 {
+		ctx, cancel = withTimeout(ctx, cancel, m)
+	}
+
+	r := wazero.NewRuntimeWithConfig(ctx, runtimeConfig(m))
+
+	// The module's binary is only valid for the duration of the RPC
+	// call, so it must be compiled before returning.
+	compiled, err := r.CompileModule(ctx, m.Binary)
+	if err != nil {
+		cancel()
+		r.Close(context.Background())
+		return nil, err
+	}
+
+	log := e.log.WithField("wasm", compiled.Name())
+	env := newHostEnv(ctx, log, m)
+
+	if err = instantiateHost(ctx, r, env); err != nil {
+		cancel()
+		r.Close(context.Background())
+		return nil, err
+	}
+
+	stdinR, stdinW := io.Pipe()
+	logs := newLogBuffer(e.logSize)
+	p := newProcess(log, instance{
+		stdin: stdinW,
+		kill: func(syscall.Signal) error {
+			cancel() // modules cannot handle signals; terminate
+			return nil
+		},
+	}, logs)
+
+	e.bind(p, Status{
+		State: Running,
+		Path:  compiled.Name(),
+	})
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer releaseWriters(stdout, stderr)
+		defer env.Close()
+		defer r.Close(context.Background())
+		defer cancel()
+
+		if m.CPUTime > 0 {
+			// The module runs on the calling goroutine, so its
+			// CPU time is that of the goroutine's thread.
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+			defer limitCPU(ctx, log, cancel, m.CPUTime)()
+		}
+
+		_, err := r.InstantiateModule(ctx, compiled,
+			moduleConfig(m, stdinR,
+				logs.writer(api.Log_Stream_stdout, stdout),
+				logs.writer(api.Log_Stream_stderr, stderr)))
+
+		// Writes to stdin fail once the module has exited.
+		stdinR.CloseWithError(io.ErrClosedPipe)
+		stdinW.CloseWithError(io.ErrClosedPipe)
+
+		code, err := wasmExitCode(err)
+		p.exit(exitStatus{code: code}, err)
+	}()
+
+	return p, nil
+}
+
+func withTimeout(ctx context.Context, cancel context.CancelFunc, m Module) (context.Context, context.CancelFunc) {
+	ctx, cancelTimeout := context.WithTimeout(ctx, m.Timeout)
+	return ctx, func() {
+		cancelTimeout()
+		cancel()
+	}
+}
+
+// limitCPU terminates the module by calling cancel once the calling
+// thread has consumed more than 'limit' CPU time.  The caller MUST be
+// locked to its thread, and MUST call the returned function when the
+// module has exited.
+func limitCPU(ctx context.Context, log log.Logger, cancel context.CancelFunc, limit time.Duration) func() {
+	// Threads are reused, so only CPU time consumed after this call
+	// counts towards the limit.
+	clock, err := threadClock()
+	if err == nil {
+		var start time.Duration
+		if start, err = clock(); err == nil {
+			limit += start
+		}
+	}
+
+	if err != nil {
+		log.WithError(err).Warn("CPU time limit will not be enforced")
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(cpuPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				t, err := clock()
+				if err != nil {
+					log.WithError(err).Warn("failed to read CPU time")
+					cancel()
+					return
+				}
+
+				if t > limit {
+					log.Debug("CPU time limit exceeded")
+					cancel()
+					return
+				}
+
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+func runtimeConfig(m Module) wazero.RuntimeConfig {
+	pages := m.MemoryPages
+	if pages == 0 {
+		pages = defaultMemoryPages
+	}
+
+	return wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(pages).
+		WithCloseOnContextDone(true)
+}
+
+func moduleConfig(m Module, stdin io.Reader, stdout, stderr io.Writer) wazero.ModuleConfig {
+	if stdout == nil {
+		stdout = io.Discard
+	}
+
+	if stderr == nil {
+		stderr = io.Discard
+	}
+
+	fs := wazero.NewFSConfig()
+	for _, p := range m.Preopens {
+		if p.ReadOnly {
+			fs = fs.WithReadOnlyDirMount(p.HostPath, p.GuestPath)
+		} else {
+			fs = fs.WithDirMount(p.HostPath, p.GuestPath)
+		}
+	}
+
+	config := wazero.NewModuleConfig().
+		WithArgs(append([]string{"module"}, m.Args...)...).
+		WithStdin(stdin).
+		WithStdout(stdout).
+		WithStderr(stderr).
+		WithFSConfig(fs).
+		WithRandSource(rand.Reader).
+		WithSysWalltime().
+		WithSysNanotime()
+
+	for _, kv := range m.Env {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			config = config.WithEnv(k, v)
+		}
+	}
+
+	return config
+}
+
+func instantiateHost(ctx context.Context, r wazero.Runtime, env *hostEnv) error {
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		return err
+	}
+
+	_, err := r.NewHostModuleBuilder("ww").
+		NewFunctionBuilder().
+		WithFunc(func(_ context.Context, m wapi.Module, name, nameLen, msg, msgLen uint32) int64 {
+			return env.TopicPublish(m.Memory(), name, nameLen, msg, msgLen)
+		}).
+		Export("topic_publish").
+		NewFunctionBuilder().
+		WithFunc(func(_ context.Context, m wapi.Module, name, nameLen, buf, bufLen uint32) int64 {
+			return env.TopicNext(m.Memory(), name, nameLen, buf, bufLen)
+		}).
+		Export("topic_next").
+		NewFunctionBuilder().
+		WithFunc(func(_ context.Context, m wapi.Module, name, nameLen, buf, bufLen uint32) int64 {
+			return env.AnchorLs(m.Memory(), name, nameLen, buf, bufLen)
+		}).
+		Export("anchor_ls").
+		Instantiate(ctx)
+
+	return err
+}
+
+// wasmExitCode returns the exit code of a module, given the error
+// returned by its instantiation.  Modules that were terminated by the
+// host report an exit code of -1, consistent with signalled processes.
+func wasmExitCode(err error) (int, error) {
+	var exitErr *sys.ExitError
+	if !errors.As(err, &exitErr) {
+		return 0, err
+	}
+
+	switch code := exitErr.ExitCode(); code {
+	case sys.ExitCodeContextCanceled, sys.ExitCodeDeadlineExceeded:
+		return -1, nil
+	default:
+		return int(code), nil
+	}
+}
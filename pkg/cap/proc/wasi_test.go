@@ -0,0 +1,179 @@
+package proc_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/ww/pkg/cap/proc"
+)
+
+func TestExecutor_spawn(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, "data"), 0700))
+
+	s := proc.New(proc.WithPreopenRoots(root))
+	defer s.Close()
+
+	e := proc.Executor{s.Client()}
+	defer e.Release()
+
+	t.Run("Stdout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		var stdout buffer
+		p, release := e.Spawn(ctx, proc.Module{Binary: helloWasm}, &stdout, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for module")
+		assert.Equal(t, 3, code, "should report exit code")
+		assert.Equal(t, "hello\n", stdout.String(), "unexpected output")
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		p, release := e.Spawn(ctx, proc.Module{
+			Binary:  loopWasm,
+			Timeout: time.Millisecond * 100,
+		}, nil, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for module")
+		assert.Equal(t, -1, code, "should report termination")
+	})
+
+	t.Run("CPUTime", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		p, release := e.Spawn(ctx, proc.Module{
+			Binary:  loopWasm,
+			CPUTime: time.Millisecond * 100,
+		}, nil, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for module")
+		assert.Equal(t, -1, code, "should report termination")
+	})
+
+	t.Run("StdinAfterExit", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		p, release := e.Spawn(ctx, proc.Module{Binary: helloWasm}, nil, nil)
+		defer release()
+
+		_, err := p.Wait(ctx)
+		require.NoError(t, err, "should wait for module")
+
+		stdin := p.Stdin(ctx)
+		_, err = stdin.Write([]byte("ignored\n"))
+		assert.Error(t, err, "should not block writing to exited module")
+		assert.NoError(t, ctx.Err(), "write should fail before deadline")
+	})
+
+	t.Run("Preopen", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		p, release := e.Spawn(ctx, proc.Module{
+			Binary: helloWasm,
+			Preopens: []proc.Preopen{{
+				HostPath:  filepath.Join(root, "data"),
+				GuestPath: "/data",
+			}},
+		}, nil, nil)
+		defer release()
+
+		code, err := p.Wait(ctx)
+		require.NoError(t, err, "should permit preopen beneath root")
+		assert.Equal(t, 3, code, "should report exit code")
+	})
+
+	t.Run("PreopenOutsideRoot", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+
+		// A symlink beneath the root must not grant access to the
+		// directory it points to.
+		link := filepath.Join(root, "escape")
+		require.NoError(t, os.Symlink(t.TempDir(), link))
+
+		for _, path := range []string{
+			t.TempDir(),
+			filepath.Join(root, ".."),
+			link,
+			"data", // relative
+		} {
+			p, release := e.Spawn(ctx, proc.Module{
+				Binary:   helloWasm,
+				Preopens: []proc.Preopen{{HostPath: path, GuestPath: "/"}},
+			}, nil, nil)
+			defer release()
+
+			_, err := p.Wait(ctx)
+			assert.ErrorContains(t, err, proc.ErrPreopen.Error(),
+				"should refuse preopen %s", path)
+		}
+	})
+}
+
+// helloWasm writes "hello\n" to stdout and exits with code 3.
+//
+//	(module
+//	  (import "wasi_snapshot_preview1" "fd_write"
+//	    (func $fd_write (param i32 i32 i32 i32) (result i32)))
+//	  (import "wasi_snapshot_preview1" "proc_exit"
+//	    (func $proc_exit (param i32)))
+//	  (memory (export "memory") 1)
+//	  (data (i32.const 8) "hello\n")
+//	  (func (export "_start")
+//	    (i32.store (i32.const 0) (i32.const 8))  ;; iov.buf
+//	    (i32.store (i32.const 4) (i32.const 6))  ;; iov.len
+//	    (drop (call $fd_write (i32.const 1) (i32.const 0) (i32.const 1) (i32.const 16)))
+//	    (call $proc_exit (i32.const 3))))
+var helloWasm = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x10, 0x03, 0x60,
+	0x04, 0x7f, 0x7f, 0x7f, 0x7f, 0x01, 0x7f, 0x60, 0x01, 0x7f, 0x00, 0x60,
+	0x00, 0x00, 0x02, 0x46, 0x02, 0x16, 0x77, 0x61, 0x73, 0x69, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x31, 0x08, 0x66, 0x64, 0x5f, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x00, 0x00, 0x16, 0x77, 0x61, 0x73, 0x69, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x31, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x5f, 0x65, 0x78, 0x69, 0x74,
+	0x00, 0x01, 0x03, 0x02, 0x01, 0x02, 0x05, 0x03, 0x01, 0x00, 0x01, 0x07,
+	0x13, 0x02, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00, 0x06,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x00, 0x02, 0x0a, 0x21, 0x01, 0x1f,
+	0x00, 0x41, 0x00, 0x41, 0x08, 0x36, 0x02, 0x00, 0x41, 0x04, 0x41, 0x06,
+	0x36, 0x02, 0x00, 0x41, 0x01, 0x41, 0x00, 0x41, 0x01, 0x41, 0x10, 0x10,
+	0x00, 0x1a, 0x41, 0x03, 0x10, 0x01, 0x0b, 0x0b, 0x0c, 0x01, 0x00, 0x41,
+	0x08, 0x0b, 0x06, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x0a,
+}
+
+// loopWasm never exits.
+//
+//	(module
+//	  (memory (export "memory") 1)
+//	  (func (export "_start")
+//	    (loop (br 0))))
+var loopWasm = []byte{
+	0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00, 0x01, 0x04, 0x01, 0x60,
+	0x00, 0x00, 0x03, 0x02, 0x01, 0x00, 0x05, 0x03, 0x01, 0x00, 0x01, 0x07,
+	0x13, 0x02, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x02, 0x00, 0x06,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x00, 0x00, 0x0a, 0x09, 0x01, 0x07,
+	0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x0b,
+}
@@ -0,0 +1,571 @@
+package proc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/lthibault/log"
+
+	clapi "github.com/wetware/ww/internal/api/cluster"
+	api "github.com/wetware/ww/internal/api/process"
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
+)
+
+var (
+	// ErrNoCap is returned by host functions when a WASI module refers
+	// to a capability that was not passed to it at spawn time.
+	ErrNoCap = errors.New("no such capability")
+
+	// ErrPreopen is returned by Spawn if a module requests access to
+	// a host directory that lies outside of the preopen roots.  See
+	// WithPreopenRoots.
+	ErrPreopen = errors.New("preopen not permitted")
+)
+
+// Module describes a WASI program to be executed in a sandbox on a
+// remote host.  Unlike a Command, a module has no ambient authority;
+// it can only access the directories and capabilities listed here.
+type Module struct {
+	Binary   []byte // WebAssembly bytecode
+	Args     []string
+	Env      []string // KEY=VALUE pairs
+	Preopens []Preopen
+
+	// Capabilities are made available to the module by name, through
+	// the host functions in the "ww" import module.
+	Topics  map[string]pscap.Topic
+	Anchors map[string]clcap.Register
+
+	MemoryPages uint32 // max linear memory in 64KiB pages (0 = host default)
+
+	// Timeout bounds the module's wall-clock running time, including
+	// time spent blocked in host functions.  If Timeout == 0, the
+	// module runs until it exits or is killed.
+	Timeout time.Duration
+
+	// CPUTime bounds the CPU time consumed by the module, excluding
+	// time spent blocked in host functions.  It is only enforced on
+	// Linux.  If CPUTime == 0, CPU time is unlimited.
+	CPUTime time.Duration
+}
+
+// Preopen grants a module access to a directory on the host.  The
+// host only honors preopens that lie beneath one of its preopen roots.
+type Preopen struct {
+	HostPath  string
+	GuestPath string
+	ReadOnly  bool
+}
+
+// Release the module's capabilities.
+func (m Module) Release() {
+	for _, t := range m.Topics {
+		t.Release()
+	}
+
+	for _, a := range m.Anchors {
+		a.Client.Release()
+	}
+}
+
+// Bind the module to a capnp struct.  It is used by capabilities
+// that embed modules, such as the scheduler.
+func (m Module) Bind(mod api.Module) error { return m.bind(mod) }
+
+func (m Module) bind(mod api.Module) (err error) {
+	if err = mod.SetBinary(m.Binary); err != nil {
+		return
+	}
+
+	if err = bindTextList(m.Args, mod.NewArgs); err != nil {
+		return
+	}
+
+	if err = bindTextList(m.Env, mod.NewEnv); err != nil {
+		return
+	}
+
+	mod.SetMemoryPages(m.MemoryPages)
+	mod.SetTimeout(int64(m.Timeout))
+	mod.SetCpuTime(int64(m.CPUTime))
+
+	if err = m.bindPreopens(mod); err != nil {
+		return
+	}
+
+	return m.bindCaps(mod)
+}
+
+func (m Module) bindPreopens(mod api.Module) error {
+	if len(m.Preopens) == 0 {
+		return nil
+	}
+
+	ps, err := mod.NewPreopens(int32(len(m.Preopens)))
+	if err != nil {
+		return err
+	}
+
+	for i, p := range m.Preopens {
+		if err = ps.At(i).SetHostPath(p.HostPath); err != nil {
+			break
+		}
+
+		if err = ps.At(i).SetGuestPath(p.GuestPath); err != nil {
+			break
+		}
+
+		ps.At(i).SetReadOnly(p.ReadOnly)
+	}
+
+	return err
+}
+
+func (m Module) bindCaps(mod api.Module) error {
+	if len(m.Topics)+len(m.Anchors) == 0 {
+		return nil
+	}
+
+	cs, err := mod.NewCaps(int32(len(m.Topics) + len(m.Anchors)))
+	if err != nil {
+		return err
+	}
+
+	var i int
+	bind := func(name string, kind api.Module_Cap_Kind, c *capnp.Client) error {
+		defer func() { i++ }()
+
+		seg := cs.Segment()
+		iface := capnp.NewInterface(seg, seg.Message().AddCap(c.AddRef()))
+
+		cs.At(i).SetKind(kind)
+		if err := cs.At(i).SetName(name); err != nil {
+			return err
+		}
+
+		return cs.At(i).SetClient(iface.ToPtr())
+	}
+
+	for name, t := range m.Topics {
+		if err = bind(name, api.Module_Cap_Kind_topic, t.Client); err != nil {
+			return err
+		}
+	}
+
+	for name, a := range m.Anchors {
+		if err = bind(name, api.Module_Cap_Kind_anchor, a.Client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// load the module.  The caller MUST call Release on the module when
+// finished, even if an error is returned.
+func (m *Module) load(mod api.Module) (err error) {
+	if m.Binary, err = mod.Binary(); err != nil {
+		return
+	}
+
+	if m.Args, err = loadTextList(mod.Args); err != nil {
+		return
+	}
+
+	if m.Env, err = loadTextList(mod.Env); err != nil {
+		return
+	}
+
+	m.MemoryPages = mod.MemoryPages()
+	m.Timeout = time.Duration(mod.Timeout())
+	m.CPUTime = time.Duration(mod.CpuTime())
+
+	if err = m.loadPreopens(mod); err != nil {
+		return
+	}
+
+	return m.loadCaps(mod)
+}
+
+func (m *Module) loadPreopens(mod api.Module) error {
+	ps, err := mod.Preopens()
+	if err != nil {
+		return err
+	}
+
+	m.Preopens = make([]Preopen, ps.Len())
+	for i := range m.Preopens {
+		m.Preopens[i].ReadOnly = ps.At(i).ReadOnly()
+
+		if m.Preopens[i].HostPath, err = ps.At(i).HostPath(); err != nil {
+			break
+		}
+
+		if m.Preopens[i].GuestPath, err = ps.At(i).GuestPath(); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+func (m *Module) loadCaps(mod api.Module) error {
+	cs, err := mod.Caps()
+	if err != nil {
+		return err
+	}
+
+	m.Topics = make(map[string]pscap.Topic)
+	m.Anchors = make(map[string]clcap.Register)
+
+	for i := 0; i < cs.Len(); i++ {
+		name, err := cs.At(i).Name()
+		if err != nil {
+			return err
+		}
+
+		ptr, err := cs.At(i).Client()
+		if err != nil {
+			return err
+		}
+
+		c := ptr.Interface().Client().AddRef()
+
+		switch kind := cs.At(i).Kind(); kind {
+		case api.Module_Cap_Kind_topic:
+			m.Topics[name] = pscap.Topic{Client: c}
+
+		case api.Module_Cap_Kind_anchor:
+			m.Anchors[name] = clcap.Register(clapi.Anchor{Client: c})
+
+		default:
+			c.Release()
+			return fmt.Errorf("invalid capability kind %s", kind)
+		}
+	}
+
+	return nil
+}
+
+// Spawn a WASI module on the remote host.  The module's standard
+// output and error are streamed to stdout and stderr, respectively.
+// Either MAY be nil, in which case the corresponding output is
+// discarded.
+//
+// The module is terminated when the returned release function is
+// called, if it has not already exited.
+func (e Executor) Spawn(ctx context.Context, m Module, stdout, stderr io.Writer) (Process, capnp.ReleaseFunc) {
+	f, release := api.Executor(e).Spawn(ctx, func(ps api.Executor_spawn_Params) error {
+		mod, err := ps.NewModule()
+		if err != nil {
+			return err
+		}
+
+		if err = m.bind(mod); err != nil {
+			return err
+		}
+
+		if stdout != nil {
+			if err = ps.SetStdout(newWriter(stdout)); err != nil {
+				return err
+			}
+		}
+
+		if stderr != nil {
+			err = ps.SetStderr(newWriter(stderr))
+		}
+
+		return err
+	})
+
+	return Process(f.Proc()), release
+}
+
+func (e *Server) Spawn(_ context.Context, call api.Executor_spawn) error {
+	select {
+	case <-e.cq:
+		return ErrClosed
+	default:
+	}
+
+	mod, err := call.Args().Module()
+	if err != nil {
+		return err
+	}
+
+	var m Module
+	if err = m.load(mod); err != nil {
+		m.Release()
+		return err
+	}
+
+	if err = e.checkPreopens(m.Preopens); err != nil {
+		m.Release()
+		return err
+	}
+
+	stdout, stderr := e.output(call.Args())
+
+	p, err := e.spawn(m, stdout, stderr)
+	if err != nil {
+		m.Release()
+		releaseWriters(stdout, stderr)
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		p.Shutdown()
+		return err
+	}
+
+	return res.SetProc(api.Process_ServerToClient(p, &defaultPolicy))
+}
+
+// checkPreopens ensures that each preopen's host path lies beneath one
+// of the server's preopen roots.  Host paths are replaced with their
+// canonical form, so that symlinks cannot be used to escape the root.
+func (e *Server) checkPreopens(ps []Preopen) error {
+	for i, p := range ps {
+		path, err := canonical(p.HostPath)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrPreopen, p.HostPath)
+		}
+
+		if !e.underPreopenRoot(path) {
+			return fmt.Errorf("%w: %s", ErrPreopen, p.HostPath)
+		}
+
+		ps[i].HostPath = path
+	}
+
+	return nil
+}
+
+func (e *Server) underPreopenRoot(path string) bool {
+	for _, root := range e.preopenRoots {
+		root, err := canonical(root)
+		if err != nil {
+			continue
+		}
+
+		if rel, err := filepath.Rel(root, path); err == nil &&
+			rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// canonical returns the absolute path, with symlinks resolved.  The
+// path MUST be absolute, and MUST exist.
+func canonical(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("%s: not an absolute path", path)
+	}
+
+	return filepath.EvalSymlinks(path)
+}
+
+// memory is a module's linear memory.
+type memory interface {
+	Read(offset, n uint32) ([]byte, bool)
+	Write(offset uint32, b []byte) bool
+}
+
+// hostEnv implements the host functions that give WASI modules access
+// to the capabilities they were spawned with.  It is independent of
+// the WebAssembly runtime.
+//
+// The functions are exported to the module under the "ww" namespace.
+// Capabilities are referenced by the name under which they were passed
+// to Spawn.  Each function returns a negative value on error.
+//
+//	topic_publish(name, name_len, msg, msg_len) -> i64
+//	topic_next(name, name_len, buf, buf_len) -> i64
+//	anchor_ls(name, name_len, buf, buf_len) -> i64
+//
+// Functions that write to 'buf' return the length of the full result,
+// which is truncated to 'buf_len'.  Callers can detect truncation, and
+// retry with a larger buffer.  Anchor children are newline-separated.
+type hostEnv struct {
+	ctx context.Context
+	log log.Logger
+	mod Module
+
+	mu   sync.Mutex
+	subs map[string]*hostSub
+}
+
+type hostSub struct {
+	ms     chan []byte
+	cancel func()
+}
+
+func newHostEnv(ctx context.Context, log log.Logger, m Module) *hostEnv {
+	return &hostEnv{
+		ctx:  ctx,
+		log:  log,
+		mod:  m,
+		subs: make(map[string]*hostSub),
+	}
+}
+
+// Close cancels subscriptions and releases capabilities.
+func (env *hostEnv) Close() {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+
+	for _, sub := range env.subs {
+		sub.cancel()
+	}
+
+	env.mod.Release()
+}
+
+func (env *hostEnv) TopicPublish(mem memory, name, nameLen, msg, msgLen uint32) int64 {
+	topic, ok := mem.Read(name, nameLen)
+	if !ok {
+		return -1
+	}
+
+	b, ok := mem.Read(msg, msgLen)
+	if !ok {
+		return -1
+	}
+
+	// copy; b is only valid for the duration of the call
+	return env.result(0, env.Publish(string(topic), append([]byte(nil), b...)))
+}
+
+func (env *hostEnv) TopicNext(mem memory, name, nameLen, buf, bufLen uint32) int64 {
+	topic, ok := mem.Read(name, nameLen)
+	if !ok {
+		return -1
+	}
+
+	msg, err := env.Next(string(topic))
+	if err != nil {
+		return env.result(0, err)
+	}
+
+	return env.write(mem, buf, bufLen, msg)
+}
+
+func (env *hostEnv) AnchorLs(mem memory, name, nameLen, buf, bufLen uint32) int64 {
+	anchor, ok := mem.Read(name, nameLen)
+	if !ok {
+		return -1
+	}
+
+	names, err := env.Ls(string(anchor))
+	if err != nil {
+		return env.result(0, err)
+	}
+
+	return env.write(mem, buf, bufLen, []byte(strings.Join(names, "\n")))
+}
+
+func (env *hostEnv) write(mem memory, buf, bufLen uint32, b []byte) int64 {
+	n := len(b)
+	if n > int(bufLen) {
+		b = b[:bufLen]
+	}
+
+	if !mem.Write(buf, b) {
+		return -1
+	}
+
+	return int64(n)
+}
+
+func (env *hostEnv) result(n int64, err error) int64 {
+	if err != nil {
+		env.log.WithError(err).Debug("host function failed")
+		return -1
+	}
+
+	return n
+}
+
+// Publish a message to the named topic.
+func (env *hostEnv) Publish(topic string, msg []byte) error {
+	t, ok := env.mod.Topics[topic]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoCap, topic)
+	}
+
+	return t.Publish(env.ctx, msg)
+}
+
+// Next blocks until a message is received on the named topic.  The
+// module is subscribed to the topic the first time Next is called.
+func (env *hostEnv) Next(topic string) ([]byte, error) {
+	sub, err := env.subscribe(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case msg, ok := <-sub.ms:
+		if !ok {
+			return nil, ErrClosed
+		}
+
+		return msg, nil
+
+	case <-env.ctx.Done():
+		return nil, env.ctx.Err()
+	}
+}
+
+func (env *hostEnv) subscribe(topic string) (*hostSub, error) {
+	env.mu.Lock()
+	defer env.mu.Unlock()
+
+	if sub, ok := env.subs[topic]; ok {
+		return sub, nil
+	}
+
+	t, ok := env.mod.Topics[topic]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoCap, topic)
+	}
+
+	sub := &hostSub{ms: make(chan []byte, 32)}
+	cancel, err := t.Subscribe(env.ctx, sub.ms)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.cancel = cancel
+	env.subs[topic] = sub
+	return sub, nil
+}
+
+// Ls returns the names of the named anchor's children.
+func (env *hostEnv) Ls(anchor string) ([]string, error) {
+	a, ok := env.mod.Anchors[anchor]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoCap, anchor)
+	}
+
+	it, release := a.Ls(env.ctx)
+	defer release()
+
+	var names []string
+	for it.Next() {
+		names = append(names, it.Name)
+	}
+
+	return names, it.Err
+}
@@ -0,0 +1,107 @@
+package proc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lthibault/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	psapi "github.com/wetware/ww/internal/api/pubsub"
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
+)
+
+func TestHostEnv(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("TopicPublish", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ms := make(chan []byte, 1)
+		topic := pscap.Topic(psapi.Topic_ServerToClient(mockTopic(ms), nil))
+
+		env := newHostEnv(ctx, log.New(), Module{
+			Topics: map[string]pscap.Topic{"foo": topic},
+		})
+		defer env.Close()
+
+		mem := make(mockMemory, 16)
+		copy(mem, "foohello")
+
+		n := env.TopicPublish(mem, 0, 3, 3, 5)
+		require.Zero(t, n, "should publish message")
+		assert.Equal(t, "hello", string(<-ms), "unexpected message")
+
+		n = env.TopicPublish(mem, 3, 5, 3, 5)
+		assert.Equal(t, int64(-1), n, "should fail for unknown topic")
+
+		n = env.TopicPublish(mem, 0, 3, 12, 8)
+		assert.Equal(t, int64(-1), n, "should fail for out-of-bounds read")
+	})
+
+	t.Run("AnchorLs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		root := clcap.Register{Client: clcap.NewHost(nil).Client()}
+		child, release := root.Walk(ctx, []string{"bar"})
+		defer release()
+		require.NoError(t, child.Client.Resolve(ctx), "should create child anchor")
+
+		env := newHostEnv(ctx, log.New(), Module{
+			Anchors: map[string]clcap.Register{"root": root},
+		})
+		defer env.Close()
+
+		mem := make(mockMemory, 8)
+		copy(mem, "root")
+
+		n := env.AnchorLs(mem, 0, 4, 4, 4)
+		require.Equal(t, int64(3), n, "should return length of result")
+		assert.Equal(t, "bar", string(mem[4:7]), "should list children")
+
+		n = env.AnchorLs(mem, 0, 4, 4, 2)
+		require.Equal(t, int64(3), n, "should return full length when truncated")
+	})
+}
+
+type mockTopic chan<- []byte
+
+func (t mockTopic) Publish(ctx context.Context, call psapi.Topic_publish) error {
+	b, err := call.Args().Msg()
+	if err == nil {
+		t <- append([]byte(nil), b...)
+	}
+
+	return err
+}
+
+func (mockTopic) Subscribe(context.Context, psapi.Topic_subscribe) error { return nil }
+func (mockTopic) Peers(context.Context, psapi.Topic_peers) error         { return nil }
+
+type mockMemory []byte
+
+func (m mockMemory) Read(offset, n uint32) ([]byte, bool) {
+	if uint64(offset)+uint64(n) > uint64(len(m)) {
+		return nil, false
+	}
+
+	return m[offset : offset+n], true
+}
+
+func (m mockMemory) Write(offset uint32, b []byte) bool {
+	if uint64(offset)+uint64(len(b)) > uint64(len(m)) {
+		return false
+	}
+
+	copy(m[offset:], b)
+	return true
+}
@@ -2,9 +2,12 @@ package pubsub
 
 import (
 	"context"
+	"sync/atomic"
+	"time"
 
 	capnp "capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/server"
+	"github.com/libp2p/go-libp2p-core/peer"
 
 	api "github.com/wetware/ww/internal/api/pubsub"
 )
@@ -19,6 +22,57 @@ func (ps PubSub) Join(ctx context.Context, topic string) (FutureTopic, capnp.Rel
 	return FutureTopic(f), release
 }
 
+// TopicInfo describes a topic that has been joined by the host.
+type TopicInfo struct {
+	Name        string
+	Subscribers int // number of subscriptions local to the host
+}
+
+// Ls returns the topics that are currently joined by the host.
+func (ps PubSub) Ls(ctx context.Context) ([]TopicInfo, error) {
+	f, release := api.PubSub(ps).Ls(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := res.Topics()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]TopicInfo, ts.Len())
+	for i := range infos {
+		if infos[i].Name, err = ts.At(i).Name(); err != nil {
+			break
+		}
+
+		infos[i].Subscribers = int(ts.At(i).Subscribers())
+	}
+
+	return infos, err
+}
+
+// Peers returns the IDs of the peers with which the host is exchanging
+// messages for the topic.  Unlike Topic.Peers, it does not cause the
+// host to join the topic, and fails with ErrNotJoined if the host has
+// not already done so.
+func (ps PubSub) Peers(ctx context.Context, topic string) (peer.IDSlice, error) {
+	f, release := api.PubSub(ps).Peers(ctx, func(ps api.PubSub_peers_Params) error {
+		return ps.SetName(topic)
+	})
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	return peers(res.Peers())
+}
+
 func (ps PubSub) AddRef() PubSub {
 	return PubSub(api.PubSub(ps).AddRef())
 }
@@ -52,16 +106,26 @@ func (t Topic) Publish(ctx context.Context, b []byte) error {
 	return err
 }
 
-func (t Topic) Subscribe(ctx context.Context, ch chan<- []byte) (cancel func(), err error) {
-	hc := api.Topic_Handler_ServerToClient(handler{
-		ms:      ch,
-		release: t.AddRef().Release,
-	}, &server.Policy{
+// Subscribe to the topic.  Messages are delivered to ch, whose
+// capacity determines the size of the subscription's buffer.  The
+// subscription's behavior when the buffer is full is determined by
+// its Policy.  See SubOpt.
+func (t Topic) Subscribe(ctx context.Context, ch chan []byte, opt ...SubOpt) (cancel func(), err error) {
+	h := handler{ms: ch}
+	for _, option := range opt {
+		option(&h)
+	}
+
+	h.release = t.AddRef().Release
+	hc := api.Topic_Handler_ServerToClient(h, &server.Policy{
 		MaxConcurrentCalls: cap(ch),
 	})
 	defer hc.Release() // ensure topic ref is released if we return an error
 
 	f, release := api.Topic(t).Subscribe(ctx, func(ps api.Topic_subscribe_Params) error {
+		ps.SetMaxInflight(h.inflight)
+		ps.SetBatchSize(h.batchSize)
+		ps.SetBatchLatency(int64(h.batchLatency))
 		return ps.SetHandler(hc.AddRef())
 	})
 	defer release()
@@ -76,6 +140,40 @@ func (t Topic) Subscribe(ctx context.Context, ch chan<- []byte) (cancel func(),
 	return hc.AddRef().Release, err
 }
 
+// Peers returns the IDs of the peers with which the host is
+// exchanging messages for the topic.
+func (t Topic) Peers(ctx context.Context) (peer.IDSlice, error) {
+	f, release := api.Topic(t).Peers(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	return peers(res.Peers())
+}
+
+func peers(ps capnp.TextList, err error) (peer.IDSlice, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(peer.IDSlice, ps.Len())
+	for i := range ids {
+		s, err := ps.At(i)
+		if err != nil {
+			return nil, err
+		}
+
+		if ids[i], err = peer.Decode(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
 func (t Topic) Release() { t.Client.Release() }
 
 func (t Topic) AddRef() Topic {
@@ -83,8 +181,14 @@ func (t Topic) AddRef() Topic {
 }
 
 type handler struct {
-	ms      chan<- []byte
-	release capnp.ReleaseFunc
+	ms       chan []byte
+	policy   Policy
+	inflight uint32
+	dropped  *uint64
+	release  capnp.ReleaseFunc
+
+	batchSize    uint32
+	batchLatency time.Duration
 }
 
 func (h handler) Shutdown() {
@@ -93,16 +197,65 @@ func (h handler) Shutdown() {
 }
 
 func (h handler) Handle(ctx context.Context, call api.Topic_Handler_handle) error {
-	b, err := call.Args().Msg()
+	ms, err := call.Args().Msgs()
 	if err != nil {
 		return err
 	}
 
-	select {
-	case h.ms <- b:
-		return nil
+	for i := 0; i < ms.Len(); i++ {
+		b, err := ms.At(i)
+		if err != nil {
+			return err
+		}
 
-	case <-ctx.Done():
-		return ctx.Err()
+		if err = h.deliver(ctx, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h handler) deliver(ctx context.Context, b []byte) error {
+	switch h.policy {
+	case DropNewest:
+		select {
+		case h.ms <- b:
+		default:
+			h.drop()
+		}
+
+	case DropOldest:
+		for {
+			select {
+			case h.ms <- b:
+				return nil
+			default:
+			}
+
+			// Buffer is full; evict the oldest message.  The
+			// consumer may have drained the buffer in the mean
+			// time, so this is non-blocking.
+			select {
+			case <-h.ms:
+				h.drop()
+			default:
+			}
+		}
+
+	default:
+		select {
+		case h.ms <- b:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (h handler) drop() {
+	if h.dropped != nil {
+		atomic.AddUint64(h.dropped, 1)
 	}
 }
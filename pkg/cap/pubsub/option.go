@@ -1,6 +1,9 @@
 package pubsub
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/lthibault/log"
 )
 
@@ -18,8 +21,94 @@ func WithLogger(l log.Logger) Option {
 	}
 }
 
+// WithReservedPrefix sets the prefix for topic names that clients
+// are not permitted to join.  If prefix == "", only the cluster
+// topic is reserved.  Defaults to DefaultReservedPrefix.
+func WithReservedPrefix(prefix string) Option {
+	return func(p *Provider) {
+		p.reserved = prefix
+	}
+}
+
 func withDefault(opt []Option) []Option {
 	return append([]Option{
 		WithLogger(nil),
+		WithReservedPrefix(DefaultReservedPrefix),
 	}, opt...)
 }
+
+// Policy determines how a subscription handles incoming messages
+// when its buffer is full.
+type Policy uint8
+
+const (
+	// Block until space becomes available in the buffer.  Back-
+	// pressure is propagated to the host, which drops messages
+	// once its own buffer is full.
+	Block Policy = iota
+
+	// DropOldest discards the oldest message in the buffer to
+	// make room for the incoming message.
+	DropOldest
+
+	// DropNewest discards the incoming message.
+	DropNewest
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	}
+
+	return fmt.Sprintf("Policy(%d)", uint8(p))
+}
+
+// SubOpt configures a subscription.
+type SubOpt func(*handler)
+
+// WithPolicy sets the overflow policy for the subscription.
+// The default policy is Block.
+func WithPolicy(p Policy) SubOpt {
+	return func(h *handler) {
+		h.policy = p
+	}
+}
+
+// WithMaxInflight sets the maximum number of messages that the
+// host will send to the subscriber before waiting for an ack.
+// If n == 0, the host's default is used.
+func WithMaxInflight(n uint32) SubOpt {
+	return func(h *handler) {
+		h.inflight = n
+	}
+}
+
+// WithDropCounter atomically increments the value pointed to by n
+// each time a message is discarded by the subscription's overflow
+// policy.
+func WithDropCounter(n *uint64) SubOpt {
+	return func(h *handler) {
+		h.dropped = n
+	}
+}
+
+// WithBatching requests that the host coalesce up to 'size' messages
+// into a single delivery.  A partial batch is delivered once 'latency'
+// has elapsed since its first message was received.  If latency <= 0,
+// the host's default is used.  The host also bounds the combined size
+// of each batch, so a batch may hold fewer than 'size' messages.
+// Batches are unpacked transparently, so the subscriber continues to
+// receive individual messages.
+//
+// By default, each message is delivered individually.
+func WithBatching(size uint32, latency time.Duration) SubOpt {
+	return func(h *handler) {
+		h.batchSize = size
+		h.batchLatency = latency
+	}
+}
@@ -2,6 +2,8 @@ package pubsub
 
 import "github.com/wetware/ww/pkg/vat"
 
+// Capability for the PubSub protocol.  Version 1.0.0 delivers messages
+// to Topic.Handler in batches, and does not interoperate with 0.x.
 var Capability = vat.BasicCap{
-	"pubsub/packed",
-	"pubsub"}
+	Name:     "pubsub",
+	Versions: []string{"1.0.0"}}
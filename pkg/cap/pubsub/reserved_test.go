@@ -0,0 +1,45 @@
+package pubsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReserved(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name, prefix, topic string
+		reserved            bool
+	}{
+		{name: "Cluster", prefix: "", topic: "ns", reserved: true},
+		{name: "ClusterWithPrefix", prefix: "_ww/", topic: "ns", reserved: true},
+		{name: "Prefix", prefix: "_ww/", topic: "_ww/foo", reserved: true},
+		{name: "PrefixOnly", prefix: "_ww/", topic: "_ww/", reserved: true},
+		{name: "Unreserved", prefix: "_ww/", topic: "foo"},
+		{name: "PrefixNotLeading", prefix: "_ww/", topic: "foo/_ww/"},
+		{name: "NoPrefix", prefix: "", topic: "_ww/foo"},
+		{name: "NamespacePrefix", prefix: "", topic: "ns/foo"},
+	} {
+		p := New("ns", nil, WithReservedPrefix(tt.prefix))
+		assert.Equal(t, tt.reserved, p.isReserved(tt.topic),
+			"%s: unexpected result for topic %q", tt.name, tt.topic)
+	}
+}
+
+func TestWithReservedPrefix(t *testing.T) {
+	t.Parallel()
+
+	p := New("ns", nil)
+	assert.Equal(t, DefaultReservedPrefix, p.reserved,
+		"should reserve default prefix")
+	assert.True(t, p.isReserved(DefaultReservedPrefix+"foo"),
+		"should reserve topics with default prefix")
+
+	p = New("ns", nil, WithReservedPrefix("_internal/"))
+	assert.Equal(t, "_internal/", p.reserved,
+		"should override default prefix")
+	assert.False(t, p.isReserved(DefaultReservedPrefix+"foo"),
+		"should not reserve default prefix when overridden")
+}
@@ -0,0 +1,134 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	inproc "github.com/lthibault/go-libp2p-inproc-transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Size", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		top, sub := subscribe(ctx, t)
+		publish(ctx, t, top, "a", "b", "c")
+
+		// The latency bound is never reached, so batches are
+		// flushed because they are full.
+		s := &sender{ctx: ctx, size: 2, bytes: maxBatchBytes, latency: time.Hour}
+
+		batch, err := s.Next(sub)
+		require.NoError(t, err, "should return batch")
+		assert.Equal(t, []string{"a", "b"}, data(batch))
+	})
+
+	t.Run("Bytes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		top, sub := subscribe(ctx, t)
+		publish(ctx, t, top, "aaaa", "bbbb", "cccc", "dddddddddd")
+
+		s := &sender{ctx: ctx, size: 10, bytes: 8, latency: time.Hour}
+
+		batch, err := s.Next(sub)
+		require.NoError(t, err, "should return batch")
+		assert.Equal(t, []string{"aaaa", "bbbb"}, data(batch),
+			"batch should be flushed when byte limit is reached")
+
+		batch, err = s.Next(sub)
+		require.NoError(t, err, "should return batch")
+		assert.Equal(t, []string{"cccc"}, data(batch),
+			"overflowing message should start the next batch")
+
+		batch, err = s.Next(sub)
+		require.NoError(t, err, "should return batch")
+		assert.Equal(t, []string{"dddddddddd"}, data(batch),
+			"oversized message should be delivered on its own")
+	})
+
+	t.Run("Latency", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		top, sub := subscribe(ctx, t)
+		publish(ctx, t, top, "a", "b")
+
+		s := &sender{ctx: ctx, size: 10, bytes: maxBatchBytes, latency: 10 * time.Millisecond}
+
+		batch, err := s.Next(sub)
+		require.NoError(t, err, "should return partial batch")
+		assert.Equal(t, []string{"a", "b"}, data(batch),
+			"partial batch should be flushed when latency has elapsed")
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		top, sub := subscribe(ctx, t)
+		publish(ctx, t, top, "a")
+
+		sctx, abort := context.WithCancel(ctx)
+		s := &sender{ctx: sctx, size: 10, bytes: maxBatchBytes, latency: time.Hour}
+		time.AfterFunc(10*time.Millisecond, abort)
+
+		_, err := s.Next(sub)
+		assert.ErrorIs(t, err, context.Canceled,
+			"should report error when subscription is aborted")
+	})
+}
+
+func subscribe(ctx context.Context, t *testing.T) (*pubsub.Topic, *pubsub.Subscription) {
+	h, err := libp2p.New(
+		libp2p.NoListenAddrs,
+		libp2p.NoTransports,
+		libp2p.Transport(inproc.New()),
+		libp2p.ListenAddrStrings("/inproc/~"))
+	require.NoError(t, err, "should create host")
+	t.Cleanup(func() { h.Close() })
+
+	gs, err := pubsub.NewGossipSub(ctx, h)
+	require.NoError(t, err, "should create pubsub")
+
+	top, err := gs.Join("test")
+	require.NoError(t, err, "should join topic")
+
+	sub, err := top.Subscribe()
+	require.NoError(t, err, "should subscribe")
+	t.Cleanup(sub.Cancel)
+
+	return top, sub
+}
+
+func publish(ctx context.Context, t *testing.T, top *pubsub.Topic, msgs ...string) {
+	for _, msg := range msgs {
+		require.NoError(t, top.Publish(ctx, []byte(msg)), "should publish")
+	}
+}
+
+func data(batch []*pubsub.Message) []string {
+	ms := make([]string, len(batch))
+	for i, m := range batch {
+		ms[i] = string(m.Data)
+	}
+
+	return ms
+}
@@ -4,22 +4,60 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/server"
+	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/lthibault/log"
 	ctxutil "github.com/lthibault/util/ctx"
+	"golang.org/x/sync/semaphore"
+
 	api "github.com/wetware/ww/internal/api/pubsub"
 )
 
-var ErrClosed = errors.New("closed")
+var (
+	ErrClosed = errors.New("closed")
+
+	// ErrReserved is returned when a client attempts to join a
+	// topic that is reserved for internal use by the host, such
+	// as the cluster topic.
+	ErrReserved = errors.New("reserved topic")
+
+	// ErrNotJoined is returned when a client requests information
+	// about a topic that the host has not joined.
+	ErrNotJoined = errors.New("topic not joined")
+)
+
+// DefaultReservedPrefix is the default prefix for topic names that
+// are reserved for internal use.  See WithReservedPrefix.
+const DefaultReservedPrefix = "_ww/"
 
 var defaultPolicy = server.Policy{
 	MaxConcurrentCalls: 64,
 }
 
+// defaultMaxInflight is the number of concurrent calls to
+// Handler.handle that are permitted for each subscription,
+// unless otherwise specified by the subscriber.
+const defaultMaxInflight = 8
+
+// defaultBatchLatency is the maximum amount of time a message is
+// held back while a batch is being filled, unless otherwise
+// specified by the subscriber.  It is only relevant when the
+// subscriber requested a batch size greater than one.
+const defaultBatchLatency = 10 * time.Millisecond
+
+// maxBatchBytes is the maximum combined size of the message payloads
+// in a batch.  A message that does not fit is held back, and starts
+// the next batch.  A message larger than maxBatchBytes is delivered
+// on its own.
+const maxBatchBytes = 1 << 20
+
 type TopicJoiner interface {
 	Join(string, ...pubsub.TopicOpt) (*pubsub.Topic, error)
 }
@@ -28,9 +66,17 @@ type TopicJoiner interface {
 //
 // In order to export a given topic through multiple capabilities,
 // Provider tracks existing topics internally.  See 'Join' for more details.
+//
+// Provider refuses to join the cluster topic, whose name is equal to
+// the namespace, as well as any topic whose name begins with the
+// reserved prefix.  This prevents clients from publishing forged
+// heartbeats.  Cluster membership is instead exported through the
+// dedicated View capability.
 type Provider struct {
-	cq  chan struct{}
-	log log.Logger
+	cq       chan struct{}
+	log      log.Logger
+	ns       string
+	reserved string // topic prefix
 
 	ps TopicJoiner
 
@@ -42,6 +88,7 @@ type Provider struct {
 func New(ns string, ps TopicJoiner, opt ...Option) *Provider {
 	var f = &Provider{
 		cq: make(chan struct{}),
+		ns: ns,
 		ps: ps,
 		ts: make(map[string]*refCountedTopic),
 	}
@@ -79,6 +126,10 @@ func (p *Provider) Join(ctx context.Context, call api.PubSub_join) error {
 		return err
 	}
 
+	if p.isReserved(name) {
+		return fmt.Errorf("%w: %s", ErrReserved, name)
+	}
+
 	t, err := p.getOrCreate(name)
 	if err != nil {
 		return err
@@ -92,6 +143,73 @@ func (p *Provider) Join(ctx context.Context, call api.PubSub_join) error {
 	return res.SetTopic(api.Topic_ServerToClient(t, &defaultPolicy))
 }
 
+func (p *Provider) isReserved(topic string) bool {
+	return topic == p.ns ||
+		(p.reserved != "" && strings.HasPrefix(topic, p.reserved))
+}
+
+// Ls returns the topics that are currently joined by the provider,
+// along with the number of local subscriptions to each.
+func (p *Provider) Ls(_ context.Context, call api.PubSub_ls) error {
+	// Snapshot the topic map.  Topics must not be locked while
+	// holding p.mu, since refCountedTopic.release acquires p.mu
+	// while holding the topic's lock.
+	p.mu.RLock()
+	ts := make([]*refCountedTopic, 0, len(p.ts))
+	for _, t := range p.ts {
+		ts = append(ts, t)
+	}
+	p.mu.RUnlock()
+
+	sort.Slice(ts, func(i, j int) bool {
+		return ts[i].topic.String() < ts[j].topic.String()
+	})
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	infos, err := res.NewTopics(int32(len(ts)))
+	if err != nil {
+		return err
+	}
+
+	for i, t := range ts {
+		if err = infos.At(i).SetName(t.topic.String()); err != nil {
+			break
+		}
+
+		infos.At(i).SetSubscribers(uint32(t.Subscribers()))
+	}
+
+	return err
+}
+
+// Peers returns the peers of a topic that has already been joined by
+// the provider.  It does not join the topic.
+func (p *Provider) Peers(_ context.Context, call api.PubSub_peers) error {
+	name, err := call.Args().Name()
+	if err != nil {
+		return err
+	}
+
+	p.mu.RLock()
+	t, ok := p.ts[name]
+	p.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotJoined, name)
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return setPeers(res.NewPeers, t.topic.ListPeers())
+}
+
 func (p *Provider) getOrCreate(topic string) (*refCountedTopic, error) {
 	p.mu.RLock()
 
@@ -156,8 +274,9 @@ type refCountedTopic struct {
 	log   log.Logger
 	topic *pubsub.Topic
 
-	mu  sync.Mutex
-	ref int // number of refs from capnp.Client instances
+	mu   sync.Mutex
+	ref  int // number of refs from capnp.Client instances
+	subs int // number of active subscriptions
 
 	release capnp.ReleaseFunc // caller MUST hold mu
 }
@@ -181,6 +300,21 @@ func (t *refCountedTopic) Release() {
 	}
 }
 
+// Subscribers returns the number of active subscriptions to t.
+func (t *refCountedTopic) Subscribers() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.subs
+}
+
+func (t *refCountedTopic) unsubscribe() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.subs--
+}
+
 // The refCountedTopic is unique for each *pubsub.Topic, and is
 // therefore shared across multiple capnp.Client instances. For
 // this reason, Shutdown MAY be called multiple times.
@@ -213,38 +347,194 @@ func (t *refCountedTopic) Subscribe(_ context.Context, call api.Topic_subscribe)
 	}
 
 	t.ref++
-	go t.handle(sub, call.Args().Handler().AddRef())
+	t.subs++
+	go t.handle(sub, newSender(t.ctx, call.Args()))
 
 	return nil
 }
 
-func (t *refCountedTopic) handle(sub *pubsub.Subscription, h api.Topic_Handler) {
+func (t *refCountedTopic) Peers(_ context.Context, call api.Topic_peers) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return setPeers(res.NewPeers, t.topic.ListPeers())
+}
+
+func setPeers(alloc func(int32) (capnp.TextList, error), ids []peer.ID) error {
+	ps, err := alloc(int32(len(ids)))
+	if err != nil {
+		return err
+	}
+
+	for i, id := range ids {
+		if err = ps.Set(i, id.String()); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+func (t *refCountedTopic) handle(sub *pubsub.Subscription, s *sender) {
 	defer t.Release()
+	defer t.unsubscribe()
 	defer sub.Cancel()
-	defer h.Release()
+	defer s.Close()
 
 	for {
-		m, err := sub.Next(t.ctx)
+		batch, err := s.Next(sub)
 		if err != nil {
 			return
 		}
 
-		if t.send(h, m) != nil {
+		if s.Send(batch) != nil {
 			return
 		}
 	}
 }
 
-func (t *refCountedTopic) send(h api.Topic_Handler, m *pubsub.Message) error {
-	f, release := h.Handle(t.ctx, message(m))
-	defer release()
+// sender pipelines calls to Handler.handle, allowing up to 'n' calls
+// to be in-flight at any given time.  Message order is preserved by
+// the RPC layer.  When the limit is reached, the sender blocks, which
+// causes the libp2p subscription's buffer to fill up.
+//
+// Messages are coalesced into batches of up to 'size' messages and
+// 'bytes' bytes.  A batch is sent as soon as it is full, or when
+// 'latency' has elapsed since its first message arrived, whichever
+// comes first.
+type sender struct {
+	ctx    context.Context
+	cancel context.CancelFunc // aborts the subscription on handler error
+	h      api.Topic_Handler
+	n      int64
+	lim    *semaphore.Weighted
+
+	size    int
+	bytes   int
+	latency time.Duration
+	pending *pubsub.Message // overflow from the previous batch
+}
 
-	_, err := f.Struct()
-	return err
+func newSender(ctx context.Context, args api.Topic_subscribe_Params) *sender {
+	n := int64(args.MaxInflight())
+	if n == 0 {
+		n = defaultMaxInflight
+	}
+
+	size := int(args.BatchSize())
+	if size == 0 {
+		size = 1
+	}
+
+	latency := time.Duration(args.BatchLatency())
+	if latency <= 0 {
+		latency = defaultBatchLatency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &sender{
+		ctx:     ctx,
+		cancel:  cancel,
+		h:       args.Handler().AddRef(),
+		n:       n,
+		lim:     semaphore.NewWeighted(n),
+		size:    size,
+		bytes:   maxBatchBytes,
+		latency: latency,
+	}
+}
+
+// Next blocks until a message is available, and then collects
+// further messages until the batch is full or its latency bound
+// has expired.
+func (s *sender) Next(sub *pubsub.Subscription) ([]*pubsub.Message, error) {
+	m, err := s.next(s.ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := []*pubsub.Message{m}
+	if s.size == 1 {
+		return batch, nil
+	}
+
+	ctx, cancel := context.WithTimeout(s.ctx, s.latency)
+	defer cancel()
+
+	for n := len(m.Data); len(batch) < s.size && n < s.bytes; {
+		if m, err = sub.Next(ctx); err != nil {
+			break
+		}
+
+		if n += len(m.Data); n > s.bytes {
+			s.pending = m
+			break
+		}
+
+		batch = append(batch, m)
+	}
+
+	// Deliver whatever was collected, unless the subscription
+	// itself was aborted.
+	return batch, s.ctx.Err()
+}
+
+// next returns the message that overflowed the previous batch, if
+// any.  Otherwise, it blocks until the next message is received.
+func (s *sender) next(ctx context.Context, sub *pubsub.Subscription) (*pubsub.Message, error) {
+	if m := s.pending; m != nil {
+		s.pending = nil
+		return m, nil
+	}
+
+	return sub.Next(ctx)
 }
 
-func message(m *pubsub.Message) func(api.Topic_Handler_handle_Params) error {
+func (s *sender) Send(batch []*pubsub.Message) error {
+	if err := s.lim.Acquire(s.ctx, 1); err != nil {
+		return err
+	}
+
+	f, release := s.h.Handle(s.ctx, messages(batch))
+	go func() {
+		defer s.lim.Release(1)
+		defer release()
+
+		if _, err := f.Struct(); err != nil {
+			s.cancel()
+		}
+	}()
+
+	return nil
+}
+
+// Close aborts in-flight calls, blocks until they have returned,
+// and releases the handler.
+func (s *sender) Close() {
+	defer s.h.Release()
+	s.cancel()
+
+	// Acquiring the full weight of the semaphore ensures that
+	// no calls are in-flight.  This cannot fail, since the
+	// background context never expires.
+	_ = s.lim.Acquire(context.Background(), s.n)
+}
+
+func messages(batch []*pubsub.Message) func(api.Topic_Handler_handle_Params) error {
 	return func(ps api.Topic_Handler_handle_Params) error {
-		return ps.SetMsg(m.Data)
+		ms, err := ps.NewMsgs(int32(len(batch)))
+		if err != nil {
+			return err
+		}
+
+		for i, m := range batch {
+			if err = ms.Set(i, m.Data); err != nil {
+				break
+			}
+		}
+
+		return err
 	}
 }
@@ -17,10 +17,19 @@ import (
 	pscap "github.com/wetware/ww/pkg/cap/pubsub"
 )
 
+func TestCapability(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, pscap.Capability.Match("pubsub/1.0.0/packed"),
+		"should match batched protocol")
+	assert.False(t, pscap.Capability.Match("pubsub/0.1.0/packed"),
+		"should not match unbatched protocol")
+}
+
 func TestPubSub_refcount(t *testing.T) {
 	t.Parallel()
 
-	const topic = "test"
+	const topic = "foo"
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -83,7 +92,7 @@ func TestPubSub_refcount(t *testing.T) {
 
 			require.Eventually(t, func() bool {
 				return len(ch) == cap(ch)
-			}, time.Millisecond*10, time.Millisecond, "should receive message")
+			}, time.Second, time.Millisecond, "should receive message")
 
 			assert.Equal(t, "test", string(<-ch),
 				"should match previously-published message")
@@ -91,6 +100,97 @@ func TestPubSub_refcount(t *testing.T) {
 	}
 }
 
+func TestPubSub_ls(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := newTestHost()
+	defer h.Close()
+
+	gs, err := pubsub.NewGossipSub(ctx, h)
+	require.NoError(t, err)
+
+	p := pscap.New("test", gs)
+	defer p.Close()
+
+	ps := pscap.PubSub{p.Client()}
+	defer ps.Release()
+
+	ts, err := ps.Ls(ctx)
+	require.NoError(t, err, "should list topics")
+	assert.Empty(t, ts, "should not have joined any topics")
+
+	_, err = ps.Peers(ctx, "foo")
+	assert.ErrorContains(t, err, pscap.ErrNotJoined.Error(),
+		"should not list peers of unknown topic")
+
+	ts, err = ps.Ls(ctx)
+	require.NoError(t, err, "should list topics")
+	assert.Empty(t, ts, "listing peers should not join topic")
+
+	f, release := ps.Join(ctx, "foo")
+	defer release()
+
+	top, err := f.Struct()
+	require.NoError(t, err, "should resolve topic")
+	defer top.Release()
+
+	unsub, err := top.Subscribe(ctx, make(chan []byte, 1))
+	require.NoError(t, err, "should subscribe")
+	defer unsub()
+
+	ts, err = ps.Ls(ctx)
+	require.NoError(t, err, "should list topics")
+	require.Len(t, ts, 1, "should have joined one topic")
+	assert.Equal(t, "foo", ts[0].Name, "unexpected topic name")
+	assert.Equal(t, 1, ts[0].Subscribers, "should have one subscriber")
+
+	peers, err := top.Peers(ctx)
+	require.NoError(t, err, "should list peers")
+	assert.Empty(t, peers, "should not have any peers")
+
+	peers, err = ps.Peers(ctx, "foo")
+	require.NoError(t, err, "should list peers of joined topic")
+	assert.Empty(t, peers, "should not have any peers")
+}
+
+func TestPubSub_reserved(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := newTestHost()
+	defer h.Close()
+
+	gs, err := pubsub.NewGossipSub(ctx, h)
+	require.NoError(t, err)
+
+	p := pscap.New("ns", gs, pscap.WithReservedPrefix("_internal/"))
+	defer p.Close()
+
+	ps := pscap.PubSub{p.Client()}
+	defer ps.Release()
+
+	for _, topic := range []string{"ns", "_internal/foo"} {
+		f, release := ps.Join(ctx, topic)
+		defer release()
+
+		_, err := f.Struct()
+		assert.ErrorContains(t, err, pscap.ErrReserved.Error(),
+			"should refuse to join reserved topic %s", topic)
+	}
+
+	f, release := ps.Join(ctx, "foo")
+	defer release()
+
+	top, err := f.Struct()
+	require.NoError(t, err, "should join unreserved topic")
+	top.Release()
+}
+
 func newTestHost() host.Host {
 	h, err := libp2p.New(
 		libp2p.NoListenAddrs,
@@ -28,9 +28,7 @@ func TestHandler(t *testing.T) {
 		c := api.Topic_Handler_ServerToClient(h, nil)
 		defer c.Release()
 
-		f, release := c.Handle(ctx, func(ps api.Topic_Handler_handle_Params) error {
-			return ps.SetMsg([]byte("test"))
-		})
+		f, release := c.Handle(ctx, batch("test"))
 		defer release()
 
 		_, err := f.Struct()
@@ -38,6 +36,31 @@ func TestHandler(t *testing.T) {
 		assert.Equal(t, "test", string(<-ms), "unexpected message")
 	})
 
+	t.Run("HandleBatch", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ms := make(chan []byte, 3)
+		h := handler{
+			ms:      ms,
+			release: func() {},
+		}
+
+		c := api.Topic_Handler_ServerToClient(h, nil)
+		defer c.Release()
+
+		f, release := c.Handle(ctx, batch("foo", "bar", "baz"))
+		defer release()
+
+		_, err := f.Struct()
+		require.NoError(t, err, "call to Handle should succeed")
+		assert.Equal(t, "foo", string(<-ms), "unexpected message")
+		assert.Equal(t, "bar", string(<-ms), "unexpected message")
+		assert.Equal(t, "baz", string(<-ms), "unexpected message")
+	})
+
 	t.Run("Release", func(t *testing.T) {
 		t.Parallel()
 
@@ -59,4 +82,77 @@ func TestHandler(t *testing.T) {
 		_, ok := <-ms
 		assert.False(t, ok, "should close message channel when released")
 	})
+
+	t.Run("DropNewest", func(t *testing.T) {
+		t.Parallel()
+
+		var dropped uint64
+		ms := make(chan []byte, 1)
+		h := handler{
+			ms:      ms,
+			policy:  DropNewest,
+			dropped: &dropped,
+			release: func() {},
+		}
+
+		c := api.Topic_Handler_ServerToClient(h, nil)
+		defer c.Release()
+
+		for _, msg := range []string{"first", "second"} {
+			err := handle(c, msg)
+			require.NoError(t, err, "call to Handle should succeed")
+		}
+
+		assert.Equal(t, uint64(1), dropped, "should drop one message")
+		assert.Equal(t, "first", string(<-ms), "should retain oldest message")
+	})
+
+	t.Run("DropOldest", func(t *testing.T) {
+		t.Parallel()
+
+		var dropped uint64
+		ms := make(chan []byte, 1)
+		h := handler{
+			ms:      ms,
+			policy:  DropOldest,
+			dropped: &dropped,
+			release: func() {},
+		}
+
+		c := api.Topic_Handler_ServerToClient(h, nil)
+		defer c.Release()
+
+		for _, msg := range []string{"first", "second"} {
+			err := handle(c, msg)
+			require.NoError(t, err, "call to Handle should succeed")
+		}
+
+		assert.Equal(t, uint64(1), dropped, "should drop one message")
+		assert.Equal(t, "second", string(<-ms), "should retain newest message")
+	})
+}
+
+func handle(c api.Topic_Handler, msg string) error {
+	f, release := c.Handle(context.Background(), batch(msg))
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+func batch(msgs ...string) func(api.Topic_Handler_handle_Params) error {
+	return func(ps api.Topic_Handler_handle_Params) error {
+		ms, err := ps.NewMsgs(int32(len(msgs)))
+		if err != nil {
+			return err
+		}
+
+		for i, msg := range msgs {
+			if err = ms.Set(i, []byte(msg)); err != nil {
+				break
+			}
+		}
+
+		return err
+	}
 }
@@ -0,0 +1,233 @@
+package sched
+
+import (
+	"context"
+	"errors"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/peer"
+
+	api "github.com/wetware/ww/internal/api/process"
+	"github.com/wetware/ww/pkg/cap/proc"
+)
+
+// JobSpec describes a job to be placed on hosts by the scheduler.
+// Exactly one of Command and Module MUST be set.
+type JobSpec struct {
+	Name    string // if empty, a name is assigned by the scheduler
+	Command *proc.Command
+	Module  *proc.Module
+
+	// Requests are the resources reserved for each replica on its
+	// host.  They are also used as the replica's limits, unless the
+	// command sets its own.
+	Requests proc.Limits
+	Selector map[string]string // hosts MUST have all labels
+	Replicas int               // defaults to one
+}
+
+// Bind the spec to a capnp struct.  It is used by capabilities that
+// embed jobs, such as cron.
+func (spec JobSpec) Bind(s api.JobSpec) error { return spec.bind(s) }
+
+func (spec JobSpec) bind(s api.JobSpec) (err error) {
+	if (spec.Command == nil) == (spec.Module == nil) {
+		return errors.New("job must specify exactly one of command and module")
+	}
+
+	if err = s.SetName(spec.Name); err != nil {
+		return
+	}
+
+	if spec.Command != nil {
+		c, err := s.NewCommand()
+		if err == nil {
+			err = spec.Command.Bind(c)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if spec.Module != nil {
+		m, err := s.NewModule()
+		if err == nil {
+			err = spec.Module.Bind(m)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	lim, err := s.NewRequests()
+	if err != nil {
+		return
+	}
+	spec.Requests.Bind(lim)
+
+	if len(spec.Selector) > 0 {
+		ls, err := s.NewSelector(int32(len(spec.Selector)))
+		if err != nil {
+			return err
+		}
+
+		var i int
+		for k, v := range spec.Selector {
+			if err = ls.At(i).SetKey(k); err != nil {
+				return err
+			}
+
+			if err = ls.At(i).SetValue(v); err != nil {
+				return err
+			}
+
+			i++
+		}
+	}
+
+	s.SetReplicas(uint32(spec.Replicas))
+	return nil
+}
+
+type Scheduler api.Scheduler
+
+// Submit a job to the scheduler.  The job continues to run after the
+// returned release function is called; use Job.Stop to stop it.
+func (s Scheduler) Submit(ctx context.Context, spec JobSpec) (Job, capnp.ReleaseFunc) {
+	f, release := api.Scheduler(s).Submit(ctx, func(ps api.Scheduler_submit_Params) error {
+		js, err := ps.NewSpec()
+		if err == nil {
+			err = spec.bind(js)
+		}
+
+		return err
+	})
+
+	return Job(f.Job()), release
+}
+
+// Ls returns the names of the scheduler's jobs.
+func (s Scheduler) Ls(ctx context.Context) ([]string, error) {
+	f, release := api.Scheduler(s).Ls(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := res.Jobs()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, jobs.Len())
+	for i := range names {
+		if names[i], err = jobs.At(i); err != nil {
+			break
+		}
+	}
+
+	return names, err
+}
+
+// Lookup returns the job with the specified name.
+func (s Scheduler) Lookup(ctx context.Context, name string) (Job, capnp.ReleaseFunc) {
+	f, release := api.Scheduler(s).Lookup(ctx, func(ps api.Scheduler_lookup_Params) error {
+		return ps.SetName(name)
+	})
+
+	return Job(f.Job()), release
+}
+
+func (s Scheduler) AddRef() Scheduler {
+	return Scheduler(api.Scheduler(s).AddRef())
+}
+
+func (s Scheduler) Release() { s.Client.Release() }
+
+// ReplicaState is the state of one of a job's replicas.
+type ReplicaState uint16
+
+const (
+	Pending ReplicaState = ReplicaState(api.Job_Replica_State_pending)
+	Running ReplicaState = ReplicaState(api.Job_Replica_State_running)
+	Exited  ReplicaState = ReplicaState(api.Job_Replica_State_exited)
+)
+
+func (s ReplicaState) String() string { return api.Job_Replica_State(s).String() }
+
+// Replica of a job.
+type Replica struct {
+	Host       peer.ID // empty if pending
+	State      ReplicaState
+	ExitCode   int // valid iff State == Exited
+	Placements int // number of times the replica was placed on a host
+}
+
+type Job api.Job
+
+// Status returns the state of each of the job's replicas.
+func (j Job) Status(ctx context.Context) ([]Replica, error) {
+	f, release := api.Job(j).Status(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return nil, err
+	}
+
+	rs, err := res.Replicas()
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := make([]Replica, rs.Len())
+	for i := range replicas {
+		host, err := rs.At(i).Host()
+		if err != nil {
+			return nil, err
+		}
+
+		if host != "" {
+			if replicas[i].Host, err = peer.Decode(host); err != nil {
+				return nil, err
+			}
+		}
+
+		replicas[i].State = ReplicaState(rs.At(i).State())
+		replicas[i].ExitCode = int(rs.At(i).ExitCode())
+		replicas[i].Placements = int(rs.At(i).Placements())
+	}
+
+	return replicas, nil
+}
+
+// Name returns the job's name, which is assigned by the scheduler if
+// the spec did not provide one.
+func (j Job) Name(ctx context.Context) (string, error) {
+	f, release := api.Job(j).Name(ctx, nil)
+	defer release()
+
+	res, err := f.Struct()
+	if err != nil {
+		return "", err
+	}
+
+	return res.Name()
+}
+
+// Stop the job, killing its replicas.
+func (j Job) Stop(ctx context.Context) error {
+	f, release := api.Job(j).Stop(ctx, nil)
+	defer release()
+
+	_, err := f.Struct()
+	return err
+}
+
+func (j Job) AddRef() Job {
+	return Job(api.Job(j).AddRef())
+}
+
+func (j Job) Release() { j.Client.Release() }
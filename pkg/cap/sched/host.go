@@ -0,0 +1,16 @@
+package sched
+
+import (
+	"github.com/wetware/casm/pkg/cluster/routing"
+
+	"github.com/wetware/ww/pkg/cap/cluster/meta"
+)
+
+// HostInfo is published in each host's heartbeat, and is used by the
+// scheduler to select the hosts on which jobs are placed.
+type HostInfo = meta.HostInfo
+
+// Info returns the host info published in a routing record.
+func Info(rec routing.Record) (HostInfo, error) {
+	return meta.Load(rec)
+}
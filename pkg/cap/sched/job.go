@@ -0,0 +1,339 @@
+package sched
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/lthibault/log"
+
+	api "github.com/wetware/ww/internal/api/process"
+	"github.com/wetware/ww/pkg/cap/proc"
+)
+
+// job is the server implementation of Job.  Each replica is managed by
+// its own goroutine, which places it on a host and waits for it to
+// exit.
+type job struct {
+	s      *Server
+	log    log.Logger
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	name     string
+	spec     api.JobSpec // owned by the job
+	selector map[string]string
+	requests proc.Limits
+
+	mu       sync.Mutex
+	replicas []replica
+	running  int // replicas that have not exited
+}
+
+type replica struct {
+	host       peer.ID
+	state      ReplicaState
+	code       int
+	placements int
+}
+
+// newJob copies the spec into a message owned by the job, so that it
+// outlives the call that submitted it.
+func (s *Server) newJob(src api.JobSpec) (*job, error) {
+	if src.HasCommand() == src.HasModule() {
+		return nil, errors.New("job must specify exactly one of command and module")
+	}
+
+	name, err := src.Name()
+	if err != nil {
+		return nil, err
+	}
+
+	sel, err := loadSelector(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var req proc.Limits
+	if src.HasRequests() {
+		r, err := src.Requests()
+		if err != nil {
+			return nil, err
+		}
+		req = proc.Limits{CPU: r.Cpu(), Memory: r.Memory(), PIDs: r.Pids()}
+	}
+
+	msg, _, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = msg.SetRoot(src.ToPtr()); err != nil {
+		return nil, err
+	}
+
+	spec, err := api.ReadRootJobSpec(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	n := int(src.Replicas())
+	if n == 0 {
+		n = 1
+	}
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	return &job{
+		s:        s,
+		ctx:      ctx,
+		cancel:   cancel,
+		name:     name,
+		spec:     spec,
+		selector: sel,
+		requests: req,
+		replicas: make([]replica, n),
+		running:  n,
+	}, nil
+}
+
+func (j *job) Status(_ context.Context, call api.Job_status) error {
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	rs, err := res.NewReplicas(int32(len(j.replicas)))
+	if err != nil {
+		return err
+	}
+
+	for i, r := range j.replicas {
+		if r.host != "" {
+			if err = rs.At(i).SetHost(r.host.String()); err != nil {
+				return err
+			}
+		}
+
+		rs.At(i).SetState(api.Job_Replica_State(r.state))
+		rs.At(i).SetExitCode(int32(r.code))
+		rs.At(i).SetPlacements(uint32(r.placements))
+	}
+
+	return nil
+}
+
+func (j *job) Name(_ context.Context, call api.Job_name) error {
+	res, err := call.AllocResults()
+	if err == nil {
+		err = res.SetName(j.name)
+	}
+
+	return err
+}
+
+// Stop kills the job's replicas, and removes the job from the
+// scheduler.
+func (j *job) Stop(context.Context, api.Job_stop) error {
+	j.cancel()
+	j.s.remove(j)
+	return nil
+}
+
+// run the ith replica until it exits or the job is stopped.
+func (j *job) run(i int) {
+	defer j.s.wg.Done()
+	defer j.done()
+
+	for {
+		id, err := j.s.place(j)
+		if err != nil {
+			j.set(i, func(r *replica) { r.host, r.state = "", Pending })
+			j.log.WithError(err).Debug("replica pending")
+
+			if j.sleep() {
+				continue
+			}
+
+			return
+		}
+
+		j.set(i, func(r *replica) {
+			r.host, r.state = id, Running
+			r.placements++
+		})
+
+		code, exited := j.runOn(id)
+		j.s.release(id, j)
+
+		if exited {
+			j.set(i, func(r *replica) { r.state, r.code = Exited, code })
+			return
+		}
+
+		if j.ctx.Err() != nil {
+			return
+		}
+
+		j.log.WithField("peer", id).Info("rescheduling replica")
+	}
+}
+
+// runOn runs the replica on the host.  It returns when the replica has
+// exited, the host has left the cluster, or the job has been stopped,
+// killing the replica in the latter two cases.
+func (j *job) runOn(id peer.ID) (code int, exited bool) {
+	ctx, cancel := context.WithCancel(j.ctx)
+	defer cancel()
+
+	e, release, err := j.s.dialer.Dial(ctx, id)
+	if err != nil {
+		j.log.WithError(err).WithField("peer", id).Debug("failed to dial host")
+		j.sleep()
+		return 0, false
+	}
+	defer release()
+
+	p, release := j.exec(ctx, e)
+	defer release() // kills the replica, if it is still running
+
+	type result struct {
+		code int
+		err  error
+	}
+
+	cherr := make(chan result, 1)
+	go func() {
+		code, err := p.Wait(ctx)
+		cherr <- result{code, err}
+	}()
+
+	ticker := time.NewTicker(j.s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case r := <-cherr:
+			if r.err != nil && ctx.Err() == nil {
+				j.log.WithError(r.err).WithField("peer", id).Debug("replica failed")
+				j.sleep()
+			}
+			return r.code, r.err == nil
+
+		case <-ticker.C:
+			if _, ok := j.s.rt.Lookup(id); !ok {
+				j.log.WithField("peer", id).Debug("host expired")
+				return 0, false
+			}
+
+		case <-ctx.Done():
+			return 0, false
+		}
+	}
+}
+
+// exec the command or module in the job's spec.  Output is discarded.
+// The resources requested by the job are used as the replica's limits,
+// unless the spec overrides them.
+func (j *job) exec(ctx context.Context, e proc.Executor) (proc.Process, capnp.ReleaseFunc) {
+	if j.spec.HasModule() {
+		f, release := api.Executor(e).Spawn(ctx, func(ps api.Executor_spawn_Params) error {
+			m, err := j.spec.Module()
+			if err != nil {
+				return err
+			}
+
+			return ps.SetModule(m)
+		})
+
+		return proc.Process(f.Proc()), release
+	}
+
+	f, release := api.Executor(e).Exec(ctx, func(ps api.Executor_exec_Params) error {
+		c, err := j.spec.Command()
+		if err != nil {
+			return err
+		}
+
+		if err = ps.SetCmd(c); err != nil {
+			return err
+		}
+
+		if c, err = ps.Cmd(); err != nil {
+			return err
+		}
+
+		lim, err := c.Limits()
+		if err != nil {
+			return err
+		}
+
+		if lim.Cpu() == 0 && lim.Memory() == 0 && lim.Pids() == 0 {
+			if lim, err = c.NewLimits(); err == nil {
+				j.requests.Bind(lim)
+			}
+		}
+
+		return err
+	})
+
+	return proc.Process(f.Proc()), release
+}
+
+func (j *job) set(i int, f func(*replica)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	f(&j.replicas[i])
+}
+
+// done is called when a replica's goroutine returns.  Jobs whose
+// replicas have all exited remain listed until they are stopped, so
+// that their status can be inspected.
+func (j *job) done() {
+	j.mu.Lock()
+	j.running--
+	last := j.running == 0
+	j.mu.Unlock()
+
+	if last {
+		j.cancel()
+	}
+}
+
+// sleep for the scheduler's interval, and report whether the job is
+// still active.
+func (j *job) sleep() bool {
+	select {
+	case <-time.After(j.s.interval):
+		return true
+	case <-j.ctx.Done():
+		return false
+	}
+}
+
+func loadSelector(spec api.JobSpec) (map[string]string, error) {
+	ls, err := spec.Selector()
+	if err != nil || ls.Len() == 0 {
+		return nil, err
+	}
+
+	sel := make(map[string]string, ls.Len())
+	for i := 0; i < ls.Len(); i++ {
+		k, err := ls.At(i).Key()
+		if err != nil {
+			return nil, err
+		}
+
+		if sel[k], err = ls.At(i).Value(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sel, nil
+}
@@ -0,0 +1,42 @@
+package sched
+
+import (
+	"time"
+
+	"github.com/lthibault/log"
+)
+
+type Option func(*Server)
+
+// WithLogger sets the logger for the scheduler.  If l == nil, a
+// default logger is used.
+func WithLogger(l log.Logger) Option {
+	if l == nil {
+		l = log.New()
+	}
+
+	return func(s *Server) {
+		s.log = l
+	}
+}
+
+// WithInterval sets the interval at which the scheduler checks that
+// the hosts of running replicas are still in the cluster, and retries
+// the placement of pending replicas.  If d <= 0, a default of one
+// second is used.
+func WithInterval(d time.Duration) Option {
+	if d <= 0 {
+		d = time.Second
+	}
+
+	return func(s *Server) {
+		s.interval = d
+	}
+}
+
+func withDefault(opt []Option) []Option {
+	return append([]Option{
+		WithLogger(nil),
+		WithInterval(0),
+	}, opt...)
+}
@@ -0,0 +1,350 @@
+// Package sched provides a capability for running jobs in a cluster,
+// without choosing the hosts on which they run.
+//
+// Each host runs its own scheduler, and schedulers do not coordinate.
+// A scheduler only accounts for the replicas that it has placed, so
+// jobs submitted to different hosts may together exceed the capacity
+// advertised by a host.  Jobs are held in the memory of the host to
+// which they were submitted, and are lost if that host exits.
+// Schedulers cannot tell which hosts were started with --enable-exec;
+// replicas placed on other hosts are retried until they are placed on
+// a host that runs them, so use labels to select exec-enabled hosts
+// in mixed clusters.
+package sched
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/server"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/lthibault/log"
+
+	api "github.com/wetware/ww/internal/api/process"
+	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/proc"
+	"github.com/wetware/ww/pkg/vat"
+)
+
+var Capability = vat.BasicCap{
+	Name:     "sched",
+	Versions: []string{"0.1.0"}}
+
+var (
+	// ErrClosed is returned when submitting a job to a closed server.
+	ErrClosed = errors.New("closed")
+
+	// ErrExists is returned when submitting a job whose name is in use.
+	ErrExists = errors.New("job exists")
+
+	// ErrNotFound is returned when looking up a nonexistent job.
+	ErrNotFound = errors.New("job not found")
+
+	// ErrNoHosts is reported when no host satisfies a job's selector
+	// and resource requests.  The replica remains pending until one
+	// does.
+	ErrNoHosts = errors.New("no eligible hosts")
+)
+
+var defaultPolicy = server.Policy{
+	MaxConcurrentCalls: 64,
+}
+
+// Dialer returns the executor of a host in the cluster.
+type Dialer interface {
+	Dial(context.Context, peer.ID) (proc.Executor, capnp.ReleaseFunc, error)
+}
+
+// Server places jobs on the hosts in the routing table, according to
+// the metadata published in their heartbeats (see HostInfo), and
+// places replicas again when their host's record expires.  Jobs are
+// stopped when the server is closed.  Capacity is reserved locally;
+// see the package documentation.
+type Server struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup // blocks shutdown until all replicas are done
+
+	log      log.Logger
+	rt       clcap.RoutingTable
+	dialer   Dialer
+	interval time.Duration
+	nextID   uint64 // atomic
+
+	mu    sync.Mutex
+	jobs  map[string]*job
+	usage map[peer.ID]*usage // resources reserved by replicas
+}
+
+// New scheduler.  Hosts are selected from rt, and their executors are
+// obtained from d.
+func New(rt clcap.RoutingTable, d Dialer, opt ...Option) *Server {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Server{
+		ctx:    ctx,
+		cancel: cancel,
+		rt:     rt,
+		dialer: d,
+		jobs:   make(map[string]*job),
+		usage:  make(map[peer.ID]*usage),
+	}
+
+	for _, option := range withDefault(opt) {
+		option(s)
+	}
+
+	return s
+}
+
+// Close stops all jobs and blocks until their replicas are done.
+func (s *Server) Close() error {
+	select {
+	case <-s.ctx.Done():
+		return fmt.Errorf("already %w", ErrClosed)
+	default:
+		s.cancel()
+		s.wg.Wait()
+		return nil
+	}
+}
+
+func (s *Server) Client() *capnp.Client {
+	return api.Scheduler_ServerToClient(s, &defaultPolicy).Client
+}
+
+func (s *Server) Submit(_ context.Context, call api.Scheduler_submit) error {
+	spec, err := call.Args().Spec()
+	if err != nil {
+		return err
+	}
+
+	j, err := s.newJob(spec)
+	if err != nil {
+		return err
+	}
+
+	if err = s.add(j); err != nil {
+		return err
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetJob(api.Job_ServerToClient(j, &defaultPolicy))
+}
+
+func (s *Server) Ls(_ context.Context, call api.Scheduler_ls) error {
+	s.mu.Lock()
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+
+	sort.Strings(names)
+
+	res, err := call.AllocResults()
+	if err != nil || len(names) == 0 {
+		return err
+	}
+
+	l, err := res.NewJobs(int32(len(names)))
+	if err != nil {
+		return err
+	}
+
+	for i, name := range names {
+		if err = l.Set(i, name); err != nil {
+			break
+		}
+	}
+
+	return err
+}
+
+func (s *Server) Lookup(_ context.Context, call api.Scheduler_lookup) error {
+	name, err := call.Args().Name()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	j, ok := s.jobs[name]
+	s.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	res, err := call.AllocResults()
+	if err != nil {
+		return err
+	}
+
+	return res.SetJob(api.Job_ServerToClient(j, &defaultPolicy))
+}
+
+// add the job to the scheduler, and start its replicas.
+func (s *Server) add(j *job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.ctx.Done():
+		return ErrClosed
+	default:
+	}
+
+	if j.name == "" {
+		j.name = "job-" + strconv.FormatUint(atomic.AddUint64(&s.nextID, 1), 10)
+	}
+
+	if _, ok := s.jobs[j.name]; ok {
+		return fmt.Errorf("%w: %s", ErrExists, j.name)
+	}
+	s.jobs[j.name] = j
+	j.log = s.log.WithField("job", j.name)
+
+	for i := range j.replicas {
+		s.wg.Add(1)
+		go j.run(i)
+	}
+
+	return nil
+}
+
+// remove the job from the scheduler.
+func (s *Server) remove(j *job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.jobs[j.name] == j {
+		delete(s.jobs, j.name)
+	}
+}
+
+// place a replica of j on the least-loaded eligible host, and reserve
+// the resources requested by j.  Hosts are eligible if they match j's
+// selector and have sufficient capacity.  Replicas of the same job are
+// spread across hosts where possible.
+func (s *Server) place(j *job) (peer.ID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		best peer.ID
+		min  *usage
+	)
+
+	for it := s.rt.Iter(); it.Record() != nil; it.Next() {
+		info, err := Info(it.Record())
+		if err != nil {
+			s.log.WithError(err).
+				WithField("peer", it.Record().Peer()).
+				Debug("ignoring host with invalid metadata")
+			continue
+		}
+
+		if !info.Matches(j.selector) {
+			continue
+		}
+
+		u := s.usage[it.Record().Peer()]
+		if !u.fits(info, j.requests) {
+			continue
+		}
+
+		if best == "" || u.less(min, j) {
+			best, min = it.Record().Peer(), u
+		}
+	}
+
+	if best == "" {
+		return "", ErrNoHosts
+	}
+
+	if min == nil {
+		min = &usage{jobs: make(map[*job]int)}
+		s.usage[best] = min
+	}
+	min.reserve(j)
+
+	return best, nil
+}
+
+// release the resources reserved by a replica of j on the host.
+func (s *Server) release(id peer.ID, j *job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if u := s.usage[id]; u != nil && u.free(j) {
+		delete(s.usage, id)
+	}
+}
+
+// usage of a host's resources by the replicas placed on it.  Methods
+// treat a nil usage as an idle host.
+type usage struct {
+	cpu    float64
+	memory uint64
+	n      int
+	jobs   map[*job]int // replicas per job
+}
+
+func (u *usage) fits(info HostInfo, req proc.Limits) bool {
+	if u == nil {
+		u = &usage{}
+	}
+
+	if info.CPU > 0 && u.cpu+req.CPU > info.CPU {
+		return false
+	}
+
+	return info.Memory == 0 || u.memory+req.Memory <= info.Memory
+}
+
+func (u *usage) less(other *usage, j *job) bool {
+	if u == nil {
+		return other != nil
+	}
+
+	if other == nil {
+		return false
+	}
+
+	if u.jobs[j] != other.jobs[j] {
+		return u.jobs[j] < other.jobs[j]
+	}
+
+	return u.n < other.n
+}
+
+func (u *usage) reserve(j *job) {
+	u.cpu += j.requests.CPU
+	u.memory += j.requests.Memory
+	u.n++
+	u.jobs[j]++
+}
+
+// free the resources reserved for j, and report whether the host is
+// now idle.
+func (u *usage) free(j *job) bool {
+	u.cpu -= j.requests.CPU
+	u.memory -= j.requests.Memory
+	u.n--
+
+	if u.jobs[j]--; u.jobs[j] == 0 {
+		delete(u.jobs, j)
+	}
+
+	return u.n == 0
+}
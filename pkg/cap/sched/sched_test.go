@@ -0,0 +1,364 @@
+package sched_test
+
+import (
+	"context"
+	"crypto/rand"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wetware/casm/pkg/cluster/pulse"
+	"github.com/wetware/casm/pkg/cluster/routing"
+
+	"github.com/wetware/ww/pkg/cap/proc"
+	"github.com/wetware/ww/pkg/cap/sched"
+)
+
+func TestHostInfo(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	want := sched.HostInfo{
+		Labels: map[string]string{"zone": "us-east", "gpu": "true"},
+		CPU:    2.5,
+		Memory: 1 << 30,
+		RelayAddrs: []ma.Multiaddr{
+			ma.StringCast("/ip4/1.2.3.4/tcp/4001/p2p/QmZ3EMXJgcD49oh4oZsLymjPCY6LJpJerHNBiufpe3ZZ1i/p2p-circuit"),
+		},
+	}
+
+	hb, err := pulse.NewHeartbeat(capnp.SingleSegment(nil))
+	require.NoError(t, err)
+	want.Prepare(hb)
+
+	got, err := sched.Info(record{Heartbeat: hb})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	assert.True(t, got.Matches(nil), "empty selector should match")
+	assert.True(t, got.Matches(map[string]string{"zone": "us-east"}))
+	assert.False(t, got.Matches(map[string]string{"zone": "eu-west"}))
+	assert.False(t, got.Matches(map[string]string{"region": "us"}))
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Spread", func(t *testing.T) {
+		t.Parallel()
+
+		rt, d := newCluster(t, sched.HostInfo{}, sched.HostInfo{})
+		s := sched.New(rt, d, sched.WithInterval(10*time.Millisecond))
+		defer s.Close()
+
+		j := submit(t, s, sched.JobSpec{
+			Command:  &proc.Command{Path: "sleep", Args: []string{"10"}},
+			Replicas: 2,
+		})
+		defer j.Release()
+
+		rs := waitFor(t, j, func(rs []sched.Replica) bool {
+			return rs[0].State == sched.Running && rs[1].State == sched.Running
+		})
+		assert.NotEqual(t, rs[0].Host, rs[1].Host, "replicas should be spread across hosts")
+	})
+
+	t.Run("Selector", func(t *testing.T) {
+		t.Parallel()
+
+		rt, d := newCluster(t,
+			sched.HostInfo{Labels: map[string]string{"zone": "a"}},
+			sched.HostInfo{Labels: map[string]string{"zone": "b"}})
+		s := sched.New(rt, d, sched.WithInterval(10*time.Millisecond))
+		defer s.Close()
+
+		j := submit(t, s, sched.JobSpec{
+			Command:  &proc.Command{Path: "sleep", Args: []string{"10"}},
+			Selector: map[string]string{"zone": "b"},
+			Replicas: 2,
+		})
+		defer j.Release()
+
+		rs := waitFor(t, j, func(rs []sched.Replica) bool {
+			return rs[0].State == sched.Running && rs[1].State == sched.Running
+		})
+		assert.Equal(t, rt.IDs[1], rs[0].Host)
+		assert.Equal(t, rt.IDs[1], rs[1].Host)
+
+		pending := submit(t, s, sched.JobSpec{
+			Command:  &proc.Command{Path: "true"},
+			Selector: map[string]string{"zone": "c"},
+		})
+		defer pending.Release()
+
+		time.Sleep(50 * time.Millisecond)
+		rs, err := pending.Status(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, sched.Pending, rs[0].State)
+	})
+
+	t.Run("Capacity", func(t *testing.T) {
+		t.Parallel()
+
+		rt, d := newCluster(t, sched.HostInfo{CPU: 1})
+		s := sched.New(rt, d, sched.WithInterval(10*time.Millisecond))
+		defer s.Close()
+
+		j := submit(t, s, sched.JobSpec{
+			Command:  &proc.Command{Path: "sleep", Args: []string{"10"}},
+			Requests: proc.Limits{CPU: 0.75},
+			Replicas: 2,
+		})
+		defer j.Release()
+
+		waitFor(t, j, func(rs []sched.Replica) bool {
+			return count(rs, sched.Running) == 1
+		})
+
+		time.Sleep(50 * time.Millisecond)
+		rs, err := j.Status(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, count(rs, sched.Pending), "second replica should not fit")
+	})
+
+	t.Run("Reschedule", func(t *testing.T) {
+		t.Parallel()
+
+		rt, d := newCluster(t, sched.HostInfo{}, sched.HostInfo{})
+		s := sched.New(rt, d, sched.WithInterval(10*time.Millisecond))
+		defer s.Close()
+
+		j := submit(t, s, sched.JobSpec{
+			Command: &proc.Command{Path: "sleep", Args: []string{"10"}},
+		})
+		defer j.Release()
+
+		name, err := j.Name(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "job-1", name, "should assign name")
+
+		rs := waitFor(t, j, func(rs []sched.Replica) bool {
+			return rs[0].State == sched.Running
+		})
+
+		lost := rs[0].Host
+		rt.Expire(lost)
+
+		rs = waitFor(t, j, func(rs []sched.Replica) bool {
+			return rs[0].State == sched.Running && rs[0].Host != lost
+		})
+		assert.Equal(t, 2, rs[0].Placements)
+	})
+
+	t.Run("ExitAndStop", func(t *testing.T) {
+		t.Parallel()
+
+		rt, d := newCluster(t, sched.HostInfo{})
+		s := sched.New(rt, d, sched.WithInterval(10*time.Millisecond))
+		defer s.Close()
+
+		c := sched.Scheduler{Client: s.Client()}
+		defer c.Release()
+
+		j := submit(t, s, sched.JobSpec{
+			Name:    "fail",
+			Command: &proc.Command{Path: "sh", Args: []string{"-c", "exit 3"}},
+		})
+		defer j.Release()
+
+		rs := waitFor(t, j, func(rs []sched.Replica) bool {
+			return rs[0].State == sched.Exited
+		})
+		assert.Equal(t, 3, rs[0].ExitCode)
+
+		names, err := c.Ls(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []string{"fail"}, names, "exited jobs should remain listed")
+
+		dup, release := c.Submit(context.Background(), sched.JobSpec{
+			Name:    "fail",
+			Command: &proc.Command{Path: "true"},
+		})
+		defer release()
+
+		_, err = dup.Status(context.Background())
+		assert.ErrorIs(t, err, sched.ErrExists)
+
+		found, release := c.Lookup(context.Background(), "fail")
+		defer release()
+
+		require.NoError(t, found.Stop(context.Background()))
+
+		names, err = c.Ls(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, names, "stopped jobs should be removed")
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		rt, d := newCluster(t)
+		s := sched.New(rt, d)
+		defer s.Close()
+
+		c := sched.Scheduler{Client: s.Client()}
+		defer c.Release()
+
+		j, release := c.Submit(context.Background(), sched.JobSpec{
+			Name: "empty",
+		})
+		defer release()
+
+		_, err := j.Status(context.Background())
+		assert.Error(t, err, "should reject job without command or module")
+	})
+}
+
+func submit(t *testing.T, s *sched.Server, spec sched.JobSpec) sched.Job {
+	t.Helper()
+
+	c := sched.Scheduler{Client: s.Client()}
+	defer c.Release()
+
+	j, release := c.Submit(context.Background(), spec)
+	defer release()
+
+	return j.AddRef()
+}
+
+func waitFor(t *testing.T, j sched.Job, cond func([]sched.Replica) bool) []sched.Replica {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for {
+		rs, err := j.Status(ctx)
+		require.NoError(t, err)
+
+		if cond(rs) {
+			return rs
+		}
+
+		select {
+		case <-time.After(10 * time.Millisecond):
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for replicas: %+v", rs)
+		}
+	}
+}
+
+func count(rs []sched.Replica, state sched.ReplicaState) (n int) {
+	for _, r := range rs {
+		if r.State == state {
+			n++
+		}
+	}
+
+	return
+}
+
+// newCluster returns a routing table containing a host for each info.
+// Each host is backed by its own process executor.
+func newCluster(t *testing.T, infos ...sched.HostInfo) (*routingTable, dialer) {
+	t.Helper()
+
+	rt := &routingTable{recs: make(map[peer.ID]routing.Record)}
+	d := make(dialer)
+
+	for _, info := range infos {
+		hb, err := pulse.NewHeartbeat(capnp.SingleSegment(nil))
+		require.NoError(t, err)
+		hb.SetTTL(time.Minute)
+		info.Prepare(hb)
+
+		_, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+		require.NoError(t, err)
+		id, err := peer.IDFromPublicKey(pk)
+		require.NoError(t, err)
+
+		rt.IDs = append(rt.IDs, id)
+		rt.recs[id] = record{id: id, Heartbeat: hb}
+
+		e := proc.New()
+		t.Cleanup(func() { e.Close() })
+		d[id] = proc.Executor{Client: e.Client()}
+	}
+
+	return rt, d
+}
+
+type dialer map[peer.ID]proc.Executor
+
+func (d dialer) Dial(_ context.Context, id peer.ID) (proc.Executor, capnp.ReleaseFunc, error) {
+	e := d[id].AddRef()
+	return e, e.Release, nil
+}
+
+type record struct {
+	id peer.ID
+	pulse.Heartbeat
+}
+
+func (r record) Peer() peer.ID { return r.id }
+func (r record) Seq() uint64   { return 0 }
+
+type routingTable struct {
+	IDs []peer.ID // in order of creation
+
+	mu   sync.Mutex
+	recs map[peer.ID]routing.Record
+}
+
+func (rt *routingTable) Expire(id peer.ID) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	delete(rt.recs, id)
+}
+
+func (rt *routingTable) Lookup(id peer.ID) (routing.Record, bool) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	rec, ok := rt.recs[id]
+	return rec, ok
+}
+
+func (rt *routingTable) Iter() routing.Iterator {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	it := &iterator{}
+	for _, rec := range rt.recs {
+		it.recs = append(it.recs, rec)
+	}
+
+	sort.Slice(it.recs, func(i, j int) bool {
+		return it.recs[i].Peer() < it.recs[j].Peer()
+	})
+
+	return it
+}
+
+type iterator struct{ recs []routing.Record }
+
+func (it *iterator) Next()               { it.recs = it.recs[1:] }
+func (it *iterator) Deadline() time.Time { return time.Time{} }
+func (it *iterator) Finish()             {}
+
+func (it *iterator) Record() routing.Record {
+	if len(it.recs) == 0 {
+		return nil
+	}
+
+	return it.recs[0]
+}
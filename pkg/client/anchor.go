@@ -8,7 +8,9 @@ import (
 	"capnproto.org/go/capnp/v3/rpc"
 	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
+	"github.com/wetware/casm/pkg/cluster/routing"
 	"github.com/wetware/ww/pkg/cap/cluster"
+	hostcap "github.com/wetware/ww/pkg/cap/host"
 	"github.com/wetware/ww/pkg/vat"
 )
 
@@ -31,8 +33,8 @@ type Container interface {
 
 type dialer vat.Network
 
-func (d dialer) Dial(ctx context.Context, info peer.AddrInfo) (*rpc.Conn, error) {
-	return vat.Network(d).Connect(ctx, info, cluster.AnchorCapability)
+func (d dialer) Dial(ctx context.Context, info peer.AddrInfo) (*rpc.Conn, capnp.ReleaseFunc, error) {
+	return vat.Network(d).Dial(ctx, info, cluster.AnchorCapability)
 }
 
 // Host anchor represents a machine instance.
@@ -58,6 +60,21 @@ func (h Host) Join(ctx context.Context, peers ...peer.AddrInfo) error {
 	return h.host.Join(ctx, h.dialer, peers)
 }
 
+// services connects to the host's bootstrap capability, from which
+// each of its services can be obtained.
+func (h Host) services(ctx context.Context) (hostcap.Host, capnp.ReleaseFunc, error) {
+	conn, release, err := vat.Network(h.dialer).Dial(ctx, h.host.Info, hostcap.Capability)
+	if err != nil {
+		return hostcap.Host{}, nil, err
+	}
+
+	s := hostcap.Host{Client: conn.Bootstrap(ctx)}
+	return s, func() {
+		s.Release()
+		release()
+	}, nil
+}
+
 func (h Host) Ls(ctx context.Context) Iterator {
 	rs, release := h.host.Ls(ctx, h.dialer)
 
@@ -115,13 +132,23 @@ func (hs *hostSet) Anchor() Anchor {
 	return Host{
 		dialer: hs.dialer,
 		host: &cluster.Host{
-			Info: peer.AddrInfo{
-				ID: hs.RecordStream.Record().Peer(),
-			},
+			Info: addrInfo(hs.RecordStream.Record()),
 		},
 	}
 }
 
+// addrInfo returns the peer's relay addresses, if it advertised any,
+// so that hosts behind a NAT can be reached.  Other addresses are
+// resolved by the network.
+func addrInfo(rec routing.Record) peer.AddrInfo {
+	info := peer.AddrInfo{ID: rec.Peer()}
+	if r, ok := rec.(interface{ RelayAddrs() []ma.Multiaddr }); ok {
+		info.Addrs = r.RelayAddrs()
+	}
+
+	return info
+}
+
 type registerMap struct {
 	*cluster.RegisterMap
 	release capnp.ReleaseFunc
@@ -144,6 +171,10 @@ type register struct {
 
 func (r register) Path() []string { return r.path }
 
+func (r register) Get(ctx context.Context) (data []byte, release func()) {
+	return r.Register.Get(ctx)
+}
+
 func (r register) Ls(ctx context.Context) Iterator {
 	rs, release := r.Register.Ls(ctx)
 
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"runtime"
 
-	"capnproto.org/go/capnp/v3/rpc"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/wetware/ww/pkg/cap/cluster"
@@ -19,11 +18,18 @@ import (
 // the cluster was lost.
 var ErrDisconnected = errors.New("disconnected")
 
+// ErrCanceled is returned by Subscription.Next after the
+// subscription has been canceled.
+var ErrCanceled = errors.New("subscription canceled")
+
+// ErrClosed is returned by Subscription.Next after the host has
+// ended the subscription.
+var ErrClosed = errors.New("subscription closed")
+
 type Node struct {
 	vat  vat.Network
-	conn *rpc.Conn
-	ps   pscap.PubSub // conn's bootstrap capability
-	view cluster.View
+	link *link
+	pool *vat.Pool // nil if the pool is not owned by the node
 }
 
 // String returns the cluster namespace
@@ -40,43 +46,54 @@ func (n Node) Host() host.Host { return n.vat.Host }
 // node's capabilities resolve.  It is safe to cancel
 // the context passed to Dial after this method returns.
 func (n Node) Bootstrap(ctx context.Context) error {
-	// TODO:  update this when we replace 'ps' with a
-	//        capability set.
-	if err := n.ps.Client.Resolve(ctx); err != nil {
+	s, err := n.link.session(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.ps.Client.Resolve(ctx); err != nil {
 		return err
 	}
 
-	return n.view.Client.Resolve(ctx)
+	return s.view.Client.Resolve(ctx)
 }
 
 // Done returns a read-only channel that is closed when
-// 'n' becomes disconnected from the cluster.
+// 'n' becomes disconnected from the cluster.  If 'n' was
+// dialed with a ReconnectPolicy, Done is closed when 'n'
+// is closed, or when the policy gives up.
 func (n Node) Done() <-chan struct{} {
-	return n.conn.Done()
+	return n.link.Done()
 }
 
 // Close the client connection.  Note that this does not
 // close the underlying host.
 func (n Node) Close() error {
-	n.ps.Release()
+	if n.pool != nil {
+		defer n.pool.Close()
+	}
 
-	return n.conn.Close()
+	return n.link.Close()
 }
 
-// Join a pubsub topic.
-func (n Node) Join(ctx context.Context, topic string) Topic {
-	var f, release = n.ps.Join(ctx, topic)
-
+// Join a pubsub topic.  Options may be passed to sign and/or
+// encrypt payloads end-to-end.  See TopicOpt.
+func (n Node) Join(ctx context.Context, topic string, opt ...TopicOpt) Topic {
 	t := &futureTopic{
+		env:  envelope{topic: topic},
 		name: topic,
-		f:    f,
-		done: n.conn.Done(),
+		link: n.link,
+	}
+	t.join(ctx, n.link.current())
+
+	for _, option := range opt {
+		option(&t.env)
 	}
 
 	// Wrap the call to release in a function that ensures
 	// release is only called once.
 	t.release = func() {
-		release()
+		t.leave()
 		t.release = nil
 		runtime.SetFinalizer(t, nil)
 	}
@@ -91,10 +108,38 @@ func (n Node) Join(ctx context.Context, topic string) Topic {
 	return t
 }
 
+// Topics returns the pubsub topics that are currently joined by the
+// host to which the client is connected.
+func (n Node) Topics(ctx context.Context) ([]pscap.TopicInfo, error) {
+	s, err := n.link.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ps.Ls(ctx)
+}
+
+// TopicPeers returns the peers of a topic that is currently joined by
+// the host to which the client is connected.  Unlike Topic.Peers, it
+// does not cause the host to join the topic.
+func (n Node) TopicPeers(ctx context.Context, topic string) (peer.IDSlice, error) {
+	s, err := n.link.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ps.Peers(ctx, topic)
+}
+
 func (n Node) Path() []string { return nil }
 
 func (n Node) Ls(ctx context.Context) Iterator {
-	s, release := n.view.Iter(ctx)
+	sess, err := n.link.session(ctx)
+	if err != nil {
+		return errIterator{err}
+	}
+
+	s, release := sess.view.Iter(ctx)
 
 	it := &hostSet{
 		ctx:          ctx,
@@ -114,6 +159,12 @@ func (n Node) Ls(ctx context.Context) Iterator {
 	return it
 }
 
+type errIterator struct{ err error }
+
+func (it errIterator) Err() error     { return it.err }
+func (it errIterator) Next() bool     { return false }
+func (it errIterator) Anchor() Anchor { return nil }
+
 func (n Node) Walk(ctx context.Context, path []string) Anchor {
 	if len(path) == 0 {
 		return n
@@ -124,8 +175,16 @@ func (n Node) Walk(ctx context.Context, path []string) Anchor {
 		return newErrorHost(fmt.Errorf("invalid id: %w", err))
 	}
 
+	info := peer.AddrInfo{ID: id}
+	if sess, err := n.link.session(ctx); err == nil {
+		// Look up the host's relay addresses, if any.
+		if rec, err := sess.view.Lookup(ctx, id); err == nil && rec != nil {
+			info = addrInfo(rec)
+		}
+	}
+
 	return Host{
 		dialer: dialer(n.vat),
-		host:   &cluster.Host{Info: peer.AddrInfo{ID: id}},
+		host:   &cluster.Host{Info: info},
 	}.Walk(ctx, path[1:])
 }
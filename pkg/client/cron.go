@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/wetware/ww/pkg/cap/cron"
+)
+
+// Cron returns the host's cron table.  Entries are stored and fired by
+// the host.
+func (h Host) Cron(ctx context.Context) (cron.Cron, capnp.ReleaseFunc, error) {
+	s, release, err := h.services(ctx)
+	if err != nil {
+		return cron.Cron{}, nil, err
+	}
+
+	c := s.Cron(ctx)
+	return c, func() {
+		c.Release()
+		release()
+	}, nil
+}
@@ -10,8 +10,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 
 	"github.com/wetware/casm/pkg/boot"
-	"github.com/wetware/ww/pkg/cap/cluster"
-	"github.com/wetware/ww/pkg/cap/pubsub"
+	hostcap "github.com/wetware/ww/pkg/cap/host"
 	"github.com/wetware/ww/pkg/vat"
 )
 
@@ -29,6 +28,10 @@ func (addr Addr) FindPeers(ctx context.Context, ns string, opt ...discovery.Opti
 type Dialer struct {
 	Vat  vat.Network
 	Boot discovery.Discoverer
+
+	// Reconnect, if non-nil, causes the client to re-dial the cluster
+	// when its connection is lost.  See ReconnectPolicy.
+	Reconnect *ReconnectPolicy
 }
 
 // Dial is a convenience function that joins a cluster using the
@@ -40,39 +43,51 @@ func Dial(ctx context.Context, vat vat.Network, a Addr) (*Node, error) {
 	return Dialer{Vat: vat, Boot: a}.Dial(ctx)
 }
 
-// Dial creates a client and connects it to a cluster.
+// Dial creates a client and connects it to a cluster.  All of the
+// client's capabilities are obtained from the bootstrap host over a
+// single connection.  Connections to other hosts are cached in the
+// Vat's Pool.  If the Vat has no Pool, the client creates one, which
+// is closed along with the client.
 func (d Dialer) Dial(ctx context.Context) (*Node, error) {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	n := &Node{vat: d.Vat}
-
-	conn, err := d.join(ctx, pubsub.Capability)
+	s, err := d.dial(ctx)
 	if err != nil {
 		return nil, err
 	}
-	n.ps = pubsub.PubSub{Client: conn.Bootstrap(context.Background())}
 
-	conn, err = d.join(ctx, cluster.ViewCapability)
+	n := &Node{vat: d.Vat, link: newLink(s)}
+	if n.vat.Pool == nil {
+		n.vat.Pool = new(vat.Pool)
+		n.pool = n.vat.Pool
+	}
+
+	if d.Reconnect != nil {
+		n.link.reconnect(d.dial, *d.Reconnect)
+	}
+
+	return n, nil
+}
+
+func (d Dialer) dial(ctx context.Context) (*session, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	id, conn, err := d.join(ctx, hostcap.Capability)
 	if err != nil {
-		n.ps.Release()
 		return nil, err
 	}
-	n.view = cluster.View{Client: conn.Bootstrap(context.Background())}
 
-	n.conn = conn
-	return n, nil
+	return newSession(ctx, id, conn)
 }
 
-func (d Dialer) join(ctx context.Context, cap vat.Capability) (conn *rpc.Conn, err error) {
+func (d Dialer) join(ctx context.Context, cap vat.Capability) (id peer.ID, conn *rpc.Conn, err error) {
 	var peers <-chan peer.AddrInfo
 	if peers, err = d.Boot.FindPeers(ctx, d.Vat.NS); err != nil {
-		return nil, fmt.Errorf("discover: %w", err)
+		return "", nil, fmt.Errorf("discover: %w", err)
 	}
 
 	for info := range peers {
-		conn, err = d.Vat.Connect(ctx, info, cap)
-		if err == nil {
+		if conn, err = d.Vat.Connect(ctx, info, cap); err == nil {
+			id = info.ID
 			break
 		}
 	}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	swarm "github.com/libp2p/go-libp2p-swarm"
 	inproc "github.com/lthibault/go-libp2p-inproc-transport"
 	ma "github.com/multiformats/go-multiaddr"
@@ -19,8 +21,8 @@ import (
 	"github.com/wetware/casm/pkg/boot"
 	clapi "github.com/wetware/ww/internal/api/cluster"
 	psapi "github.com/wetware/ww/internal/api/pubsub"
-	"github.com/wetware/ww/pkg/cap/cluster"
-	"github.com/wetware/ww/pkg/cap/pubsub"
+	hostcap "github.com/wetware/ww/pkg/cap/host"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
 	"github.com/wetware/ww/pkg/client"
 	"github.com/wetware/ww/pkg/vat"
 )
@@ -129,8 +131,10 @@ func TestDialer(t *testing.T) {
 			NS:   "test",
 			Host: h,
 		}
-		svr.Export(pubsub.Capability, mockPubSub{})
-		svr.Export(cluster.ViewCapability, mockView{})
+		svr.Export(hostcap.Capability, hostcap.New(hostcap.Services{
+			PubSub: mockPubSub{},
+			View:   mockView{},
+		}))
 
 		clt := newVat()
 		defer clt.Host.Close()
@@ -145,7 +149,247 @@ func TestDialer(t *testing.T) {
 
 		err = n.Bootstrap(ctx)
 		assert.NoError(t, err, "should bootstrap successfully")
+
+		conns := clt.Host.Network().ConnsToPeer(h.ID())
+		require.Len(t, conns, 1, "should connect to host")
+		assert.Len(t, conns[0].GetStreams(), 1,
+			"should multiplex capabilities over a single stream")
+	})
+}
+
+func TestDialer_reconnect(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Reconnect", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		h0, h1 := newServer(t, nil), newServer(t, nil)
+		defer h1.Close()
+
+		events := make(chan client.ReconnectEvent, 8)
+
+		clt := newVat()
+		defer clt.Host.Close()
+
+		n, err := client.Dialer{
+			Vat: clt,
+			Boot: boot.StaticAddrs{
+				*host.InfoFromHost(h0),
+				*host.InfoFromHost(h1),
+			},
+			Reconnect: &client.ReconnectPolicy{
+				Backoff: time.Millisecond,
+				Timeout: time.Millisecond * 500,
+				OnEvent: func(ev client.ReconnectEvent) { events <- ev },
+			},
+		}.Dial(ctx)
+		require.NoError(t, err, "should dial successfully")
+		defer n.Close()
+
+		err = n.Bootstrap(ctx)
+		require.NoError(t, err, "should bootstrap successfully")
+
+		// Kill the bootstrap host.  The in-process transport does not
+		// signal the remote end, so drop the connection explicitly.
+		require.NoError(t, h0.Close(), "should close host")
+		require.NoError(t, clt.Host.Network().ClosePeer(h0.ID()),
+			"should close connection")
+
+		ev := nextEvent(t, events)
+		assert.Equal(t, client.Disconnected, ev.Type, "should disconnect")
+		assert.Equal(t, h0.ID(), ev.Peer, "should report lost host")
+
+		for ev = nextEvent(t, events); ev.Type == client.ReconnectFailed; {
+			ev = nextEvent(t, events)
+		}
+		assert.Equal(t, client.Reconnected, ev.Type, "should reconnect")
+		assert.Equal(t, h1.ID(), ev.Peer, "should reconnect through remaining host")
+
+		err = n.Bootstrap(ctx)
+		assert.NoError(t, err, "should bootstrap after reconnecting")
+
+		select {
+		case <-n.Done():
+			t.Error("should not report disconnection while reconnecting")
+		default:
+		}
+	})
+
+	t.Run("Resubscribe", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		gossip := func(h host.Host) vat.ClientProvider {
+			gs, err := pubsub.NewGossipSub(ctx, h)
+			require.NoError(t, err, "must succeed")
+			return pscap.New("test", gs)
+		}
+
+		h0, h1 := newServer(t, gossip), newServer(t, gossip)
+		defer h1.Close()
+
+		reconnected := make(chan struct{})
+
+		clt := newVat()
+		defer clt.Host.Close()
+
+		n, err := client.Dialer{
+			Vat: clt,
+			Boot: boot.StaticAddrs{
+				*host.InfoFromHost(h0),
+				*host.InfoFromHost(h1),
+			},
+			Reconnect: &client.ReconnectPolicy{
+				Backoff: time.Millisecond,
+				Timeout: time.Millisecond * 500,
+				OnEvent: func(ev client.ReconnectEvent) {
+					if ev.Type == client.Reconnected {
+						close(reconnected)
+					}
+				},
+			},
+		}.Dial(ctx)
+		require.NoError(t, err, "should dial successfully")
+		defer n.Close()
+
+		topic := n.Join(ctx, "foo")
+		defer topic.Release()
+
+		sub, err := topic.Subscribe(ctx)
+		require.NoError(t, err, "should subscribe")
+		defer sub.Cancel()
+
+		err = topic.Publish(ctx, []byte("before"))
+		require.NoError(t, err, "should publish")
+
+		b, err := sub.Next(ctx)
+		require.NoError(t, err, "should receive message")
+		assert.Equal(t, "before", string(b), "unexpected message")
+
+		require.NoError(t, h0.Close(), "should close host")
+		require.NoError(t, clt.Host.Network().ClosePeer(h0.ID()),
+			"should close connection")
+
+		select {
+		case <-reconnected:
+		case <-time.After(time.Second * 5):
+			t.Fatal("should reconnect")
+		}
+
+		err = topic.Publish(ctx, []byte("after"))
+		require.NoError(t, err, "should publish after reconnecting")
+
+		ctx, cancel = context.WithTimeout(ctx, time.Second*5)
+		defer cancel()
+
+		b, err = sub.Next(ctx)
+		require.NoError(t, err, "should receive message after reconnecting")
+		assert.Equal(t, "after", string(b), "unexpected message")
 	})
+
+	t.Run("GiveUp", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		h := newServer(t, nil)
+
+		events := make(chan client.ReconnectEvent, 8)
+
+		clt := newVat()
+		defer clt.Host.Close()
+
+		n, err := client.Dialer{
+			Vat:  clt,
+			Boot: boot.StaticAddrs{*host.InfoFromHost(h)},
+			Reconnect: &client.ReconnectPolicy{
+				Backoff:     time.Millisecond,
+				Timeout:     time.Millisecond * 500,
+				MaxAttempts: 2,
+				OnEvent:     func(ev client.ReconnectEvent) { events <- ev },
+			},
+		}.Dial(ctx)
+		require.NoError(t, err, "should dial successfully")
+		defer n.Close()
+
+		err = n.Bootstrap(ctx)
+		require.NoError(t, err, "should bootstrap successfully")
+
+		require.NoError(t, h.Close(), "should close host")
+		require.NoError(t, clt.Host.Network().ClosePeer(h.ID()),
+			"should close connection")
+
+		select {
+		case <-n.Done():
+		case <-time.After(time.Second * 5):
+			t.Fatal("should give up")
+		}
+
+		var types []client.EventType
+		for len(events) > 0 {
+			types = append(types, (<-events).Type)
+		}
+
+		assert.Equal(t, []client.EventType{
+			client.Disconnected,
+			client.ReconnectFailed,
+			client.ReconnectFailed,
+			client.GaveUp,
+		}, types, "should report each attempt")
+
+		err = n.Bootstrap(ctx)
+		assert.ErrorIs(t, err, client.ErrDisconnected,
+			"should fail after giving up")
+	})
+}
+
+func nextEvent(t *testing.T, events <-chan client.ReconnectEvent) client.ReconnectEvent {
+	t.Helper()
+
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for reconnect event")
+	}
+
+	return client.ReconnectEvent{}
+}
+
+// newServer returns a host that exports the host capability.  If ps
+// is nil, the host's pubsub capability is mocked.
+func newServer(t *testing.T, ps func(host.Host) vat.ClientProvider) host.Host {
+	t.Helper()
+
+	h, err := libp2p.New(
+		libp2p.NoListenAddrs,
+		libp2p.NoTransports,
+		libp2p.ListenAddrStrings("/inproc/~"),
+		libp2p.Transport(inproc.New()))
+	require.NoError(t, err, "must succeed")
+
+	var router vat.ClientProvider = mockPubSub{}
+	if ps != nil {
+		router = ps(h)
+	}
+
+	svr := vat.Network{
+		NS:   "test",
+		Host: h,
+	}
+	svr.Export(hostcap.Capability, hostcap.New(hostcap.Services{
+		PubSub: router,
+		View:   mockView{},
+	}))
+
+	return h
 }
 
 type mockPubSub struct{}
@@ -154,6 +398,14 @@ func (mockPubSub) Join(ctx context.Context, call psapi.PubSub_join) error {
 	return fmt.Errorf("NOT IMPLEMENTED")
 }
 
+func (mockPubSub) Ls(ctx context.Context, call psapi.PubSub_ls) error {
+	return fmt.Errorf("NOT IMPLEMENTED")
+}
+
+func (mockPubSub) Peers(ctx context.Context, call psapi.PubSub_peers) error {
+	return fmt.Errorf("NOT IMPLEMENTED")
+}
+
 func (mockPubSub) Client() *capnp.Client {
 	return psapi.PubSub_ServerToClient(mockPubSub{}, nil).Client
 }
@@ -0,0 +1,341 @@
+package client
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+
+	api "github.com/wetware/ww/internal/api/pubsub"
+)
+
+var (
+	// ErrUnknownKey is returned when a message was encrypted with a
+	// key that is not present in the subscriber's keyring.
+	ErrUnknownKey = errors.New("unknown key")
+
+	// ErrNoKey is returned when a message is published with a
+	// keyring that has no current key.
+	ErrNoKey = errors.New("keyring has no current key")
+
+	// ErrUnencrypted is returned when a subscriber with a keyring
+	// receives a message that was not encrypted.
+	ErrUnencrypted = errors.New("unencrypted message")
+
+	// ErrUnsigned is returned when a subscriber requires signed
+	// messages, and receives an unsigned message.
+	ErrUnsigned = errors.New("unsigned message")
+
+	// ErrBadSignature is returned when a message's signature does
+	// not match its payload.
+	ErrBadSignature = errors.New("invalid signature")
+)
+
+// KeySize is the size of topic encryption keys, in bytes.
+const KeySize = 32
+
+// Keyring holds the symmetric keys for an encrypted topic.  Each key
+// is identified by an integer ID, which is transmitted alongside the
+// ciphertext.  Publishers encrypt with the current key, and subscribers
+// decrypt with whichever key the message references.  This allows keys
+// to be rotated without interrupting delivery, provided subscribers
+// receive the new key before publishers start using it.
+//
+// The zero value is an empty keyring, which cannot be used to publish
+// until a key has been rotated in.  Keyring is safe for concurrent use.
+type Keyring struct {
+	mu      sync.RWMutex
+	current uint32
+	keys    map[uint32]cipher.AEAD
+}
+
+// NewKey returns a random topic encryption key.
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	return key, err
+}
+
+// NewKeyring returns a keyring whose current key is 'key', with ID 'id'.
+func NewKeyring(id uint32, key []byte) (*Keyring, error) {
+	k := &Keyring{keys: make(map[uint32]cipher.AEAD)}
+	return k, k.Rotate(id, key)
+}
+
+// Add a key to the keyring, without making it current.  Subscribers
+// call Add to accept messages encrypted under a new key ahead of its
+// rotation.
+func (k *Keyring) Add(id uint32, key []byte) error {
+	if len(key) != KeySize {
+		return fmt.Errorf("invalid key size %d (expected %d)", len(key), KeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.keys == nil {
+		k.keys = make(map[uint32]cipher.AEAD)
+	}
+
+	k.keys[id] = aead
+	return nil
+}
+
+// Rotate adds a key to the keyring and makes it current.  Previous
+// keys are retained, so that messages in flight can be decrypted.
+// Use Remove to discard them.
+func (k *Keyring) Rotate(id uint32, key []byte) error {
+	if err := k.Add(id, key); err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.current = id
+	return nil
+}
+
+// Remove a key from the keyring.  The current key cannot be removed.
+func (k *Keyring) Remove(id uint32) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if id != k.current {
+		delete(k.keys, id)
+	}
+}
+
+func (k *Keyring) seal(e api.Envelope, topic string, msg []byte) error {
+	k.mu.RLock()
+	id, aead := k.current, k.keys[k.current]
+	k.mu.RUnlock()
+
+	if aead == nil {
+		return ErrNoKey
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	e.SetKeyId(id)
+	if err := e.SetNonce(nonce); err != nil {
+		return err
+	}
+
+	return e.SetPayload(aead.Seal(nil, nonce, msg, []byte(topic)))
+}
+
+func (k *Keyring) open(e api.Envelope, topic string) ([]byte, error) {
+	k.mu.RLock()
+	aead, ok := k.keys[e.KeyId()]
+	k.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownKey, e.KeyId())
+	}
+
+	nonce, err := e.Nonce()
+	if err != nil {
+		return nil, err
+	}
+
+	ct, err := e.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, nonce, ct, []byte(topic))
+}
+
+// envelope seals and opens messages for a single topic.  The zero
+// value passes messages through unmodified.
+type envelope struct {
+	topic  string
+	keys   *Keyring
+	signer crypto.PrivKey
+	verify func(peer.ID) bool
+}
+
+func (env envelope) enabled() bool {
+	return env.keys != nil || env.signer != nil || env.verify != nil
+}
+
+func (env envelope) Seal(msg []byte) ([]byte, error) {
+	if env.keys == nil && env.signer == nil {
+		return msg, nil
+	}
+
+	m, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := api.NewRootEnvelope(seg)
+	if err != nil {
+		return nil, err
+	}
+
+	if env.keys != nil {
+		err = env.keys.seal(e, env.topic, msg)
+	} else {
+		err = e.SetPayload(msg)
+	}
+
+	if err == nil && env.signer != nil {
+		err = env.sign(e)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Marshal()
+}
+
+func (env envelope) Open(b []byte) ([]byte, error) {
+	if !env.enabled() {
+		return b, nil
+	}
+
+	m, err := capnp.Unmarshal(b)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := api.ReadRootEnvelope(m)
+	if err != nil {
+		return nil, err
+	}
+
+	if env.verify != nil {
+		if err = env.verifySignature(e); err != nil {
+			return nil, err
+		}
+	}
+
+	// A keyring requires encryption.  Otherwise, anyone able to
+	// publish to the topic could inject plaintext messages.
+	if !e.HasNonce() {
+		if env.keys != nil {
+			return nil, ErrUnencrypted
+		}
+
+		return e.Payload()
+	}
+
+	if env.keys == nil {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownKey, e.KeyId())
+	}
+
+	return env.keys.open(e, env.topic)
+}
+
+func (env envelope) sign(e api.Envelope) error {
+	pk, err := crypto.MarshalPublicKey(env.signer.GetPublic())
+	if err != nil {
+		return err
+	}
+
+	if err = e.SetSigner(pk); err != nil {
+		return err
+	}
+
+	data, err := env.signedData(e)
+	if err != nil {
+		return err
+	}
+
+	sig, err := env.signer.Sign(data)
+	if err != nil {
+		return err
+	}
+
+	return e.SetSignature(sig)
+}
+
+func (env envelope) verifySignature(e api.Envelope) error {
+	if !e.HasSigner() {
+		return ErrUnsigned
+	}
+
+	b, err := e.Signer()
+	if err != nil {
+		return err
+	}
+
+	pk, err := crypto.UnmarshalPublicKey(b)
+	if err != nil {
+		return err
+	}
+
+	id, err := peer.IDFromPublicKey(pk)
+	if err != nil {
+		return err
+	}
+
+	if !env.verify(id) {
+		return fmt.Errorf("untrusted signer %s", id)
+	}
+
+	data, err := env.signedData(e)
+	if err != nil {
+		return err
+	}
+
+	sig, err := e.Signature()
+	if err != nil {
+		return err
+	}
+
+	if ok, err := pk.Verify(data, sig); err != nil || !ok {
+		return ErrBadSignature
+	}
+
+	return nil
+}
+
+// signedData returns the bytes covered by the envelope's signature.
+// The topic name is included to prevent messages from being replayed
+// on other topics.
+func (env envelope) signedData(e api.Envelope) ([]byte, error) {
+	nonce, err := e.Nonce()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := e.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	var id [4]byte
+	binary.BigEndian.PutUint32(id[:], e.KeyId())
+
+	data := make([]byte, 0, len(env.topic)+len(nonce)+len(payload)+6)
+	data = append(data, env.topic...)
+	data = append(data, 0)
+	data = append(data, id[:]...)
+	data = append(data, byte(len(nonce)))
+	data = append(data, nonce...)
+	return append(data, payload...), nil
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvelope(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Passthrough", func(t *testing.T) {
+		t.Parallel()
+
+		env := envelope{topic: "test"}
+
+		b, err := env.Seal([]byte("hello"))
+		require.NoError(t, err, "should seal message")
+		assert.Equal(t, "hello", string(b), "should not modify message")
+
+		b, err = env.Open(b)
+		require.NoError(t, err, "should open message")
+		assert.Equal(t, "hello", string(b), "should not modify message")
+	})
+
+	t.Run("Encrypt", func(t *testing.T) {
+		t.Parallel()
+
+		keys := newTestKeyring(t, 1)
+		env := envelope{topic: "test", keys: keys}
+
+		b, err := env.Seal([]byte("hello"))
+		require.NoError(t, err, "should seal message")
+		assert.NotContains(t, string(b), "hello", "should encrypt payload")
+
+		got, err := env.Open(b)
+		require.NoError(t, err, "should open message")
+		assert.Equal(t, "hello", string(got), "should decrypt payload")
+
+		// Messages must not be replayable on other topics.
+		_, err = envelope{topic: "other", keys: keys}.Open(b)
+		assert.Error(t, err, "should fail to open message for other topic")
+
+		// Messages sealed under a previous key remain readable
+		// after rotation.
+		key, err := NewKey()
+		require.NoError(t, err)
+		require.NoError(t, keys.Rotate(2, key), "should rotate key")
+
+		got, err = env.Open(b)
+		require.NoError(t, err, "should open message sealed with old key")
+		assert.Equal(t, "hello", string(got), "should decrypt payload")
+
+		keys.Remove(1)
+		_, err = env.Open(b)
+		assert.ErrorIs(t, err, ErrUnknownKey, "should reject removed key")
+	})
+
+	t.Run("Sign", func(t *testing.T) {
+		t.Parallel()
+
+		sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		require.NoError(t, err)
+
+		id, err := peer.IDFromPrivateKey(sk)
+		require.NoError(t, err)
+
+		pub := envelope{topic: "test", signer: sk}
+		sub := envelope{topic: "test", verify: func(signer peer.ID) bool {
+			return signer == id
+		}}
+
+		b, err := pub.Seal([]byte("hello"))
+		require.NoError(t, err, "should seal message")
+
+		got, err := sub.Open(b)
+		require.NoError(t, err, "should verify message")
+		assert.Equal(t, "hello", string(got), "unexpected payload")
+
+		// tamper with the payload
+		b[len(b)-1] ^= 0xFF
+		_, err = sub.Open(b)
+		assert.Error(t, err, "should reject tampered message")
+
+		_, err = sub.Open(mustSeal(t, envelope{topic: "test", keys: newTestKeyring(t, 1)}))
+		assert.ErrorIs(t, err, ErrUnsigned, "should reject unsigned message")
+	})
+
+	t.Run("PlaintextInjection", func(t *testing.T) {
+		t.Parallel()
+
+		sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+		require.NoError(t, err)
+
+		sub := envelope{topic: "test", keys: newTestKeyring(t, 1)}
+
+		// An attacker without the topic key publishes a well-formed
+		// envelope whose payload is in plaintext.
+		_, err = sub.Open(mustSeal(t, envelope{topic: "test", signer: sk}))
+		assert.ErrorIs(t, err, ErrUnencrypted,
+			"should reject unencrypted envelope")
+
+		// The same applies to subscribers that also verify
+		// signatures, if the attacker's signature is trusted.
+		sub.verify = func(peer.ID) bool { return true }
+		_, err = sub.Open(mustSeal(t, envelope{topic: "test", signer: sk}))
+		assert.ErrorIs(t, err, ErrUnencrypted,
+			"should reject signed, unencrypted envelope")
+
+		_, err = sub.Open([]byte("hello"))
+		assert.Error(t, err, "should reject raw message")
+	})
+
+	t.Run("ZeroKeyring", func(t *testing.T) {
+		t.Parallel()
+
+		var keys Keyring
+		env := envelope{topic: "test", keys: &keys}
+
+		_, err := env.Seal([]byte("hello"))
+		assert.ErrorIs(t, err, ErrNoKey, "should not seal without key")
+
+		_, err = env.Open(mustSeal(t, envelope{topic: "test", keys: newTestKeyring(t, 1)}))
+		assert.ErrorIs(t, err, ErrUnknownKey, "should not open without key")
+
+		key, err := NewKey()
+		require.NoError(t, err)
+		require.NoError(t, keys.Rotate(1, key), "should rotate key into zero keyring")
+
+		got, err := env.Open(mustSeal(t, env))
+		require.NoError(t, err, "should open message")
+		assert.Equal(t, "hello", string(got), "should decrypt payload")
+	})
+}
+
+func newTestKeyring(t *testing.T, id uint32) *Keyring {
+	key, err := NewKey()
+	require.NoError(t, err)
+
+	k, err := NewKeyring(id, key)
+	require.NoError(t, err)
+
+	return k
+}
+
+func mustSeal(t *testing.T, env envelope) []byte {
+	b, err := env.Seal([]byte("hello"))
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/wetware/ww/pkg/cap/proc"
+)
+
+// ErrNotInherited is returned by Inherit if the current process was
+// not passed an RPC connection by its host.
+var ErrNotInherited = errors.New("no inherited connection")
+
+// Inherited is the set of capabilities passed to the current process
+// by the host that spawned it.
+type Inherited struct {
+	proc.CapSet
+	conn *rpc.Conn
+}
+
+// Inherit the RPC connection passed to the current process by the
+// Executor capability.  Capabilities that were passed to the process
+// through proc.Command.Caps can be retrieved with Lookup.
+func Inherit(ctx context.Context) (*Inherited, error) {
+	env, ok := os.LookupEnv(proc.EnvRPCFD)
+	if !ok {
+		return nil, ErrNotInherited
+	}
+
+	fd, err := strconv.Atoi(env)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", proc.EnvRPCFD, err)
+	}
+
+	f := os.NewFile(uintptr(fd), "rpc")
+	defer f.Close()
+
+	sock, err := net.FileConn(f)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := rpc.NewConn(rpc.NewPackedStreamTransport(sock), nil)
+	return &Inherited{
+		CapSet: proc.CapSet{Client: conn.Bootstrap(ctx)},
+		conn:   conn,
+	}, nil
+}
+
+// Done returns a read-only channel that is closed when the
+// connection to the host is lost.
+func (in *Inherited) Done() <-chan struct{} {
+	return in.conn.Done()
+}
+
+// Close the connection to the host, releasing all capabilities
+// obtained through it.
+func (in *Inherited) Close() error {
+	in.CapSet.Release()
+	return in.conn.Close()
+}
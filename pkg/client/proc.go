@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"io"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/wetware/ww/pkg/cap/proc"
+)
+
+// Exec spawns a process on the host.  The process' standard output
+// and error are streamed to stdout and stderr, respectively; either
+// MAY be nil.  The process is killed when the returned release
+// function is called, if it has not already exited.
+func (h Host) Exec(ctx context.Context, cmd proc.Command, stdout, stderr io.Writer) (proc.Process, capnp.ReleaseFunc, error) {
+	s, done, err := h.services(ctx)
+	if err != nil {
+		return proc.Process{}, nil, err
+	}
+
+	e := s.Executor(ctx)
+	p, release := e.Exec(ctx, cmd, stdout, stderr)
+
+	return p, func() {
+		release()
+		e.Release()
+		done()
+	}, nil
+}
+
+// Process returns the process bound to /<peer>/proc/<id> on the host.
+// Releasing it does not kill the process.
+func (h Host) Process(ctx context.Context, id string) (proc.Process, capnp.ReleaseFunc, error) {
+	s, done, err := h.services(ctx)
+	if err != nil {
+		return proc.Process{}, nil, err
+	}
+
+	e := s.Executor(ctx)
+	p, release := e.Lookup(ctx, id)
+
+	return p, func() {
+		release()
+		e.Release()
+		done()
+	}, nil
+}
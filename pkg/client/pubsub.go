@@ -2,9 +2,14 @@ package client
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"capnproto.org/go/capnp/v3"
 	"github.com/ipfs/go-log"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/wetware/ww/pkg/cap/pubsub"
 )
 
@@ -12,7 +17,8 @@ type Topic interface {
 	log.Loggable
 	String() string
 	Publish(context.Context, []byte) error
-	Subscribe(context.Context) (Subscription, error)
+	Subscribe(context.Context, ...SubOpt) (Subscription, error)
+	Peers(context.Context) (peer.IDSlice, error)
 	Release()
 }
 
@@ -21,13 +27,107 @@ type Subscription interface {
 	String() string
 	Next(context.Context) ([]byte, error)
 	Cancel()
+
+	// Dropped returns the number of messages that were discarded
+	// by the subscription's overflow policy.
+	Dropped() uint64
+}
+
+// defaultBufferSize is the default number of messages buffered by
+// a subscription.
+const defaultBufferSize = 32
+
+// SubOpt configures a subscription.
+type SubOpt func(*subscription)
+
+// WithBufferSize sets the number of messages that the subscription
+// will buffer locally.  If n <= 0, a default value is used.
+func WithBufferSize(n int) SubOpt {
+	if n <= 0 {
+		n = defaultBufferSize
+	}
+
+	return func(s *subscription) {
+		s.bufsize = n
+	}
+}
+
+// WithMaxInflight sets the maximum number of messages that the
+// remote host will deliver before waiting for an acknowledgement.
+// If n == 0, the host's default is used.
+func WithMaxInflight(n uint32) SubOpt {
+	return func(s *subscription) {
+		s.opts = append(s.opts, pubsub.WithMaxInflight(n))
+	}
+}
+
+// WithOverflowPolicy sets the policy that is applied to incoming
+// messages when the subscription's buffer is full.  The default
+// policy is pubsub.Block.
+func WithOverflowPolicy(p pubsub.Policy) SubOpt {
+	return func(s *subscription) {
+		s.opts = append(s.opts, pubsub.WithPolicy(p))
+	}
+}
+
+// WithBatching requests that the remote host coalesce up to 'size'
+// messages per delivery, holding a partial batch back for at most
+// 'latency'.  This reduces RPC overhead for high-throughput topics.
+// Batches are unpacked transparently by Subscription.Next.
+func WithBatching(size uint32, latency time.Duration) SubOpt {
+	return func(s *subscription) {
+		s.opts = append(s.opts, pubsub.WithBatching(size, latency))
+	}
+}
+
+// TopicOpt configures end-to-end protection for a topic's payloads.
+// Options must be applied consistently by publishers and subscribers.
+type TopicOpt func(*envelope)
+
+// WithKeyring encrypts published messages with the keyring's current
+// key, and decrypts received messages using the key they reference.
+// Messages that are unencrypted or cannot be decrypted are discarded
+// by the subscription.
+func WithKeyring(k *Keyring) TopicOpt {
+	return func(env *envelope) {
+		env.keys = k
+	}
+}
+
+// WithSigner signs published messages with the supplied key,
+// allowing subscribers to verify the identity of the publishing
+// client, rather than that of the host through which it was
+// published.
+func WithSigner(sk crypto.PrivKey) TopicOpt {
+	return func(env *envelope) {
+		env.signer = sk
+	}
+}
+
+// WithVerifier requires received messages to be signed.  Messages
+// that are unsigned, carry an invalid signature, or whose signer is
+// rejected by the 'trust' predicate are discarded by the subscription.
+// If trust == nil, any valid signature is accepted.
+func WithVerifier(trust func(peer.ID) bool) TopicOpt {
+	if trust == nil {
+		trust = func(peer.ID) bool { return true }
+	}
+
+	return func(env *envelope) {
+		env.verify = trust
+	}
 }
 
 type futureTopic struct {
+	env     envelope
 	name    string
-	f       pubsub.FutureTopic
+	link    *link
 	release capnp.ReleaseFunc
-	done    <-chan struct{} // rpc.Conn.Done()
+
+	mu   sync.Mutex
+	sess *session // session on which the topic was joined
+	f    pubsub.FutureTopic
+	drop capnp.ReleaseFunc // releases f
 }
 
 func (t *futureTopic) String() string { return t.name }
@@ -40,64 +140,255 @@ func (t *futureTopic) Loggable() map[string]interface{} {
 
 func (t *futureTopic) Release() { t.release() }
 
+// join the topic on the supplied session.  The caller MUST hold mu,
+// or have exclusive access to t.
+func (t *futureTopic) join(ctx context.Context, s *session) {
+	if t.drop != nil {
+		t.drop()
+	}
+
+	t.sess = s
+	t.f, t.drop = s.ps.Join(ctx, t.name)
+}
+
+func (t *futureTopic) leave() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.drop()
+}
+
+// resolve the topic on the current session, re-joining it if the
+// client has reconnected since it was last joined.
+func (t *futureTopic) resolve(ctx context.Context) (*session, pubsub.FutureTopic, error) {
+	s, err := t.link.session(ctx)
+	if err != nil {
+		return nil, pubsub.FutureTopic{}, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.sess != s {
+		t.join(context.Background(), s)
+	}
+
+	return t.sess, t.f, nil
+}
+
 func (t *futureTopic) Publish(ctx context.Context, msg []byte) error {
-	return t.f.Topic().Publish(ctx, msg)
+	msg, err := t.env.Seal(msg)
+	if err != nil {
+		return err
+	}
+
+	_, f, err := t.resolve(ctx)
+	if err != nil {
+		return err
+	}
+
+	return f.Topic().Publish(ctx, msg)
 }
 
-func (t *futureTopic) Subscribe(ctx context.Context) (Subscription, error) {
-	topic, err := t.f.Struct()
+func (t *futureTopic) Peers(ctx context.Context) (peer.IDSlice, error) {
+	_, f, err := t.resolve(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	out := make(chan []byte, 32)
+	return f.Topic().Peers(ctx)
+}
 
-	cancel, err := topic.Subscribe(ctx, out)
-	return &subscription{
-		done:   t.done,
-		topic:  t,
-		c:      out,
-		cancel: cancel,
-	}, err
+func (t *futureTopic) Subscribe(ctx context.Context, opt ...SubOpt) (Subscription, error) {
+	sub := &subscription{topic: t}
+	for _, option := range withDefaultSub(opt) {
+		option(sub)
+	}
 
+	if err := sub.subscribe(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	// Re-subscribe as soon as the client reconnects, so that messages
+	// published in the meantime are not missed.
+	sub.unwatch = t.link.onReconnect(func(ctx context.Context, old *session) {
+		sub.subscribe(ctx, old) // on failure, Next will retry
+	})
+
+	return sub, nil
 }
 
 type subscription struct {
-	done   <-chan struct{} // rpc.Conn.Done()
-	topic  *futureTopic
-	c      <-chan []byte
-	cancel func()
+	dropped uint64 // atomic; first word for 64-bit alignment
+
+	topic   *futureTopic
+	unwatch func()
+
+	resub sync.Mutex // serializes calls to subscribe
+
+	mu       sync.Mutex
+	sess     *session // session on which the subscription is active
+	c        <-chan []byte
+	cancel   func()
+	canceled bool
+
+	// options
+	bufsize int
+	opts    []pubsub.SubOpt
 }
 
-func (s *subscription) Cancel()        { s.cancel() }
-func (s *subscription) String() string { return s.topic.name }
+func withDefaultSub(opt []SubOpt) []SubOpt {
+	return append([]SubOpt{
+		WithBufferSize(0),
+	}, opt...)
+}
+
+// subscribe to the topic on the current session, replacing the
+// subscription on session 'old'.  It is a nop if the subscription
+// was already replaced.
+func (s *subscription) subscribe(ctx context.Context, old *session) error {
+	s.resub.Lock()
+	defer s.resub.Unlock()
+
+	if sess, _, canceled := s.current(); canceled || sess != old {
+		return nil
+	}
+
+	sess, f, err := s.topic.resolve(ctx)
+	if err != nil {
+		return err
+	}
+
+	topic, err := f.Struct()
+	if err != nil {
+		return err
+	}
+
+	out := make(chan []byte, s.bufsize)
+	cancel, err := topic.Subscribe(ctx, out, append(s.opts,
+		pubsub.WithDropCounter(&s.dropped))...)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.canceled {
+		cancel()
+		return nil
+	}
+
+	if s.cancel != nil {
+		s.cancel()
+	}
+
+	s.sess, s.c, s.cancel = sess, out, cancel
+	return nil
+}
+
+func (s *subscription) current() (*session, <-chan []byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sess, s.c, s.canceled
+}
+
+// replaced returns true if the subscription was canceled, or if c is
+// no longer its current channel.
+func (s *subscription) replaced(c <-chan []byte) bool {
+	_, cur, canceled := s.current()
+	return canceled || cur != c
+}
+
+func (s *subscription) Cancel() {
+	s.unwatch()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.canceled {
+		s.canceled = true
+		s.cancel()
+	}
+}
+
+func (s *subscription) String() string  { return s.topic.name }
+func (s *subscription) Dropped() uint64 { return atomic.LoadUint64(&s.dropped) }
 
 func (s *subscription) Loggable() map[string]interface{} {
 	return s.topic.Loggable()
 }
 
+// Next returns the next message.  Messages that fail to decrypt or
+// verify are silently discarded.  Next returns ErrClosed if the host
+// ends the subscription.
 func (s *subscription) Next(ctx context.Context) ([]byte, error) {
-	select {
-	case b := <-s.c:
-		return b, nil
-
-	case <-ctx.Done():
-		return nil, ctx.Err()
+	for {
+		b, err := s.next(ctx)
+		if err != nil {
+			return nil, err
+		}
 
-	case <-s.done:
-		// Cluster connection was lost, but we may still have
-		// messages buffered in the channel.
+		if b, err = s.topic.env.Open(b); err == nil {
+			return b, nil
+		}
 	}
+}
+
+func (s *subscription) next(ctx context.Context) ([]byte, error) {
+	for {
+		sess, c, canceled := s.current()
+		if canceled {
+			return nil, ErrCanceled
+		}
+
+		select {
+		case b, ok := <-c:
+			if ok {
+				return b, nil
+			}
+
+			// The channel is closed when the subscription is canceled
+			// or re-subscribed.  Otherwise, the host has ended it.
+			if s.replaced(c) {
+				continue
+			}
+			return nil, ErrClosed
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-sess.Done():
+			// Cluster connection was lost, but we may still have
+			// messages buffered in the channel.
+		}
+
+		// Consume remaining messages before reconnecting.
+		select {
+		case b, ok := <-c:
+			if ok {
+				return b, nil
+			}
+
+			if s.replaced(c) {
+				continue
+			}
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
 
-	// Consume remaining messages before returning error.
-	select {
-	case b := <-s.c:
-		return b, nil
+		default:
+		}
 
-	case <-ctx.Done():
-		return nil, ctx.Err()
+		// Wait for the client to reconnect, and re-subscribe.  This
+		// fails with ErrDisconnected if the client is not reconnecting.
+		if _, err := s.topic.link.next(ctx, sess); err != nil {
+			return nil, err
+		}
 
-	default:
-		return nil, ErrDisconnected
+		if err := s.subscribe(ctx, sess); err != nil {
+			return nil, err
+		}
 	}
 }
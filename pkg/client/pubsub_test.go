@@ -0,0 +1,31 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscription_closed(t *testing.T) {
+	t.Parallel()
+
+	left, right := net.Pipe()
+	conn := rpc.NewConn(rpc.NewStreamTransport(left), nil)
+	defer conn.Close()
+	defer right.Close()
+
+	c := make(chan []byte)
+	close(c) // the host ended the subscription
+
+	sub := &subscription{sess: &session{conn: conn}, c: c}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err := sub.next(ctx)
+	assert.ErrorIs(t, err, ErrClosed, "should end subscription on a live session")
+}
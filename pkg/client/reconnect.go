@@ -0,0 +1,418 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/libp2p/go-libp2p-core/peer"
+
+	"github.com/wetware/ww/pkg/cap/cluster"
+	hostcap "github.com/wetware/ww/pkg/cap/host"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
+)
+
+// ReconnectPolicy causes a client to re-dial the cluster when its
+// connection to the bootstrap host is lost.  Discovery is performed
+// anew for each attempt, so the client may reconnect through a
+// different host.  Topics are re-joined, and active subscriptions
+// are re-subscribed, transparently.  Messages published while the
+// client is disconnected are not delivered.
+type ReconnectPolicy struct {
+	// Backoff is the delay before the first attempt.  It is doubled
+	// after each failed attempt, up to MaxBackoff.  Defaults to one
+	// second, and one minute, respectively.
+	Backoff, MaxBackoff time.Duration
+
+	// Timeout bounds each attempt, including discovery.  Defaults to
+	// 15 seconds.
+	Timeout time.Duration
+
+	// MaxAttempts is the number of consecutive failed attempts after
+	// which the client gives up, and its Done channel is closed.  If
+	// zero, the client retries until it is closed.
+	MaxAttempts int
+
+	// OnEvent is called for each reconnection event.  It MUST NOT
+	// block.  If nil, events are ignored.
+	OnEvent func(ReconnectEvent)
+}
+
+// EventType identifies a ReconnectEvent.
+type EventType uint8
+
+const (
+	// Disconnected is emitted when the connection to the bootstrap
+	// host is lost.
+	Disconnected EventType = iota
+
+	// ReconnectFailed is emitted for each failed attempt.
+	ReconnectFailed
+
+	// Reconnected is emitted when the client has connected to a
+	// bootstrap host.
+	Reconnected
+
+	// GaveUp is emitted when MaxAttempts has been exceeded.
+	GaveUp
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Disconnected:
+		return "disconnected"
+	case ReconnectFailed:
+		return "reconnect failed"
+	case Reconnected:
+		return "reconnected"
+	case GaveUp:
+		return "gave up"
+	}
+
+	return fmt.Sprintf("<invalid event %d>", t)
+}
+
+// ReconnectEvent reports a change in a client's connection to the
+// cluster.
+type ReconnectEvent struct {
+	Type    EventType
+	Peer    peer.ID // bootstrap host; empty for ReconnectFailed and GaveUp
+	Attempt int     // zero for Disconnected
+	Err     error   // set for ReconnectFailed
+}
+
+func (p ReconnectPolicy) backoff(attempt int) time.Duration {
+	d, max := p.Backoff, p.MaxBackoff
+	if d <= 0 {
+		d = time.Second
+	}
+
+	if max <= 0 {
+		max = time.Minute
+	}
+
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+
+	if d > max {
+		d = max
+	}
+
+	return d
+}
+
+func (p ReconnectPolicy) timeout() time.Duration {
+	if p.Timeout <= 0 {
+		return time.Second * 15
+	}
+
+	return p.Timeout
+}
+
+func (p ReconnectPolicy) emit(ev ReconnectEvent) {
+	if p.OnEvent != nil {
+		p.OnEvent(ev)
+	}
+}
+
+// session is a connection to a bootstrap host, along with the
+// capabilities obtained from it.
+type session struct {
+	peer peer.ID
+	conn *rpc.Conn
+	host hostcap.Host // conn's bootstrap capability
+	ps   pscap.PubSub
+	view cluster.View
+}
+
+// newSession obtains the host's capabilities over conn.  It fails if
+// they do not resolve before ctx expires, or if conn is closed in the
+// meantime.  In either case, conn is closed.
+func newSession(ctx context.Context, id peer.ID, conn *rpc.Conn) (*session, error) {
+	s := &session{
+		peer: id,
+		conn: conn,
+		host: hostcap.Host{Client: conn.Bootstrap(context.Background())},
+	}
+
+	// Calls to the host block until they return, so ensure the remote
+	// vat is responsive before making them.
+	if err := s.resolve(ctx, s.host.Client); err != nil {
+		s.host.Release()
+		conn.Close()
+		return nil, err
+	}
+
+	s.ps = s.host.PubSub(context.Background())
+	s.view = s.host.View(context.Background())
+
+	if err := s.resolve(ctx, s.ps.Client, s.view.Client); err != nil {
+		s.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// resolve blocks until the clients have resolved.  It fails if any
+// client resolved to an error, or if the connection was closed.
+func (s *session) resolve(ctx context.Context, cs ...*capnp.Client) error {
+	for _, c := range cs {
+		if err := c.Resolve(ctx); err != nil {
+			return err
+		}
+
+		// Failed calls resolve to clients branded with their error.
+		if err, ok := c.State().Brand.Value.(error); ok {
+			return err
+		}
+	}
+
+	select {
+	case <-s.Done():
+		return ErrDisconnected
+	default:
+		return nil
+	}
+}
+
+func (s *session) Done() <-chan struct{} { return s.conn.Done() }
+
+func (s *session) Close() error {
+	s.view.Release()
+	s.ps.Release()
+	s.host.Release()
+
+	return s.conn.Close()
+}
+
+// link holds a client's current session.  If the link was created
+// with a ReconnectPolicy, the session is replaced when its connection
+// is lost.
+type link struct {
+	mu      sync.Mutex
+	sess    *session
+	changed chan struct{} // closed when sess is replaced
+
+	dial   func(context.Context) (*session, error) // nil if not reconnecting
+	policy ReconnectPolicy
+	hooks  map[*reconnectHook]struct{} // guarded by mu
+
+	ctx    context.Context // cancelled when the link is closed
+	cancel context.CancelFunc
+	done   chan struct{} // closed when the link is closed, or gives up
+}
+
+func newLink(s *session) *link {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &link{
+		sess:    s,
+		changed: make(chan struct{}),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// reconnect starts a goroutine that replaces the session when its
+// connection is lost.
+func (l *link) reconnect(dial func(context.Context) (*session, error), p ReconnectPolicy) {
+	l.dial = dial
+	l.policy = p
+	l.done = make(chan struct{})
+
+	go l.loop()
+}
+
+func (l *link) loop() {
+	defer close(l.done)
+
+	for {
+		s := l.current()
+
+		select {
+		case <-s.Done():
+		case <-l.ctx.Done():
+			return
+		}
+
+		l.policy.emit(ReconnectEvent{Type: Disconnected, Peer: s.peer})
+
+		if !l.redial() {
+			return
+		}
+	}
+}
+
+// redial until a new session is established.  It returns false if the
+// link was closed, or if the policy's MaxAttempts was exceeded.
+func (l *link) redial() bool {
+	for attempt := 1; ; attempt++ {
+		t := time.NewTimer(l.policy.backoff(attempt))
+		select {
+		case <-t.C:
+		case <-l.ctx.Done():
+			t.Stop()
+			return false
+		}
+
+		ctx, cancel := context.WithTimeout(l.ctx, l.policy.timeout())
+		s, err := l.dial(ctx)
+		cancel()
+
+		if err == nil {
+			l.runHooks(l.replace(s))
+			l.policy.emit(ReconnectEvent{
+				Type:    Reconnected,
+				Peer:    s.peer,
+				Attempt: attempt,
+			})
+			return true
+		}
+
+		if l.ctx.Err() != nil {
+			return false
+		}
+
+		l.policy.emit(ReconnectEvent{
+			Type:    ReconnectFailed,
+			Attempt: attempt,
+			Err:     err,
+		})
+
+		if l.policy.MaxAttempts > 0 && attempt >= l.policy.MaxAttempts {
+			l.policy.emit(ReconnectEvent{Type: GaveUp, Attempt: attempt})
+			return false
+		}
+	}
+}
+
+// reconnectHook is called with a fresh context after the session 'old'
+// has been replaced, and before the Reconnected event is emitted.
+type reconnectHook func(ctx context.Context, old *session)
+
+// onReconnect registers a hook that is called each time the link
+// reconnects.  The returned function unregisters the hook.
+func (l *link) onReconnect(f reconnectHook) func() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.hooks == nil {
+		l.hooks = make(map[*reconnectHook]struct{})
+	}
+
+	h := &f
+	l.hooks[h] = struct{}{}
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		delete(l.hooks, h)
+	}
+}
+
+func (l *link) runHooks(old *session) {
+	l.mu.Lock()
+	hooks := make([]reconnectHook, 0, len(l.hooks))
+	for h := range l.hooks {
+		hooks = append(hooks, *h)
+	}
+	l.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(l.ctx, l.policy.timeout())
+	defer cancel()
+
+	for _, f := range hooks {
+		f(ctx, old)
+	}
+}
+
+func (l *link) replace(s *session) *session {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	old := l.sess
+	old.Close()
+	l.sess = s
+
+	close(l.changed)
+	l.changed = make(chan struct{})
+
+	return old
+}
+
+func (l *link) current() *session {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.sess
+}
+
+// session returns the current session.  If its connection was lost
+// and the link is reconnecting, session blocks until the connection
+// has been re-established.
+func (l *link) session(ctx context.Context) (*session, error) {
+	s := l.current()
+
+	select {
+	case <-s.Done():
+	default:
+		return s, nil
+	}
+
+	if l.dial == nil {
+		return s, nil // calls will fail with a disconnection error
+	}
+
+	return l.next(ctx, s)
+}
+
+// next blocks until the session 'old' has been replaced.  It returns
+// ErrDisconnected if the link is not reconnecting, or has given up.
+func (l *link) next(ctx context.Context, old *session) (*session, error) {
+	if l.dial == nil {
+		return nil, ErrDisconnected
+	}
+
+	for {
+		l.mu.Lock()
+		s, changed := l.sess, l.changed
+		l.mu.Unlock()
+
+		if s != old {
+			return s, nil
+		}
+
+		select {
+		case <-changed:
+		case <-l.done:
+			return nil, ErrDisconnected
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Done returns a channel that is closed when the link is permanently
+// disconnected.
+func (l *link) Done() <-chan struct{} {
+	if l.dial == nil {
+		return l.current().Done()
+	}
+
+	return l.done
+}
+
+func (l *link) Close() error {
+	l.cancel()
+
+	if l.dial != nil {
+		<-l.done
+	}
+
+	return l.current().Close()
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/wetware/ww/pkg/cap/sched"
+)
+
+// Scheduler returns the host's job scheduler.  Schedulers place jobs
+// on any host in the cluster, so the choice of host is immaterial.
+func (h Host) Scheduler(ctx context.Context) (sched.Scheduler, capnp.ReleaseFunc, error) {
+	s, release, err := h.services(ctx)
+	if err != nil {
+		return sched.Scheduler{}, nil, err
+	}
+
+	sc := s.Scheduler(ctx)
+	return sc, func() {
+		sc.Release()
+		release()
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"capnproto.org/go/capnp/v3"
 	"github.com/google/uuid"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/peer"
@@ -13,7 +14,11 @@ import (
 
 	"github.com/wetware/casm/pkg/cluster"
 	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/cron"
+	hostcap "github.com/wetware/ww/pkg/cap/host"
+	"github.com/wetware/ww/pkg/cap/proc"
 	pscap "github.com/wetware/ww/pkg/cap/pubsub"
+	"github.com/wetware/ww/pkg/cap/sched"
 	"github.com/wetware/ww/pkg/vat"
 )
 
@@ -24,9 +29,14 @@ type PubSub interface {
 }
 
 type Joiner struct {
-	log      log.Logger
-	newMerge func(vat.Network) clcap.MergeStrategy
-	opts     []cluster.Option
+	log       log.Logger
+	newMerge  func(vat.Network) clcap.MergeStrategy
+	exec      bool
+	opts      []cluster.Option
+	psOpts    []pscap.Option
+	procOpts  []proc.Option
+	schedOpts []sched.Option
+	cronOpts  []cron.Option
 }
 
 func NewJoiner(opt ...Option) Joiner {
@@ -38,6 +48,10 @@ func NewJoiner(opt ...Option) Joiner {
 }
 
 func (j Joiner) Join(ctx context.Context, vat vat.Network, ps PubSub) (*Node, error) {
+	if j.exec && vat.Admit == nil {
+		return nil, ErrUnauthenticated
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -54,26 +68,70 @@ func (j Joiner) Join(ctx context.Context, vat vat.Network, ps PubSub) (*Node, er
 	}
 
 	// export default capabilities
+	router := pscap.New(vat.NS, ps, j.pubsubOptions(vat)...)
 	vat.Export(
 		pscap.Capability,
-		pscap.New(vat.NS, ps, pscap.WithLogger(j.log.With(vat))))
+		router)
 
+	view := clcap.ViewServer{View: c.View()}
 	vat.Export(
 		clcap.ViewCapability,
-		clcap.ViewServer{View: c.View()})
+		view)
 
+	host := clcap.NewHost(j.newMerge(vat))
 	vat.Export(
 		clcap.AnchorCapability,
-		clcap.NewHost(j.newMerge(vat)))
+		host)
 
-	// etc ...
-
-	// Bootstrap the node
-	return &Node{
+	n := &Node{
 		id:  id,
 		vat: vat,
 		c:   c,
-	}, c.Bootstrap(ctx)
+	}
+
+	svc := hostcap.Services{
+		View:   view,
+		PubSub: router,
+		Anchor: host,
+	}
+
+	// export process execution capabilities, if enabled
+	if j.exec {
+		e := proc.New(j.procOptions(vat, host)...)
+		vat.Export(
+			proc.Capability,
+			e)
+
+		s := sched.New(c.View(), executors{vat, e}, j.schedOptions(vat)...)
+		vat.Export(
+			sched.Capability,
+			s)
+
+		cr := cron.New(host, sched.Scheduler{Client: s.Client()},
+			j.cronOptions(vat)...)
+		vat.Export(
+			cron.Capability,
+			cr)
+
+		svc.Executor, svc.Scheduler, svc.Cron = e, s, cr
+		n.proc, n.sched, n.cron = e, s, cr
+	}
+
+	// export the bootstrap capability, which provides each of the
+	// above over a single connection
+	vat.Export(
+		hostcap.Capability,
+		hostcap.New(svc))
+
+	// etc ...
+
+	// Bootstrap the node
+	if err = c.Bootstrap(ctx); err != nil {
+		n.Close() // stop the services above, and leave the cluster
+		return nil, err
+	}
+
+	return n, nil
 }
 
 func (j Joiner) options(vat vat.Network, u uuid.UUID) []cluster.Option {
@@ -88,6 +146,56 @@ func (j Joiner) options(vat vat.Network, u uuid.UUID) []cluster.Option {
 	}, j.opts...)
 }
 
+func (j Joiner) pubsubOptions(vat vat.Network) []pscap.Option {
+	return append([]pscap.Option{
+		pscap.WithLogger(j.log.With(vat)),
+	}, j.psOpts...)
+}
+
+func (j Joiner) procOptions(vat vat.Network, root proc.Mounter) []proc.Option {
+	return append([]proc.Option{
+		proc.WithLogger(j.log.With(vat)),
+		proc.WithAnchor(root),
+	}, j.procOpts...)
+}
+
+func (j Joiner) schedOptions(vat vat.Network) []sched.Option {
+	return append([]sched.Option{
+		sched.WithLogger(j.log.With(vat)),
+	}, j.schedOpts...)
+}
+
+func (j Joiner) cronOptions(vat vat.Network) []cron.Option {
+	return append([]cron.Option{
+		cron.WithLogger(j.log.With(vat)),
+	}, j.cronOpts...)
+}
+
+// executors provides the scheduler with the executors of cluster
+// hosts.  The local executor is used directly.
+type executors struct {
+	vat   vat.Network
+	local *proc.Server
+}
+
+func (d executors) Dial(ctx context.Context, id peer.ID) (proc.Executor, capnp.ReleaseFunc, error) {
+	if id == d.vat.Host.ID() {
+		e := proc.Executor{Client: d.local.Client()}
+		return e, e.Release, nil
+	}
+
+	conn, err := d.vat.Connect(ctx, peer.AddrInfo{ID: id}, proc.Capability)
+	if err != nil {
+		return proc.Executor{}, nil, err
+	}
+
+	e := proc.Executor{Client: conn.Bootstrap(ctx)}
+	return e, func() {
+		e.Release()
+		conn.Close()
+	}, nil
+}
+
 type basicMerge struct{ host.Host }
 
 func newMergeFactory(m clcap.MergeStrategy) func(vat.Network) clcap.MergeStrategy {
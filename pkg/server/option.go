@@ -4,6 +4,10 @@ import (
 	"github.com/lthibault/log"
 	"github.com/wetware/casm/pkg/cluster"
 	clcap "github.com/wetware/ww/pkg/cap/cluster"
+	"github.com/wetware/ww/pkg/cap/cron"
+	"github.com/wetware/ww/pkg/cap/proc"
+	pscap "github.com/wetware/ww/pkg/cap/pubsub"
+	"github.com/wetware/ww/pkg/cap/sched"
 )
 
 type Option func(*Joiner)
@@ -36,6 +40,49 @@ func WithClusterConfig(opt ...cluster.Option) Option {
 	}
 }
 
+// WithPubSubConfig sets options for the PubSub capability
+// exported by the node.
+func WithPubSubConfig(opt ...pscap.Option) Option {
+	return func(j *Joiner) {
+		j.psOpts = opt
+	}
+}
+
+// WithProcConfig sets options for the Executor capability
+// exported by the node.
+func WithProcConfig(opt ...proc.Option) Option {
+	return func(j *Joiner) {
+		j.procOpts = opt
+	}
+}
+
+// WithSchedConfig sets options for the Scheduler capability
+// exported by the node.
+func WithSchedConfig(opt ...sched.Option) Option {
+	return func(j *Joiner) {
+		j.schedOpts = opt
+	}
+}
+
+// WithCronConfig sets options for the Cron capability exported by
+// the node.
+func WithCronConfig(opt ...cron.Option) Option {
+	return func(j *Joiner) {
+		j.cronOpts = opt
+	}
+}
+
+// WithExec exports the Executor, Scheduler and Cron capabilities,
+// which allow remote peers to run arbitrary processes on the host.
+// They are disabled by default.  Since any peer that is admitted by
+// the vat network can use them, Join fails with ErrUnauthenticated
+// unless the network has an admission policy.  See vat.AdmitFunc.
+func WithExec(enable bool) Option {
+	return func(j *Joiner) {
+		j.exec = enable
+	}
+}
+
 func withDefault(opt []Option) []Option {
 	return append([]Option{
 		WithLogger(nil),
@@ -3,24 +3,43 @@ package server
 
 import (
 	"context"
+	"errors"
 	"io"
 
 	"github.com/google/uuid"
+	"go.uber.org/multierr"
+
 	"github.com/wetware/ww/pkg/vat"
 )
 
+// ErrUnauthenticated is returned by Join when process execution is
+// enabled, but the vat network admits any peer.  See WithExec.
+var ErrUnauthenticated = errors.New("exec requires an admission policy")
+
 type Node struct {
-	id  uuid.UUID // instance ID
-	vat vat.Network
-	c   io.Closer
+	id    uuid.UUID // instance ID
+	vat   vat.Network
+	c     io.Closer
+	proc  io.Closer // kills running processes; nil if exec is disabled
+	sched io.Closer // stops scheduled jobs; nil if exec is disabled
+	cron  io.Closer // stops firing cron entries; nil if exec is disabled
 }
 
 func New(ctx context.Context, vat vat.Network, ps PubSub, opt ...Option) (*Node, error) {
 	return NewJoiner(opt...).Join(ctx, vat, ps)
 }
 
+// Close stops the node's services and leaves the cluster.  All of
+// them are closed, even if some fail.
 func (n *Node) Close() error {
-	return n.c.Close()
+	var err error
+	for _, c := range []io.Closer{n.cron, n.sched, n.proc} {
+		if c != nil {
+			err = multierr.Append(err, c.Close())
+		}
+	}
+
+	return multierr.Append(err, n.c.Close())
 }
 
 // String returns the cluster namespace
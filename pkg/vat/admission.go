@@ -0,0 +1,75 @@
+package vat
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// ErrNotAuthorized is returned by Allowlist when a peer is not
+// admitted.
+var ErrNotAuthorized = errors.New("not authorized")
+
+// AdmitFunc decides whether a remote peer may obtain an exported
+// capability.  It is called for each incoming stream with the peer's
+// identity and the client that would otherwise be provided.  It returns
+// the client to provide instead, which MAY be an attenuated form of c.
+// If it returns an error, the stream is reset.  In either case, AdmitFunc
+// takes ownership of c.
+type AdmitFunc func(id peer.ID, key crypto.PubKey, c *capnp.Client) (*capnp.Client, error)
+
+// Allowlist admits the peers it contains, and rejects all others.
+type Allowlist map[peer.ID]struct{}
+
+// LoadAllowlist reads an allowlist from the file at path.  See
+// ReadAllowlist.
+func LoadAllowlist(path string) (Allowlist, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadAllowlist(f)
+}
+
+// ReadAllowlist reads an allowlist containing one peer ID per line.
+// Blank lines and lines starting with '#' are ignored.
+func ReadAllowlist(r io.Reader) (Allowlist, error) {
+	a := make(Allowlist)
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+
+		id, err := peer.Decode(s)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		a[id] = struct{}{}
+	}
+
+	return a, scanner.Err()
+}
+
+// Admit satisfies AdmitFunc.  The client is provided unchanged to the
+// peers in the allowlist.
+func (a Allowlist) Admit(id peer.ID, _ crypto.PubKey, c *capnp.Client) (*capnp.Client, error) {
+	if _, ok := a[id]; ok {
+		return c, nil
+	}
+
+	c.Release()
+	return nil, fmt.Errorf("%s: %w", id, ErrNotAuthorized)
+}
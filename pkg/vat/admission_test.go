@@ -0,0 +1,129 @@
+package vat_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/ww/internal/api/cluster"
+	"github.com/wetware/ww/pkg/vat"
+)
+
+func TestReadAllowlist(t *testing.T) {
+	t.Parallel()
+
+	h := newVat(t).Host
+	defer h.Close()
+
+	a, err := vat.ReadAllowlist(strings.NewReader(
+		"# authorized peers\n\n" + h.ID().String() + "\n"))
+	require.NoError(t, err, "should read allowlist")
+	assert.Equal(t, vat.Allowlist{h.ID(): struct{}{}}, a)
+
+	_, err = vat.ReadAllowlist(strings.NewReader("invalid\n"))
+	assert.ErrorContains(t, err, "line 1", "should report invalid peer ID")
+}
+
+func TestNetwork_admit(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Allow", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newVat(t), newVat(t)
+		defer svr.Host.Close()
+		defer clt.Host.Close()
+
+		svr.Admit = vat.Allowlist{clt.Host.ID(): struct{}{}}.Admit
+		svr.Export(testCap, errorProvider{})
+
+		assert.EqualError(t, bootstrap(t, svr, clt), "test",
+			"should provide capability to authorized peer")
+	})
+
+	t.Run("Deny", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newVat(t), newVat(t)
+		defer svr.Host.Close()
+		defer clt.Host.Close()
+
+		svr.Admit = vat.Allowlist{}.Admit
+		svr.Export(testCap, errorProvider{})
+
+		conn, err := clt.Connect(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should open stream")
+		defer conn.Close()
+
+		select {
+		case <-conn.Done():
+		case <-time.After(time.Second * 5):
+			t.Fatal("should reset stream from unauthorized peer")
+		}
+	})
+
+	t.Run("Attenuate", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newVat(t), newVat(t)
+		defer svr.Host.Close()
+		defer clt.Host.Close()
+
+		svr.Admit = func(id peer.ID, key crypto.PubKey, c *capnp.Client) (*capnp.Client, error) {
+			assert.Equal(t, clt.Host.ID(), id, "should pass remote peer ID")
+			assert.True(t, key.Equals(clt.Host.Peerstore().PubKey(id)),
+				"should pass remote public key")
+
+			c.Release()
+			return capnp.ErrorClient(errors.New("attenuated")), nil
+		}
+		svr.Export(testCap, errorProvider{})
+
+		assert.EqualError(t, bootstrap(t, svr, clt), "attenuated",
+			"should provide attenuated capability")
+	})
+}
+
+// bootstrap connects clt to the capability exported by svr, and
+// returns the error returned by a call to it.
+func bootstrap(t *testing.T, svr, clt vat.Network) error {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	conn, err := clt.Connect(ctx, *host.InfoFromHost(svr.Host), testCap)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	f, release := cluster.Anchor{Client: conn.Bootstrap(ctx)}.Ls(ctx, nil)
+	defer release()
+
+	if _, err = f.Struct(); err != nil {
+		return unwrap(err)
+	}
+
+	return nil
+}
+
+// unwrap strips the rpc package's error prefixes.
+func unwrap(err error) error {
+	msg := err.Error()
+	if i := strings.LastIndex(msg, ": "); i >= 0 {
+		msg = msg[i+2:]
+	}
+
+	return errors.New(msg)
+}
@@ -1,24 +1,124 @@
 package vat
 
 import (
+	"path"
+	"sort"
+	"strings"
+
 	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/coreos/go-semver/semver"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	protoutil "github.com/wetware/casm/pkg/util/proto"
 )
 
-var packed = protoutil.Exactly("packed")
+var (
+	packed     = protoutil.Suffix("packed")
+	compressed = protoutil.Suffix("packed+zstd")
+)
+
+// transports supported by BasicCap, in decreasing order of preference.
+// The empty string denotes the unpacked transport.
+var transports = []string{"packed+zstd", "packed", ""}
 
 // BasicCap is a basic provider of Capability.  Most implementations
 // will benefit from using this directly.  See pkg/cap/pubsub for an
 // example.
-type BasicCap []protocol.ID
+//
+// A BasicCap supports one or more semantic versions of its protocol,
+// which are identified as <name>/<version>.  A vat that supports
+// version X.Y accepts streams for any version X.Z with Z <= Y.  Vats
+// propose each of their versions in decreasing order, so that the
+// highest version supported by both ends of the stream is selected.
+//
+// Each version is offered over three transports, in decreasing order
+// of preference:  <name>/<version>/packed+zstd, which compresses the
+// packed encoding with zstd; <name>/<version>/packed; and the unpacked
+// <name>/<version>.
+type BasicCap struct {
+	Name     string
+	Versions []string // semantic versions, e.g. "0.1.0"
+}
+
+// Protocols returns the versioned protocol IDs for the capability,
+// in decreasing order of version.  Compressed transports are preferred,
+// followed by packed transports.
+func (c BasicCap) Protocols() []protocol.ID {
+	vs := c.versions()
+
+	ps := make([]protocol.ID, 0, len(transports)*len(vs))
+	for _, v := range vs {
+		for _, t := range transports {
+			ps = append(ps, protocol.ID(path.Join(c.Name, v.String(), t)))
+		}
+	}
 
-func (c BasicCap) Protocols() []protocol.ID { return c }
+	return ps
+}
+
+// Match reports whether the capability can be served over the stream
+// with the supplied protocol ID, which is of the form returned by
+// Protocols.  Any version that is compatible with a supported version
+// is matched.
+func (c BasicCap) Match(id protocol.ID) bool {
+	v, ok := c.Version(id)
+	if !ok {
+		return false
+	}
+
+	for _, u := range c.versions() {
+		if u.Major == v.Major && u.Minor >= v.Minor {
+			return true
+		}
+	}
+
+	return false
+}
 
+// Version returns the version of the capability's protocol that was
+// negotiated for the stream with the supplied protocol ID.
+func (c BasicCap) Version(id protocol.ID) (semver.Version, bool) {
+	rest := strings.TrimPrefix(string(id), c.Name+"/")
+	if rest == string(id) {
+		return semver.Version{}, false
+	}
+
+	for _, t := range transports[:len(transports)-1] {
+		if trimmed := strings.TrimSuffix(rest, "/"+t); trimmed != rest {
+			rest = trimmed
+			break
+		}
+	}
+
+	v, err := semver.NewVersion(rest)
+	if err != nil {
+		return semver.Version{}, false
+	}
+
+	return *v, true
+}
+
+// Upgrade the stream to the transport identified by the negotiated
+// protocol ID.
 func (c BasicCap) Upgrade(s Stream) rpc.Transport {
-	if packed.MatchProto(s.Protocol()) {
+	switch {
+	case compressed.MatchProto(s.Protocol()):
+		return NewZstdTransport(s)
+
+	case packed.MatchProto(s.Protocol()):
 		return rpc.NewPackedStreamTransport(s)
 	}
 
 	return rpc.NewStreamTransport(s)
 }
+
+// versions returns the supported versions in decreasing order.  It
+// panics if a version is invalid.
+func (c BasicCap) versions() []*semver.Version {
+	vs := make([]*semver.Version, len(c.Versions))
+	for i, v := range c.Versions {
+		vs[i] = semver.New(v)
+	}
+
+	sort.Slice(vs, func(i, j int) bool { return vs[j].LessThan(*vs[i]) })
+	return vs
+}
@@ -0,0 +1,186 @@
+package vat
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+)
+
+// ErrPoolClosed is returned when dialing through a closed Pool.
+var ErrPoolClosed = errors.New("pool closed")
+
+// DefaultIdleTimeout is the time for which a Pool retains unused
+// connections, if no IdleTimeout was specified.
+const DefaultIdleTimeout = time.Second * 30
+
+// Pool caches RPC connections to capabilities on remote vats, so that
+// they can be shared by concurrent users.  Connections are keyed by
+// peer and capability, and are reference-counted.  A connection that
+// is no longer referenced is closed after it has been idle for the
+// pool's IdleTimeout.  Connections are evicted from the pool as soon
+// as they are closed by the remote vat.
+//
+// The zero-value Pool is ready to use.  See Network.Dial.
+type Pool struct {
+	IdleTimeout time.Duration
+
+	mu     sync.Mutex
+	conns  map[poolKey]*poolEntry
+	closed bool
+}
+
+type poolKey struct {
+	peer   peer.ID
+	protos string
+}
+
+type poolEntry struct {
+	ready chan struct{} // closed when the conn has been dialed
+	conn  *rpc.Conn
+	err   error
+
+	refs int         // guarded by Pool.mu
+	idle *time.Timer // non-nil while refs == 0
+}
+
+// Close the pool and all of its connections, including those that
+// are in use.  Subsequent calls to Dial will fail with ErrPoolClosed.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	conns := p.conns
+	p.conns = nil
+	p.closed = true
+	p.mu.Unlock()
+
+	for _, e := range conns {
+		<-e.ready
+
+		if e.conn != nil {
+			e.conn.Close()
+		}
+	}
+
+	return nil
+}
+
+func (p *Pool) dial(ctx context.Context, n Network, vat peer.AddrInfo, c Capability) (*rpc.Conn, capnp.ReleaseFunc, error) {
+	key := poolKey{
+		peer:   vat.ID,
+		protos: strings.Join(protocol.ConvertToStrings(n.protocolsFor(c)), " "),
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, nil, ErrPoolClosed
+	}
+
+	e, ok := p.conns[key]
+	if !ok {
+		e = &poolEntry{ready: make(chan struct{})}
+		if p.conns == nil {
+			p.conns = make(map[poolKey]*poolEntry)
+		}
+		p.conns[key] = e
+	}
+
+	e.refs++
+	if e.idle != nil {
+		e.idle.Stop()
+		e.idle = nil
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		p.connect(ctx, n, vat, c, key, e)
+	}
+
+	select {
+	case <-e.ready:
+	case <-ctx.Done():
+		p.release(key, e)
+		return nil, nil, ctx.Err()
+	}
+
+	if e.err != nil {
+		p.release(key, e)
+		return nil, nil, e.err
+	}
+
+	var once sync.Once
+	return e.conn, func() {
+		once.Do(func() { p.release(key, e) })
+	}, nil
+}
+
+// connect dials the entry's connection, and evicts it when the
+// connection is closed.  Failed entries are evicted immediately, so
+// that subsequent calls to Dial try again.
+func (p *Pool) connect(ctx context.Context, n Network, vat peer.AddrInfo, c Capability, key poolKey, e *poolEntry) {
+	defer close(e.ready)
+
+	if e.conn, e.err = n.Connect(ctx, vat, c); e.err != nil {
+		p.evict(key, e)
+		return
+	}
+
+	go func() {
+		<-e.conn.Done()
+		p.evict(key, e)
+	}()
+}
+
+func (p *Pool) release(key poolKey, e *poolEntry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if e.refs--; e.refs > 0 || e.conn == nil || p.conns[key] != e {
+		return
+	}
+
+	e.idle = time.AfterFunc(p.idleTimeout(), func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		// Was the conn reused before the timer fired?
+		if e.refs == 0 && p.conns[key] == e {
+			delete(p.conns, key)
+			go e.conn.Close()
+		}
+	})
+}
+
+// evict the entry from the pool, closing its connection.  Current
+// users of the connection are unaffected, but will observe that it
+// has been closed.
+func (p *Pool) evict(key poolKey, e *poolEntry) {
+	p.mu.Lock()
+	if p.conns[key] == e {
+		delete(p.conns, key)
+	}
+
+	if e.idle != nil {
+		e.idle.Stop()
+		e.idle = nil
+	}
+	p.mu.Unlock()
+
+	if e.conn != nil {
+		e.conn.Close()
+	}
+}
+
+func (p *Pool) idleTimeout() time.Duration {
+	if p.IdleTimeout <= 0 {
+		return DefaultIdleTimeout
+	}
+
+	return p.IdleTimeout
+}
@@ -0,0 +1,129 @@
+package vat_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/ww/pkg/vat"
+)
+
+var testCap = vat.BasicCap{
+	Name:     "test",
+	Versions: []string{"0.1.0"},
+}
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Reuse", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newPooledVats(t, time.Minute)
+
+		c0, release0, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should dial")
+
+		c1, release1, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should dial")
+		assert.Same(t, c0, c1, "should reuse connection")
+
+		release0()
+		release0() // idempotent
+		release1()
+
+		c2, release2, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should dial")
+		defer release2()
+		assert.Same(t, c0, c2, "should reuse idle connection")
+	})
+
+	t.Run("IdleTimeout", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newPooledVats(t, time.Millisecond*10)
+
+		c0, release, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should dial")
+		release()
+
+		select {
+		case <-c0.Done():
+		case <-time.After(time.Second):
+			t.Fatal("should close idle connection")
+		}
+
+		c1, release, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should dial")
+		defer release()
+		assert.NotSame(t, c0, c1, "should not reuse closed connection")
+	})
+
+	t.Run("Evict", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newPooledVats(t, time.Minute)
+
+		c0, release, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should dial")
+		defer release()
+
+		c0.Close() // simulate a lost connection
+		require.Eventually(t, func() bool {
+			c1, release, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+			require.NoError(t, err, "should dial")
+			defer release()
+
+			return c1 != c0
+		}, time.Second, time.Millisecond*10, "should evict closed connection")
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newPooledVats(t, time.Minute)
+
+		c0, release, err := clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		require.NoError(t, err, "should dial")
+		defer release()
+
+		require.NoError(t, clt.Pool.Close(), "should close pool")
+
+		select {
+		case <-c0.Done():
+		case <-time.After(time.Second):
+			t.Fatal("should close connections in use")
+		}
+
+		_, _, err = clt.Dial(context.Background(), *host.InfoFromHost(svr.Host), testCap)
+		assert.ErrorIs(t, err, vat.ErrPoolClosed)
+	})
+}
+
+func newPooledVats(t *testing.T, idle time.Duration) (svr, clt vat.Network) {
+	t.Helper()
+
+	svr, clt = newVat(t), newVat(t)
+	t.Cleanup(func() {
+		svr.Host.Close()
+		clt.Host.Close()
+	})
+
+	svr.Export(testCap, errorProvider{})
+	clt.Pool = &vat.Pool{IdleTimeout: idle}
+
+	return
+}
+
+type errorProvider struct{}
+
+func (errorProvider) Client() *capnp.Client {
+	return capnp.ErrorClient(errors.New("test"))
+}
@@ -0,0 +1,139 @@
+package vat
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/klauspost/compress/zstd"
+)
+
+// zstdWindowSize bounds the memory used by each compressed stream.
+const zstdWindowSize = 1 << 20 // 1MiB
+
+// NewZstdTransport returns a transport that packs messages, and
+// compresses them with zstd.  The compression window spans the whole
+// stream, so that recurring structure is compressed across messages.
+// Each message is flushed as soon as it has been encoded.
+//
+// Reads and writes that are blocked when their context expires are
+// interrupted by setting a deadline on the stream, if it supports one,
+// or else by closing it.  Since the compressed stream cannot recover
+// from a partial frame, an interrupted transport should be closed.
+func NewZstdTransport(rwc io.ReadWriteCloser) rpc.Transport {
+	return rpc.NewTransport(newZstdCodec(rwc))
+}
+
+type zstdCodec struct {
+	rwc io.ReadWriteCloser
+
+	zr  *zstd.Decoder
+	dec *capnp.Decoder
+
+	zw  *zstd.Encoder
+	enc *capnp.Encoder
+}
+
+func newZstdCodec(rwc io.ReadWriteCloser) *zstdCodec {
+	// Both constructors fail only if passed invalid options.
+	zr, err := zstd.NewReader(rwc,
+		zstd.WithDecoderConcurrency(1), // decode synchronously
+		zstd.WithDecoderMaxWindow(zstdWindowSize))
+	if err != nil {
+		panic(err)
+	}
+
+	zw, err := zstd.NewWriter(rwc,
+		zstd.WithEncoderConcurrency(1),
+		zstd.WithWindowSize(zstdWindowSize),
+		zstd.WithLowerEncoderMem(true))
+	if err != nil {
+		panic(err)
+	}
+
+	return &zstdCodec{
+		rwc: rwc,
+		zr:  zr,
+		dec: capnp.NewPackedDecoder(zr),
+		zw:  zw,
+		enc: capnp.NewPackedEncoder(zw),
+	}
+}
+
+func (c *zstdCodec) Encode(ctx context.Context, m *capnp.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	defer interrupt(ctx, func() {
+		if d, ok := c.rwc.(interface{ SetWriteDeadline(time.Time) error }); ok {
+			d.SetWriteDeadline(time.Now())
+		} else {
+			c.rwc.Close()
+		}
+	})()
+
+	if err := c.enc.Encode(m); err != nil {
+		return err
+	}
+
+	return c.zw.Flush()
+}
+
+func (c *zstdCodec) Decode(ctx context.Context) (*capnp.Message, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	defer interrupt(ctx, func() {
+		if d, ok := c.rwc.(interface{ SetReadDeadline(time.Time) error }); ok {
+			d.SetReadDeadline(time.Now())
+		} else {
+			c.rwc.Close()
+		}
+	})()
+
+	return c.dec.Decode()
+}
+
+// SetPartialWriteTimeout is a nop.  Messages are written to the
+// compressor in their entirety.
+func (c *zstdCodec) SetPartialWriteTimeout(time.Duration) {}
+
+// Close the stream.  The stream is closed before the encoder, which
+// would otherwise block on writing the end of the frame to an
+// unresponsive peer.  The decoder is not closed, since it may be in use
+// by a concurrent call to Decode.  Decoding is synchronous, so no
+// goroutines are leaked.
+func (c *zstdCodec) Close() error {
+	err := c.rwc.Close()
+	c.zw.Close() // release the encoder; the write error is expected
+	return err
+}
+
+// interrupt calls f if ctx expires before the returned function is
+// called.
+func interrupt(ctx context.Context, f func()) (stop func()) {
+	if ctx.Done() == nil {
+		return func() {}
+	}
+
+	cq := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		select {
+		case <-ctx.Done():
+			f()
+		case <-cq:
+		}
+	}()
+
+	return func() {
+		close(cq)
+		<-done
+	}
+}
@@ -0,0 +1,62 @@
+package vat_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/ww/pkg/vat"
+)
+
+func TestZstdTransport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	left, right := net.Pipe()
+	tx, rx := vat.NewZstdTransport(left), vat.NewZstdTransport(right)
+	defer tx.Close()
+	defer rx.Close()
+
+	const n = 16
+
+	done := make(chan struct{})
+	defer func() { <-done }() // before closing transports
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < n; i++ {
+			msg, send, release, err := tx.NewMessage(ctx)
+			if err != nil {
+				panic(err)
+			}
+
+			boot, err := msg.NewBootstrap()
+			if err != nil {
+				panic(err)
+			}
+			boot.SetQuestionId(uint32(i))
+
+			if err = send(); err != nil {
+				panic(err)
+			}
+			release()
+		}
+	}()
+
+	for i := 0; i < n; i++ {
+		msg, release, err := rx.RecvMessage(ctx)
+		require.NoError(t, err, "should receive message")
+
+		boot, err := msg.Bootstrap()
+		require.NoError(t, err, "should decode bootstrap message")
+		assert.Equal(t, uint32(i), boot.QuestionId(),
+			"should receive messages in order")
+
+		release()
+	}
+}
@@ -3,12 +3,14 @@ package vat
 
 import (
 	"context"
+	"strings"
 
+	"github.com/coreos/go-semver/semver"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/protocol"
-	ww "github.com/wetware/ww/pkg"
+	casm "github.com/wetware/casm/pkg"
 
 	"capnproto.org/go/capnp/v3"
 	"capnproto.org/go/capnp/v3/rpc"
@@ -26,6 +28,21 @@ type Capability interface {
 	Upgrade(Stream) rpc.Transport
 }
 
+// Matcher is implemented by capabilities that can be served over
+// protocols other than those returned by 'Protocols', such as other
+// compatible versions.  IDs are relative to the network namespace.
+type Matcher interface {
+	Match(protocol.ID) bool
+}
+
+// Versioned is implemented by capabilities whose protocol is versioned.
+type Versioned interface {
+	// Version returns the version of the capability's protocol that
+	// was negotiated for a stream, given its protocol ID relative to
+	// the network namespace.
+	Version(protocol.ID) (semver.Version, bool)
+}
+
 type Bootstrapper interface {
 	Bootstrap() *capnp.Client
 }
@@ -44,11 +61,27 @@ type ClientProvider interface {
 	Client() *capnp.Client
 }
 
+// VersionedClientProvider is a ClientProvider whose client depends on
+// the protocol version negotiated for each stream.  If the exported
+// capability is Versioned, 'ClientVersion' is called instead of
+// 'Client'.
+type VersionedClientProvider interface {
+	ClientProvider
+	ClientVersion(semver.Version) *capnp.Client
+}
+
 // Network wraps a libp2p Host and provides a high-level interface to
 // a capability-oriented network.
 type Network struct {
 	NS   string
 	Host host.Host
+
+	// Pool, if non-nil, caches the connections returned by Dial.
+	Pool *Pool
+
+	// Admit, if non-nil, is consulted before providing an exported
+	// capability to a remote peer.  See AdmitFunc.
+	Admit AdmitFunc
 }
 
 func (n Network) Loggable() map[string]interface{} {
@@ -87,20 +120,85 @@ func (n Network) Connect(ctx context.Context, vat peer.AddrInfo, c Capability) (
 	}), nil
 }
 
+// Dial is like Connect, but returns a connection that may be shared
+// with other users of the network.  If the network has a Pool, the
+// connection is obtained from it, and is returned to it when release
+// is called.  Otherwise, a new connection is created, and is closed by
+// release.  Callers MUST NOT close the connection directly.
+func (n Network) Dial(ctx context.Context, vat peer.AddrInfo, c Capability) (conn *rpc.Conn, release capnp.ReleaseFunc, err error) {
+	if n.Pool != nil {
+		return n.Pool.dial(ctx, n, vat, c)
+	}
+
+	if conn, err = n.Connect(ctx, vat, c); err == nil {
+		release = func() { conn.Close() }
+	}
+
+	return
+}
+
 // Export a capability, making it available to other vats in the network.
+// If 'c' satisfies the 'Matcher' interface, streams are accepted for any
+// protocol that it matches.  If the network has an 'Admit' function, it
+// decides which remote peers may obtain the capability.
 func (n Network) Export(c Capability, boot ClientProvider) {
+	handler := func(s network.Stream) {
+		client, err := n.admit(s, n.bootstrapClient(c, boot, s.Protocol()))
+		if err != nil {
+			s.Reset()
+			return
+		}
+		defer s.Close()
+
+		conn := rpc.NewConn(c.Upgrade(s), &rpc.Options{
+			BootstrapClient: client,
+		})
+		defer conn.Close()
+
+		<-conn.Done()
+	}
+
+	m, ok := c.(Matcher)
 	for _, id := range n.protocolsFor(c) {
-		n.Host.SetStreamHandler(id, func(s network.Stream) {
-			defer s.Close()
+		if !ok {
+			n.Host.SetStreamHandler(id, handler)
+			continue
+		}
 
-			conn := rpc.NewConn(c.Upgrade(s), &rpc.Options{
-				BootstrapClient: boot.Client(),
-			})
-			defer conn.Close()
+		n.Host.SetStreamHandlerMatch(id, func(s string) bool {
+			id, ok := n.relative(protocol.ID(s))
+			return ok && m.Match(id)
+		}, handler)
+	}
+}
 
-			<-conn.Done()
-		})
+func (n Network) admit(s network.Stream, c *capnp.Client) (*capnp.Client, error) {
+	if n.Admit == nil {
+		return c, nil
+	}
+
+	return n.Admit(s.Conn().RemotePeer(), s.Conn().RemotePublicKey(), c)
+}
+
+func (n Network) bootstrapClient(c Capability, boot ClientProvider, id protocol.ID) *capnp.Client {
+	vc, ok := c.(Versioned)
+	if !ok {
+		return boot.Client()
+	}
+
+	vp, ok := boot.(VersionedClientProvider)
+	if !ok {
+		return boot.Client()
 	}
+
+	if id, ok = n.relative(id); ok {
+		var v semver.Version
+		if v, ok = vc.Version(id); ok {
+			return vp.ClientVersion(v)
+		}
+	}
+
+	return boot.Client()
 }
 
 // Embargo ceases to export 'c'.  New calls to 'Connect' are guaranteed
@@ -112,14 +210,24 @@ func (n Network) Embargo(c Capability) {
 	}
 }
 
+// protocolsFor returns the absolute protocol IDs of the capability.
+// These are not pinned to the version of ww, so that vats running
+// different versions can negotiate a compatible capability version.
 func (n Network) protocolsFor(c Capability) []protocol.ID {
 	ps := make([]protocol.ID, len(c.Protocols()))
 	for i, id := range protocol.ConvertToStrings(c.Protocols()) {
-		ps[i] = ww.Subprotocol(n.NS, id)
+		ps[i] = casm.Subprotocol("ww", n.NS, id)
 	}
 	return ps
 }
 
+// relative returns the protocol ID relative to the network namespace.
+func (n Network) relative(id protocol.ID) (protocol.ID, bool) {
+	prefix := casm.Subprotocol("ww", n.NS) + "/"
+	rel := strings.TrimPrefix(string(id), string(prefix))
+	return protocol.ID(rel), rel != string(id)
+}
+
 func bootstrapper(c Capability) *capnp.Client {
 	if b, ok := c.(Bootstrapper); ok {
 		return b.Bootstrap()
@@ -0,0 +1,153 @@
+package vat_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/coreos/go-semver/semver"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	inproc "github.com/lthibault/go-libp2p-inproc-transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetware/ww/pkg/vat"
+)
+
+func TestBasicCap(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	c := vat.BasicCap{
+		Name:     "test",
+		Versions: []string{"0.1.0", "1.2.0", "0.3.0"},
+	}
+
+	t.Run("Protocols", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []protocol.ID{
+			"test/1.2.0/packed+zstd",
+			"test/1.2.0/packed",
+			"test/1.2.0",
+			"test/0.3.0/packed+zstd",
+			"test/0.3.0/packed",
+			"test/0.3.0",
+			"test/0.1.0/packed+zstd",
+			"test/0.1.0/packed",
+			"test/0.1.0",
+		}, c.Protocols(), "should order protocols by decreasing version")
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		t.Parallel()
+
+		for id, match := range map[protocol.ID]bool{
+			"test/1.2.0":             true,
+			"test/1.0.5/packed":      true,
+			"test/1.0.5/packed+zstd": true,
+			"test/0.2.0":             true,
+			"test/1.3.0":             false, // newer minor version
+			"test/2.0.0":             false, // incompatible major version
+			"other/1.2.0":            false,
+			"test/invalid":           false,
+		} {
+			assert.Equal(t, match, c.Match(id), id)
+		}
+	})
+
+	t.Run("Version", func(t *testing.T) {
+		t.Parallel()
+
+		v, ok := c.Version("test/1.0.5/packed")
+		require.True(t, ok, "should parse version")
+		assert.Equal(t, "1.0.5", v.String())
+
+		v, ok = c.Version("test/1.0.5/packed+zstd")
+		require.True(t, ok, "should parse version of compressed transport")
+		assert.Equal(t, "1.0.5", v.String())
+
+		_, ok = c.Version("other/1.0.5")
+		assert.False(t, ok, "should not parse version of other capability")
+	})
+}
+
+func TestNetwork_negotiate(t *testing.T) {
+	t.Parallel()
+	t.Helper()
+
+	t.Run("Compatible", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newVat(t), newVat(t)
+		defer svr.Host.Close()
+		defer clt.Host.Close()
+
+		p := make(provider, 1)
+		svr.Export(vat.BasicCap{
+			Name:     "test",
+			Versions: []string{"0.1.0", "0.3.0", "1.0.0"},
+		}, p)
+
+		conn, err := clt.Connect(context.Background(), *host.InfoFromHost(svr.Host), vat.BasicCap{
+			Name:     "test",
+			Versions: []string{"2.0.0", "0.2.0"},
+		})
+		require.NoError(t, err, "should connect")
+		defer conn.Close()
+
+		v := <-p
+		assert.Equal(t, "0.2.0", v.String(),
+			"should select highest mutually supported version")
+	})
+
+	t.Run("Incompatible", func(t *testing.T) {
+		t.Parallel()
+
+		svr, clt := newVat(t), newVat(t)
+		defer svr.Host.Close()
+		defer clt.Host.Close()
+
+		svr.Export(vat.BasicCap{
+			Name:     "test",
+			Versions: []string{"0.1.0"},
+		}, make(provider, 1))
+
+		_, err := clt.Connect(context.Background(), *host.InfoFromHost(svr.Host), vat.BasicCap{
+			Name:     "test",
+			Versions: []string{"0.2.0", "1.0.0"},
+		})
+		assert.EqualError(t, err, "protocol not supported")
+	})
+}
+
+// provider reports the negotiated version of each stream.
+type provider chan semver.Version
+
+func (p provider) Client() *capnp.Client {
+	panic("should call ClientVersion")
+}
+
+func (p provider) ClientVersion(v semver.Version) *capnp.Client {
+	p <- v
+	return capnp.ErrorClient(errors.New("test"))
+}
+
+func newVat(t *testing.T) vat.Network {
+	t.Helper()
+
+	h, err := libp2p.New(
+		libp2p.NoListenAddrs,
+		libp2p.NoTransports,
+		libp2p.ListenAddrStrings("/inproc/~"),
+		libp2p.Transport(inproc.New()))
+	require.NoError(t, err, "must succeed")
+
+	return vat.Network{
+		NS:   "test",
+		Host: h,
+	}
+}